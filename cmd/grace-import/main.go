@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/muzzarellimj/grace-material-api/internal/app"
+	"github.com/muzzarellimj/grace-material-api/internal/archive"
+	"github.com/muzzarellimj/grace-material-api/internal/auth"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML or TOML configuration file")
+	source := flag.String("source", "", "export file source: 'goodreads', 'letterboxd', 'steam', or 'archive' to restore a Grace archive")
+	filePath := flag.String("file", "", "path to the export file")
+	user := flag.String("user", auth.DefaultSubject, "reference of the user whose collection receives imported materials")
+
+	flag.Parse()
+
+	if *source == "" || *filePath == "" {
+		flag.Usage()
+
+		os.Exit(2)
+	}
+
+	configuration, err := config.Load(*configPath, ".env")
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to load Grace configuration: %v\n", err)
+
+		os.Exit(1)
+	}
+
+	connection, err := database.Connect(configuration.Database.URL)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to persist Grace database pool connection: %v\n", err)
+
+		os.Exit(1)
+	}
+
+	defer connection.Close()
+
+	container := app.NewContainer(configuration, connection, log.New(os.Stderr, "", log.LstdFlags))
+
+	if *source == archive.FormatArchive {
+		restore(container.Archiver, *filePath, *user)
+
+		return
+	}
+
+	importer := container.Importer
+
+	if !importer.Supports(*source) {
+		fmt.Fprintf(os.Stderr, "Unable to import from unsupported or disabled source '%s'.\n", *source)
+
+		os.Exit(1)
+	}
+
+	file, err := os.Open(*filePath)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to open export file '%s': %v\n", *filePath, err)
+
+		os.Exit(1)
+	}
+
+	defer file.Close()
+
+	rowSlice, err := importer.Parse(*source, file)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to parse %s export file '%s': %v\n", *source, *filePath, err)
+
+		os.Exit(1)
+	}
+
+	job := importer.Run(*user, *source, rowSlice)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "\t")
+
+	err = encoder.Encode(job)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to print import report: %v\n", err)
+
+		os.Exit(1)
+	}
+
+	fmt.Fprintf(os.Stderr, "Imported %d of %d rows: %d ambiguous, %d failed.\n", job.Summary.Matched, job.Summary.Total, job.Summary.Ambiguous, job.Summary.Failed)
+}
+
+// Restore a Grace archive for the provided user and print the restore report.
+func restore(archiver *archive.Archiver, filePath string, user string) {
+	file, err := os.Open(filePath)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to open archive file '%s': %v\n", filePath, err)
+
+		os.Exit(1)
+	}
+
+	defer file.Close()
+
+	restoreArchive, err := archive.ReadArchive(file)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to read archive file '%s': %v\n", filePath, err)
+
+		os.Exit(1)
+	}
+
+	report, err := archiver.Restore(user, restoreArchive)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to restore archive file '%s': %v\n", filePath, err)
+
+		os.Exit(1)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "\t")
+
+	err = encoder.Encode(report)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to print restore report: %v\n", err)
+
+		os.Exit(1)
+	}
+
+	fmt.Fprintf(os.Stderr,
+		"Restored %d books, %d games, %d movies, %d shows, %d albums, %d board games, %d comics, and %d podcasts; %d collection items, %d progress entries, %d reviews, %d tags, and %d lists: %d rows skipped.\n",
+		report.Books, report.Games, report.Movies, report.Shows, report.Albums, report.BoardGames, report.Comics, report.Podcasts,
+		report.Collection, report.Progress, report.Reviews, report.Tags, report.Lists, report.Skipped,
+	)
+}
@@ -1,26 +1,41 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
+	"log"
+	"net/http"
 	"os"
 
-	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
-	bookApi "github.com/muzzarellimj/grace-material-api/internal/api/book"
-	gameApi "github.com/muzzarellimj/grace-material-api/internal/api/game"
-	movieApi "github.com/muzzarellimj/grace-material-api/internal/api/movie"
+	"github.com/muzzarellimj/grace-material-api/internal/app"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
 	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
 )
 
 func main() {
-	err := godotenv.Load()
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML or TOML configuration file")
+	dump := flag.Bool("dump-config", false, "print the loaded configuration, with secrets redacted, and exit")
+
+	flag.Parse()
+
+	configuration, err := config.Load(*configPath, ".env")
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to load .env configuration file; this relies on explicitly declared configuration values and may cause issues outside deployed environments: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Unable to load Grace configuration: %v\n", err)
+
+		os.Exit(1)
 	}
 
-	err = database.Connect(os.Getenv("DATABASE_URL"))
+	if *dump {
+		fmt.Fprintln(os.Stdout, configuration)
+
+		return
+	}
+
+	connection, err := database.Connect(configuration.Database.URL)
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to persist Grace database pool connection: %v\n", err)
@@ -28,30 +43,24 @@ func main() {
 		os.Exit(1)
 	}
 
-	defer database.Disconnect()
+	defer connection.Close()
 
 	router := gin.Default()
-	router.Use(cors.Default())
-
-	router.GET("/api/book", bookApi.HandleGetBook)
-	router.PUT("/api/book", bookApi.HandlePutBook)
-	router.POST("/api/book", bookApi.HandlePostBook)
-	router.GET("/api/book/exist", bookApi.HandleGetBookExistenceSlice)
-	router.GET("/api/book/search", bookApi.HandleGetBookSearch)
-
-	router.GET("/api/game", gameApi.HandleGetGame)
-	router.PUT("/api/game", gameApi.HandlePutGame)
-	router.POST("/api/game", gameApi.HandlePostGame)
-	router.GET("/api/game/exist", gameApi.HandleGetGameExistenceSlice)
-	router.GET("/api/game/search", gameApi.HandleGetGameSearch)
-
-	router.GET("/api/movie", movieApi.HandleGetMovie)
-	router.PUT("/api/movie", movieApi.HandlePutMovie)
-	router.POST("/api/movie", movieApi.HandlePostMovie)
-	router.GET("/api/movie/exist", movieApi.HandleGetMovieExistenceSlice)
-	router.GET("/api/movie/search", movieApi.HandleGetMovieSearch)
-
-	err = router.Run()
+	router.Use(middleware.CORS(configuration.CORS), middleware.SecurityHeaders(configuration.Security), middleware.BodyLimit(configuration.Security))
+
+	container := app.NewContainer(configuration, connection, log.New(os.Stderr, "", log.LstdFlags))
+	container.Register(router)
+	container.Start(context.Background())
+
+	server := &http.Server{
+		Addr:         configuration.Server.Address,
+		Handler:      router,
+		ReadTimeout:  configuration.Server.ReadTimeout,
+		WriteTimeout: configuration.Server.WriteTimeout,
+		IdleTimeout:  configuration.Server.IdleTimeout,
+	}
+
+	err = server.ListenAndServe()
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to start listening with router: %v\n", err)
@@ -0,0 +1,301 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/album/helper"
+	tagHelper "github.com/muzzarellimj/grace-material-api/internal/api/tag/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/album"
+)
+
+const errorMessage string = "Unable to fetch album metadata and map to supported data structure."
+
+// An album request handler, which holds the dependencies shared between album routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create an album request handler with an album repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetAlbum(context *gin.Context) {
+	idArg := context.Query("id")
+
+	if len(idArg) == 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	idSlice := strings.Split(idArg, ",")
+
+	if len(idSlice) == 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	var constraintSlice []string
+
+	for _, id := range idSlice {
+		constraintSlice = append(constraintSlice, fmt.Sprintf("id=%s", id))
+	}
+
+	albumSlice, errorSlice := handler.repository.FetchAlbumSlice(constraintSlice)
+
+	if len(errorSlice) != 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(albumSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	if slices.Contains(strings.Split(context.Query("include"), ","), "rating") {
+		for index := range albumSlice {
+			rating, err := handler.repository.FetchAlbumRating(albumSlice[index].ID)
+
+			if err != nil {
+				context.IndentedJSON(http.StatusInternalServerError, gin.H{
+					"status":  http.StatusInternalServerError,
+					"message": errorMessage,
+				})
+
+				return
+			}
+
+			albumSlice[index].Rating = &rating
+		}
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   albumSlice,
+	})
+}
+
+func (handler *Handler) HandlePutAlbum(context *gin.Context) {
+	var album model.AlbumFragment
+
+	err := context.BindJSON(&album)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to album model.",
+		})
+
+		return
+	}
+
+	id, err := handler.repository.UpdateAlbumFragment(album)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to update album fragment.",
+		})
+
+		return
+	}
+
+	if id == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data": map[string]any{
+			"id": id,
+		},
+	})
+}
+
+// Handle an album request, by MusicBrainz release MBID in query parameter 'id' or release group MBID in query
+// parameter 'group' (stored as its earliest official release), responding with the numeric identifier of the album.
+func (handler *Handler) HandlePostAlbum(context *gin.Context) {
+	idArg := context.Query("id")
+	groupArg := context.Query("group")
+
+	var storedAlbumId int
+	var created bool
+	var err error
+
+	switch {
+	case helper.IsMBID(idArg):
+		storedAlbumId, created, err = handler.repository.StoreAlbum(idArg)
+	case idArg == "" && helper.IsMBID(groupArg):
+		storedAlbumId, created, err = handler.repository.StoreAlbumGroup(groupArg)
+	default:
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid MusicBrainz identifier argument '%s' provided in query parameter 'id' or 'group'.", idArg+groupArg),
+		})
+
+		return
+	}
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	respondStored(context, storedAlbumId, created)
+}
+
+// Handle a scanned barcode (a UPC-A or EAN-13) provided in query parameter 'barcode', which is matched against stored
+// albums and then MusicBrainz releases, responding with the numeric identifier of the album.
+func (handler *Handler) HandlePostAlbumScan(context *gin.Context) {
+	barcode, ok := helper.CleanBarcode(context.Query("barcode"))
+
+	if !ok {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid UPC or EAN barcode argument '%s' provided in query parameter 'barcode'.", context.Query("barcode")),
+		})
+
+		return
+	}
+
+	storedAlbumId, created, err := handler.repository.StoreAlbumBarcode(barcode)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	respondStored(context, storedAlbumId, created)
+}
+
+// Respond with the numeric identifier of a stored album, where no content indicates no MusicBrainz release matched.
+func respondStored(context *gin.Context, storedAlbumId int, created bool) {
+	if storedAlbumId == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	status := http.StatusOK
+
+	if created {
+		status = http.StatusCreated
+	}
+
+	context.IndentedJSON(status, gin.H{
+		"status": status,
+		"data": map[string]any{
+			"id": storedAlbumId,
+		},
+	})
+}
+
+func (handler *Handler) HandleGetAlbumExistenceSlice(context *gin.Context) {
+	var constraint string
+
+	if tag := context.Query("tag"); tag != "" {
+		principal, ok := middleware.Principal(context)
+
+		if !ok {
+			context.IndentedJSON(http.StatusUnauthorized, gin.H{
+				"status":  http.StatusUnauthorized,
+				"message": "Unable to filter by tag without an authenticated principal.",
+			})
+
+			return
+		}
+
+		constraint = tagHelper.Constraint("id", material.TypeAlbum, principal.Subject, tag)
+	}
+
+	albumExistenceSlice, errSlice := handler.repository.FetchAlbumExistenceSlice(constraint)
+
+	if len(errSlice) != 0 {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(albumExistenceSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   albumExistenceSlice,
+	})
+}
+
+func (handler *Handler) HandleGetAlbumSearch(context *gin.Context) {
+	query := context.Query("query")
+
+	if query == "" {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid search term '%s' provided in query parameter 'query'.", context.Query("query")),
+		})
+
+		return
+	}
+
+	mappedResults, err := handler.repository.SearchAlbums(query)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to fetch album metadata and map to supported data structure.",
+		})
+
+		return
+	}
+
+	if len(mappedResults) > 0 {
+		context.IndentedJSON(http.StatusOK, gin.H{
+			"status": http.StatusOK,
+			"data":   mappedResults,
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
@@ -0,0 +1,271 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/album"
+	reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+)
+
+func (repository *Repository) FetchAlbum(constraint string) (model.Album, error) {
+	zero := model.Album{}
+
+	albumFragment, err := service.FetchFragment[model.AlbumFragment](repository.connection, database.TableAlbumFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch album with constraint '%s': %v", constraint, err)
+
+		return zero, err
+	}
+
+	if albumFragment.ID == 0 {
+		return zero, nil
+	}
+
+	artistFragmentSlice, err := repository.fetchArtistFragmentSlice(albumFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch artists related to album '%d': %v", albumFragment.ID, err)
+	}
+
+	labelSlice, err := repository.fetchLabelSlice(albumFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch labels related to album '%d': %v", albumFragment.ID, err)
+	}
+
+	genreFragmentSlice, err := repository.fetchGenreFragmentSlice(albumFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch genres related to album '%d': %v", albumFragment.ID, err)
+	}
+
+	trackFragmentSlice, err := repository.fetchTrackFragmentSlice(albumFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch tracks of album '%d': %v", albumFragment.ID, err)
+	}
+
+	album := mapAlbum(albumFragment, artistFragmentSlice, labelSlice, genreFragmentSlice, trackFragmentSlice)
+
+	return album, nil
+}
+
+func (repository *Repository) FetchAlbumSlice(constraintSlice []string) ([]model.Album, []error) {
+	var albumSlice []model.Album
+	var errorSlice []error
+
+	for _, constraint := range constraintSlice {
+		album, err := repository.FetchAlbum(constraint)
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch and map album with constraint '%s': %v", constraint, err)
+
+			errorSlice = append(errorSlice, err)
+		}
+
+		if album.ID != 0 {
+			albumSlice = append(albumSlice, album)
+		}
+	}
+
+	return albumSlice, errorSlice
+}
+
+func (repository *Repository) FetchAlbumExistenceSlice(constraint string) ([]int, []error) {
+	idSlice, err := service.FetchExistenceSlice(repository.connection, database.TableAlbumFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence slice: %v", err)
+
+		return []int{}, []error{err}
+	}
+
+	if len(idSlice) == 0 {
+		repository.logger.Print("Existence slice appears to be empty.")
+
+		return []int{}, nil
+	}
+
+	return idSlice, nil
+}
+
+// Fetch the credited artists of an album in credit order.
+func (repository *Repository) fetchArtistFragmentSlice(albumFragment model.AlbumFragment) ([]model.AlbumArtistFragment, error) {
+	zero := []model.AlbumArtistFragment{}
+
+	albumArtistRelationshipSlice, err := service.FetchRelationshipSlice[model.AlbumArtistRelationship](repository.connection, database.TableAlbumArtistRelationships, fmt.Sprintf("album=%d", albumFragment.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between album '%d' and artists: %v", albumFragment.ID, err)
+
+		return zero, err
+	}
+
+	slices.SortStableFunc(albumArtistRelationshipSlice, func(a model.AlbumArtistRelationship, b model.AlbumArtistRelationship) int {
+		return cmp.Compare(a.Position, b.Position)
+	})
+
+	var artistFragmentSlice []model.AlbumArtistFragment
+
+	for _, relationship := range albumArtistRelationshipSlice {
+		artistFragment, err := service.FetchFragment[model.AlbumArtistFragment](repository.connection, database.TableAlbumArtistFragments, fmt.Sprintf("id=%d", relationship.Artist))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch artist '%d': %v", relationship.Artist, err)
+		}
+
+		if artistFragment.ID != 0 {
+			artistFragmentSlice = append(artistFragmentSlice, artistFragment)
+		}
+	}
+
+	return artistFragmentSlice, nil
+}
+
+// Fetch the labels of an album, each with the catalog number of the album on that label.
+func (repository *Repository) fetchLabelSlice(albumFragment model.AlbumFragment) ([]model.AlbumLabel, error) {
+	zero := []model.AlbumLabel{}
+
+	albumLabelRelationshipSlice, err := service.FetchRelationshipSlice[model.AlbumLabelRelationship](repository.connection, database.TableAlbumLabelRelationships, fmt.Sprintf("album=%d", albumFragment.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between album '%d' and labels: %v", albumFragment.ID, err)
+
+		return zero, err
+	}
+
+	var labelSlice []model.AlbumLabel
+
+	for _, relationship := range albumLabelRelationshipSlice {
+		labelFragment, err := service.FetchFragment[model.AlbumLabelFragment](repository.connection, database.TableAlbumLabelFragments, fmt.Sprintf("id=%d", relationship.Label))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch label '%d': %v", relationship.Label, err)
+		}
+
+		if labelFragment.ID != 0 {
+			labelSlice = append(labelSlice, model.AlbumLabel{
+				AlbumLabelFragment: labelFragment,
+				CatalogNumber:      relationship.CatalogNumber,
+			})
+		}
+	}
+
+	return labelSlice, nil
+}
+
+func (repository *Repository) fetchGenreFragmentSlice(albumFragment model.AlbumFragment) ([]model.AlbumGenreFragment, error) {
+	zero := []model.AlbumGenreFragment{}
+
+	albumGenreRelationshipSlice, err := service.FetchRelationshipSlice[model.AlbumGenreRelationship](repository.connection, database.TableAlbumGenreRelationships, fmt.Sprintf("album=%d", albumFragment.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between album '%d' and genres: %v", albumFragment.ID, err)
+
+		return zero, err
+	}
+
+	var genreFragmentSlice []model.AlbumGenreFragment
+
+	for _, relationship := range albumGenreRelationshipSlice {
+		genreFragment, err := service.FetchFragment[model.AlbumGenreFragment](repository.connection, database.TableAlbumGenreFragments, fmt.Sprintf("id=%d", relationship.Genre))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch genre '%d': %v", relationship.Genre, err)
+		}
+
+		if genreFragment.ID != 0 {
+			genreFragmentSlice = append(genreFragmentSlice, genreFragment)
+		}
+	}
+
+	return genreFragmentSlice, nil
+}
+
+// Fetch the track list of an album in disc and track position order.
+func (repository *Repository) fetchTrackFragmentSlice(albumFragment model.AlbumFragment) ([]model.AlbumTrackFragment, error) {
+	trackFragmentSlice, err := service.FetchFragmentSlice[model.AlbumTrackFragment](repository.connection, database.TableAlbumTrackFragments, fmt.Sprintf("album=%d", albumFragment.ID))
+
+	if err != nil {
+		return []model.AlbumTrackFragment{}, err
+	}
+
+	slices.SortStableFunc(trackFragmentSlice, func(a model.AlbumTrackFragment, b model.AlbumTrackFragment) int {
+		if a.Disc != b.Disc {
+			return cmp.Compare(a.Disc, b.Disc)
+		}
+
+		return cmp.Compare(a.Position, b.Position)
+	})
+
+	return trackFragmentSlice, nil
+}
+
+func mapAlbum(albumFragment model.AlbumFragment, artistFragmentSlice []model.AlbumArtistFragment, labelSlice []model.AlbumLabel, genreFragmentSlice []model.AlbumGenreFragment, trackFragmentSlice []model.AlbumTrackFragment) model.Album {
+	if artistFragmentSlice == nil {
+		artistFragmentSlice = make([]model.AlbumArtistFragment, 0)
+	}
+
+	if labelSlice == nil {
+		labelSlice = make([]model.AlbumLabel, 0)
+	}
+
+	if genreFragmentSlice == nil {
+		genreFragmentSlice = make([]model.AlbumGenreFragment, 0)
+	}
+
+	if trackFragmentSlice == nil {
+		trackFragmentSlice = make([]model.AlbumTrackFragment, 0)
+	}
+
+	return model.Album{
+		ID:             albumFragment.ID,
+		Title:          albumFragment.Title,
+		ArtistCredit:   albumFragment.ArtistCredit,
+		Artists:        artistFragmentSlice,
+		Labels:         labelSlice,
+		Genres:         genreFragmentSlice,
+		Tracks:         trackFragmentSlice,
+		ReleaseDate:    albumFragment.ReleaseDate,
+		Country:        albumFragment.Country,
+		Format:         albumFragment.Format,
+		Discs:          albumFragment.Discs,
+		Runtime:        albumFragment.Runtime,
+		Barcode:        albumFragment.Barcode,
+		Image:          albumFragment.Image,
+		Reference:      albumFragment.Reference,
+		GroupReference: albumFragment.GroupReference,
+	}
+}
+
+// Fetch the rating aggregate (i.e., review count and average rating) of an album.
+//
+// Return: rating aggregate and nil with success, empty rating aggregate and error without.
+func (repository *Repository) FetchAlbumRating(id int) (reviewModel.RatingAggregate, error) {
+	statement, err := database.CreateQuery("COUNT(rating) AS count, COALESCE(AVG(rating), 0)::FLOAT8 AS average", database.TableAlbumReviewFragments, fmt.Sprintf("album=%d", id), "")
+
+	if err != nil {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch rating aggregate of album '%d': %v", id, err)
+
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	response, err := database.MapQueryResponse[reviewModel.RatingAggregate](rows)
+
+	if err != nil || len(response) == 0 {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	return response[0], nil
+}
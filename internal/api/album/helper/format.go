@@ -0,0 +1,224 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	model "github.com/muzzarellimj/grace-material-api/internal/model/album"
+	MBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/musicbrainz.org"
+)
+
+// The maximum number of MusicBrainz tags stored as genres of an album without MusicBrainz genres.
+const MaximumTagGenres int = 5
+
+var mbidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+func MapSearchResultSlice(input []MBModel.MBReleaseGroup) []model.AlbumSearchResult {
+	var resultSlice []model.AlbumSearchResult
+
+	for _, result := range input {
+		mappedResult := model.AlbumSearchResult{
+			ID:           result.ID,
+			Title:        result.Title,
+			ArtistCredit: FormatArtistCredit(result.ArtistCredit),
+			Type:         result.PrimaryType,
+			ReleaseDate:  ParseReleaseDate(result.FirstReleaseDate),
+		}
+
+		resultSlice = append(resultSlice, mappedResult)
+	}
+
+	return resultSlice
+}
+
+// Validate a MusicBrainz identifier (MBID), which is a lowercase UUID.
+//
+// Return: whether the value is an MBID.
+func IsMBID(value string) bool {
+	return mbidPattern.MatchString(value)
+}
+
+// Format the artist credit of a release or release group as printed (e.g., "Simon & Garfunkel"), by joining each
+// credited name with its join phrase.
+//
+// Return: formatted artist credit, or an empty string without credits.
+func FormatArtistCredit(credits []MBModel.MBArtistCredit) string {
+	var builder strings.Builder
+
+	for _, credit := range credits {
+		builder.WriteString(credit.Name)
+		builder.WriteString(credit.JoinPhrase)
+	}
+
+	return builder.String()
+}
+
+// Format the media of a release as printed by MusicBrainz, where consecutive media of the same format are counted
+// (e.g., "2×CD" or "CD + DVD-Video").
+//
+// Return: formatted media, or an empty string without media.
+func FormatMedia(media []MBModel.MBMedium) string {
+	var partSlice []string
+
+	for index := 0; index < len(media); {
+		format := media[index].Format
+
+		if format == "" {
+			format = "(unknown)"
+		}
+
+		count := 1
+
+		for index+count < len(media) && media[index+count].Format == media[index].Format {
+			count++
+		}
+
+		if count > 1 {
+			format = fmt.Sprintf("%d×%s", count, format)
+		}
+
+		partSlice = append(partSlice, format)
+		index += count
+	}
+
+	return strings.Join(partSlice, " + ")
+}
+
+// Parse a MusicBrainz release date, which may be partial (i.e., a year, or a year and month), where a partial date is
+// parsed as the first day of its period.
+//
+// Return: parsed timestamp with success, 0 without.
+func ParseReleaseDate(value string) int64 {
+	for _, layout := range []string{"2006-01-02", "2006-01", "2006"} {
+		date, err := time.Parse(layout, value)
+
+		if err == nil {
+			return date.Unix()
+		}
+	}
+
+	return 0
+}
+
+// Select the representative release of a release group, which is the earliest official release, or the earliest
+// release of any status without an official release, where releases without a date are considered last.
+//
+// Return: release MBID, or an empty string without releases.
+func SelectRelease(releases []MBModel.MBReleaseResponse) string {
+	if len(releases) == 0 {
+		return ""
+	}
+
+	candidateSlice := slices.Clone(releases)
+
+	slices.SortStableFunc(candidateSlice, func(a MBModel.MBReleaseResponse, b MBModel.MBReleaseResponse) int {
+		if (a.Status == "Official") != (b.Status == "Official") {
+			if a.Status == "Official" {
+				return -1
+			}
+
+			return 1
+		}
+
+		if (a.Date == "") != (b.Date == "") {
+			if a.Date == "" {
+				return 1
+			}
+
+			return -1
+		}
+
+		return cmp.Compare(a.Date, b.Date)
+	})
+
+	return candidateSlice[0].ID
+}
+
+// Extract the genre names of a release, which are its MusicBrainz genres, or its most-voted MusicBrainz tags (see
+// MaximumTagGenres) without genres.
+//
+// Return: genre name slice, which may be empty.
+func ExtractGenreSlice(release MBModel.MBReleaseResponse) []string {
+	var nameSlice []string
+
+	for _, genre := range release.Genres {
+		nameSlice = append(nameSlice, strings.ToLower(genre.Name))
+	}
+
+	if len(nameSlice) > 0 {
+		return nameSlice
+	}
+
+	tagSlice := slices.Clone(release.Tags)
+
+	slices.SortStableFunc(tagSlice, func(a MBModel.MBTag, b MBModel.MBTag) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+
+	for _, tag := range tagSlice {
+		if len(nameSlice) == MaximumTagGenres {
+			break
+		}
+
+		if tag.Count > 0 {
+			nameSlice = append(nameSlice, strings.ToLower(tag.Name))
+		}
+	}
+
+	return nameSlice
+}
+
+// Extract the length of a track, which falls back to the length of its recording without a track length.
+//
+// Return: length in seconds, or 0 without a length.
+func ExtractTrackLength(track MBModel.MBTrack) int {
+	length := track.Length
+
+	if length == 0 {
+		length = track.Recording.Length
+	}
+
+	return length / 1000
+}
+
+// Clean and validate a barcode, by removing spaces and hyphens, where a valid barcode is a UPC-A (12 digits) or EAN-13
+// (13 digits) with a correct check digit.
+//
+// Return: cleaned barcode and true with a valid barcode, an empty string and false without.
+func CleanBarcode(value string) (string, bool) {
+	barcode := strings.NewReplacer(" ", "", "-", "").Replace(value)
+
+	if len(barcode) != 12 && len(barcode) != 13 {
+		return "", false
+	}
+
+	sum := 0
+
+	for index := len(barcode) - 2; index >= 0; index-- {
+		digit := barcode[index]
+
+		if digit < '0' || digit > '9' {
+			return "", false
+		}
+
+		weight := 1
+
+		if (len(barcode)-2-index)%2 == 0 {
+			weight = 3
+		}
+
+		sum += int(digit-'0') * weight
+	}
+
+	check := barcode[len(barcode)-1]
+
+	if check < '0' || check > '9' || int(check-'0') != (10-sum%10)%10 {
+		return "", false
+	}
+
+	return barcode, true
+}
@@ -0,0 +1,152 @@
+package helper
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/album"
+	MBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/musicbrainz.org"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+// The MusicBrainz and Cover Art Archive operations required by the album repository, which are satisfied by a
+// MusicBrainz client or a fake.
+type Provider interface {
+	MBGetRelease(id string) (MBModel.MBReleaseResponse, error)
+	MBGetReleaseGroup(id string) (MBModel.MBReleaseGroupResponse, error)
+	MBSearchReleaseGroup(query string) (MBModel.MBReleaseGroupSearchResponse, error)
+	MBSearchBarcode(barcode string) (MBModel.MBReleaseSearchResponse, error)
+	CAAFrontCover(id string) string
+}
+
+// An album repository, which fetches, stores, and updates albums, with their track lists, in the provided database
+// pool with metadata from the provided MusicBrainz provider.
+type Repository struct {
+	connection database.PgxPool
+	client     Provider
+	logger     *log.Logger
+}
+
+// Create an album repository with a database pool, MusicBrainz provider, and logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, client Provider, logger *log.Logger) *Repository {
+	return &Repository{
+		connection: connection,
+		client:     client,
+		logger:     logger,
+	}
+}
+
+// Search MusicBrainz release groups by title or artist, and map results to the supported search result model.
+//
+// Return: mapped search result slice and nil with success, empty slice and error without.
+func (repository *Repository) SearchAlbums(query string) ([]model.AlbumSearchResult, error) {
+	results, err := repository.client.MBSearchReleaseGroup(query)
+
+	if err != nil {
+		repository.logger.Printf("Unable to search MusicBrainz release groups with query '%s': %v", query, err)
+
+		return []model.AlbumSearchResult{}, err
+	}
+
+	return MapSearchResultSlice(results.ReleaseGroups), nil
+}
+
+// Store an album with a provided MusicBrainz release MBID, with its track list, unless an album with that reference
+// already exists.
+//
+// Return: numeric identifier, whether the album was newly stored, and nil with success; 0, false, and error without.
+// A 0 identifier without error indicates no MusicBrainz release matched the identifier.
+func (repository *Repository) StoreAlbum(id string) (int, bool, error) {
+	existingAlbum, err := service.FetchFragment[model.AlbumFragment](repository.connection, database.TableAlbumFragments, fmt.Sprintf("reference='%s'", util.FormatPSQLString(id)))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing album '%s': %v", id, err)
+
+		return 0, false, err
+	}
+
+	if existingAlbum.ID != 0 {
+		return existingAlbum.ID, false, nil
+	}
+
+	release, err := repository.client.MBGetRelease(id)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch release '%s' MusicBrainz record: %v", id, err)
+
+		return 0, false, err
+	}
+
+	if release.ID == "" {
+		return 0, false, nil
+	}
+
+	albumId, err := repository.ProcessAlbumStorage(release)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	return albumId, true, nil
+}
+
+// Store an album with a provided MusicBrainz release group MBID, by its representative release (see SelectRelease).
+//
+// Return: numeric identifier, whether the album was newly stored, and nil with success; 0, false, and error without.
+// A 0 identifier without error indicates no MusicBrainz release group (or release of it) matched the identifier.
+func (repository *Repository) StoreAlbumGroup(id string) (int, bool, error) {
+	group, err := repository.client.MBGetReleaseGroup(id)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch release group '%s' MusicBrainz record: %v", id, err)
+
+		return 0, false, err
+	}
+
+	release := SelectRelease(group.Releases)
+
+	if release == "" {
+		return 0, false, nil
+	}
+
+	return repository.StoreAlbum(release)
+}
+
+// Store an album with a provided barcode (i.e., a cleaned UPC or EAN; see CleanBarcode), unless an album with that
+// barcode already exists.
+//
+// Return: numeric identifier, whether the album was newly stored, and nil with success; 0, false, and error without.
+// A 0 identifier without error indicates no MusicBrainz release matched the barcode.
+func (repository *Repository) StoreAlbumBarcode(barcode string) (int, bool, error) {
+	existingAlbum, err := service.FetchFragment[model.AlbumFragment](repository.connection, database.TableAlbumFragments, fmt.Sprintf("barcode='%s'", util.FormatPSQLString(barcode)))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing album with barcode '%s': %v", barcode, err)
+
+		return 0, false, err
+	}
+
+	if existingAlbum.ID != 0 {
+		return existingAlbum.ID, false, nil
+	}
+
+	results, err := repository.client.MBSearchBarcode(barcode)
+
+	if err != nil {
+		repository.logger.Printf("Unable to search MusicBrainz releases with barcode '%s': %v", barcode, err)
+
+		return 0, false, err
+	}
+
+	for _, release := range results.Releases {
+		if release.Barcode == barcode {
+			return repository.StoreAlbum(release.ID)
+		}
+	}
+
+	return 0, false, nil
+}
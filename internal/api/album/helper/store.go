@@ -0,0 +1,220 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/album"
+	MBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/musicbrainz.org"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+func (repository *Repository) ProcessAlbumStorage(release MBModel.MBReleaseResponse) (int, error) {
+	albumId, err := repository.storeAlbumFragment(release)
+
+	if err != nil {
+		repository.logger.Printf("Unable to store album '%s': %v", release.ID, err)
+
+		return 0, err
+	}
+
+	repository.processArtistStorage(albumId, release.ArtistCredit)
+	repository.processLabelStorage(albumId, release.LabelInfo)
+
+	genreIdSlice := repository.processGenreFragmentSlice(ExtractGenreSlice(release))
+
+	service.StoreRelationshipSlice(repository.connection, database.TableAlbumGenreRelationships, database.PropertiesAlbumGenreRelationships, service.RelationshipSliceArgument{
+		SourceName:          "album",
+		SourceArgument:      albumId,
+		DestinationName:     "genre",
+		DestinationArgument: genreIdSlice,
+	})
+
+	repository.processTrackStorage(albumId, release)
+
+	return albumId, nil
+}
+
+func (repository *Repository) storeAlbumFragment(release MBModel.MBReleaseResponse) (int, error) {
+	tracks := 0
+	length := 0
+
+	for _, medium := range release.Media {
+		tracks += medium.TrackCount
+
+		for _, track := range medium.Tracks {
+			length += ExtractTrackLength(track)
+		}
+	}
+
+	image := ""
+
+	if release.CoverArtArchive.Front {
+		image = repository.client.CAAFrontCover(release.ID)
+	}
+
+	albumId, err := service.StoreFragment(repository.connection, database.TableAlbumFragments, database.PropertiesAlbumFragments, pgx.NamedArgs{
+		"title":           release.Title,
+		"artist_credit":   FormatArtistCredit(release.ArtistCredit),
+		"release_date":    ParseReleaseDate(release.Date),
+		"country":         release.Country,
+		"format":          FormatMedia(release.Media),
+		"discs":           len(release.Media),
+		"tracks":          tracks,
+		"runtime":         (length + 30) / 60,
+		"barcode":         release.Barcode,
+		"image":           image,
+		"reference":       release.ID,
+		"group_reference": release.ReleaseGroup.ID,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store album '%s' fragment: %v", release.ID, err)
+
+		return 0, err
+	}
+
+	return albumId, nil
+}
+
+// Store the credited artists of an album, reusing existing artists by MBID, with the position of each in the credit.
+func (repository *Repository) processArtistStorage(albumId int, credits []MBModel.MBArtistCredit) {
+	for position, credit := range credits {
+		if credit.Artist.ID == "" {
+			continue
+		}
+
+		existingArtistFragment, err := service.FetchFragment[model.AlbumArtistFragment](repository.connection, database.TableAlbumArtistFragments, fmt.Sprintf("reference='%s'", util.FormatPSQLString(credit.Artist.ID)))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch existing artist '%s' fragment: %v", credit.Artist.ID, err)
+
+			continue
+		}
+
+		artistId := existingArtistFragment.ID
+
+		if artistId == 0 {
+			artistId, err = service.StoreFragment(repository.connection, database.TableAlbumArtistFragments, database.PropertiesAlbumArtistFragments, pgx.NamedArgs{
+				"name":      credit.Artist.Name,
+				"sort_name": credit.Artist.SortName,
+				"reference": credit.Artist.ID,
+			})
+
+			if err != nil {
+				repository.logger.Printf("Unable to store new artist '%s' fragment: %v", credit.Artist.ID, err)
+
+				continue
+			}
+		}
+
+		err = service.StoreRelationship(repository.connection, database.TableAlbumArtistRelationships, database.PropertiesAlbumArtistRelationships, pgx.NamedArgs{
+			"album":    albumId,
+			"artist":   artistId,
+			"position": position,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store relationship between album '%d' and artist '%d': %v", albumId, artistId, err)
+		}
+	}
+}
+
+// Store the labels of an album, reusing existing labels by MBID, with the catalog number of the album on each.
+func (repository *Repository) processLabelStorage(albumId int, labels []MBModel.MBLabelInfo) {
+	for _, info := range labels {
+		if info.Label.ID == "" {
+			continue
+		}
+
+		existingLabelFragment, err := service.FetchFragment[model.AlbumLabelFragment](repository.connection, database.TableAlbumLabelFragments, fmt.Sprintf("reference='%s'", util.FormatPSQLString(info.Label.ID)))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch existing label '%s' fragment: %v", info.Label.ID, err)
+
+			continue
+		}
+
+		labelId := existingLabelFragment.ID
+
+		if labelId == 0 {
+			labelId, err = service.StoreFragment(repository.connection, database.TableAlbumLabelFragments, database.PropertiesAlbumLabelFragments, pgx.NamedArgs{
+				"name":      info.Label.Name,
+				"reference": info.Label.ID,
+			})
+
+			if err != nil {
+				repository.logger.Printf("Unable to store new label '%s' fragment: %v", info.Label.ID, err)
+
+				continue
+			}
+		}
+
+		err = service.StoreRelationship(repository.connection, database.TableAlbumLabelRelationships, database.PropertiesAlbumLabelRelationships, pgx.NamedArgs{
+			"album":          albumId,
+			"label":          labelId,
+			"catalog_number": info.CatalogNumber,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store relationship between album '%d' and label '%d': %v", albumId, labelId, err)
+		}
+	}
+}
+
+func (repository *Repository) processGenreFragmentSlice(names []string) []int {
+	var genreIdSlice []int
+
+	for _, name := range names {
+		existingGenreFragment, err := service.FetchFragment[model.AlbumGenreFragment](repository.connection, database.TableAlbumGenreFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(name)))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch existing genre '%s' fragment: %v", name, err)
+
+			continue
+		}
+
+		if existingGenreFragment.ID != 0 {
+			genreIdSlice = append(genreIdSlice, existingGenreFragment.ID)
+
+			continue
+		}
+
+		genreId, err := service.StoreFragment(repository.connection, database.TableAlbumGenreFragments, database.PropertiesAlbumGenreFragments, pgx.NamedArgs{
+			"name": name,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store new genre '%s' fragment: %v", name, err)
+		}
+
+		if genreId != 0 {
+			genreIdSlice = append(genreIdSlice, genreId)
+		}
+	}
+
+	return genreIdSlice
+}
+
+// Store the track list of an album, where the disc of each track is the position of its medium.
+func (repository *Repository) processTrackStorage(albumId int, release MBModel.MBReleaseResponse) {
+	for _, medium := range release.Media {
+		for _, track := range medium.Tracks {
+			_, err := service.StoreFragment(repository.connection, database.TableAlbumTrackFragments, database.PropertiesAlbumTrackFragments, pgx.NamedArgs{
+				"album":     albumId,
+				"disc":      medium.Position,
+				"position":  track.Position,
+				"number":    track.Number,
+				"title":     track.Title,
+				"length":    ExtractTrackLength(track),
+				"reference": track.ID,
+			})
+
+			if err != nil {
+				repository.logger.Printf("Unable to store track '%d' of disc '%d' of album '%s' fragment: %v", track.Position, medium.Position, release.ID, err)
+			}
+		}
+	}
+}
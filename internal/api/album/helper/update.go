@@ -0,0 +1,35 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/album"
+)
+
+func (repository *Repository) UpdateAlbumFragment(album model.AlbumFragment) (int, error) {
+	id, err := service.UpdateFragment(repository.connection, database.TableAlbumFragments, database.PropertiesAlbumFragments, fmt.Sprintf("id=%d", album.ID), pgx.NamedArgs{
+		"title":           album.Title,
+		"artist_credit":   album.ArtistCredit,
+		"release_date":    album.ReleaseDate,
+		"country":         album.Country,
+		"format":          album.Format,
+		"discs":           album.Discs,
+		"tracks":          album.Tracks,
+		"runtime":         album.Runtime,
+		"barcode":         album.Barcode,
+		"image":           album.Image,
+		"reference":       album.Reference,
+		"group_reference": album.GroupReference,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to update album '%d' fragment: %v", album.ID, err)
+
+		return 0, err
+	}
+
+	return id, nil
+}
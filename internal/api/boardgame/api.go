@@ -0,0 +1,263 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/boardgame/helper"
+	tagHelper "github.com/muzzarellimj/grace-material-api/internal/api/tag/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/boardgame"
+)
+
+const errorMessage string = "Unable to fetch board game metadata and map to supported data structure."
+
+// A board game request handler, which holds the dependencies shared between board game routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a board game request handler with a board game repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetBoardGame(context *gin.Context) {
+	idArg := context.Query("id")
+
+	if len(idArg) == 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	idSlice := strings.Split(idArg, ",")
+
+	if len(idSlice) == 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	var constraintSlice []string
+
+	for _, id := range idSlice {
+		constraintSlice = append(constraintSlice, fmt.Sprintf("id=%s", id))
+	}
+
+	boardGameSlice, errorSlice := handler.repository.FetchBoardGameSlice(constraintSlice)
+
+	if len(errorSlice) != 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(boardGameSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	if slices.Contains(strings.Split(context.Query("include"), ","), "rating") {
+		for index := range boardGameSlice {
+			rating, err := handler.repository.FetchBoardGameRating(boardGameSlice[index].ID)
+
+			if err != nil {
+				context.IndentedJSON(http.StatusInternalServerError, gin.H{
+					"status":  http.StatusInternalServerError,
+					"message": errorMessage,
+				})
+
+				return
+			}
+
+			boardGameSlice[index].Rating = &rating
+		}
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   boardGameSlice,
+	})
+}
+
+func (handler *Handler) HandlePutBoardGame(context *gin.Context) {
+	var boardGame model.BoardGameFragment
+
+	err := context.BindJSON(&boardGame)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to board game model.",
+		})
+
+		return
+	}
+
+	id, err := handler.repository.UpdateBoardGameFragment(boardGame)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to update board game fragment.",
+		})
+
+		return
+	}
+
+	if id == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data": map[string]any{
+			"id": id,
+		},
+	})
+}
+
+func (handler *Handler) HandlePostBoardGame(context *gin.Context) {
+	idArg := context.Query("id")
+
+	if len(idArg) == 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	storedBoardGameId, created, err := handler.repository.StoreBoardGame(idArg)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if storedBoardGameId == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	if !created {
+		context.IndentedJSON(http.StatusOK, gin.H{
+			"status": http.StatusOK,
+			"data": map[string]any{
+				"id": storedBoardGameId,
+			},
+		})
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusCreated, gin.H{
+		"status": http.StatusCreated,
+		"data": map[string]any{
+			"id": storedBoardGameId,
+		},
+	})
+}
+
+func (handler *Handler) HandleGetBoardGameExistenceSlice(context *gin.Context) {
+	var constraint string
+
+	if tag := context.Query("tag"); tag != "" {
+		principal, ok := middleware.Principal(context)
+
+		if !ok {
+			context.IndentedJSON(http.StatusUnauthorized, gin.H{
+				"status":  http.StatusUnauthorized,
+				"message": "Unable to filter by tag without an authenticated principal.",
+			})
+
+			return
+		}
+
+		constraint = tagHelper.Constraint("id", material.TypeBoardGame, principal.Subject, tag)
+	}
+
+	boardGameExistenceSlice, errSlice := handler.repository.FetchBoardGameExistenceSlice(constraint)
+
+	if len(errSlice) != 0 {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(boardGameExistenceSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   boardGameExistenceSlice,
+	})
+}
+
+func (handler *Handler) HandleGetBoardGameSearch(context *gin.Context) {
+	query := context.Query("query")
+
+	if query == "" {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid search term '%s' provided in query parameter 'query'.", context.Query("query")),
+		})
+
+		return
+	}
+
+	mappedResults, err := handler.repository.SearchBoardGames(query)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to fetch board game metadata and map to supported data structure.",
+		})
+
+		return
+	}
+
+	if len(mappedResults) > 0 {
+		context.IndentedJSON(http.StatusOK, gin.H{
+			"status": http.StatusOK,
+			"data":   mappedResults,
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
@@ -0,0 +1,169 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/boardgame"
+	reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+)
+
+func (repository *Repository) FetchBoardGame(constraint string) (model.BoardGame, error) {
+	zero := model.BoardGame{}
+
+	boardGameFragment, err := service.FetchFragment[model.BoardGameFragment](repository.connection, database.TableBoardGameFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch board game with constraint '%s': %v", constraint, err)
+
+		return zero, err
+	}
+
+	if boardGameFragment.ID == 0 {
+		return zero, nil
+	}
+
+	designerFragmentSlice, err := fetchLinkFragmentSlice[model.BoardGameDesignerFragment](repository, designerLinkage, boardGameFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch designers related to board game '%d': %v", boardGameFragment.ID, err)
+	}
+
+	publisherFragmentSlice, err := fetchLinkFragmentSlice[model.BoardGamePublisherFragment](repository, publisherLinkage, boardGameFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch publishers related to board game '%d': %v", boardGameFragment.ID, err)
+	}
+
+	mechanicFragmentSlice, err := fetchLinkFragmentSlice[model.BoardGameMechanicFragment](repository, mechanicLinkage, boardGameFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch mechanics related to board game '%d': %v", boardGameFragment.ID, err)
+	}
+
+	categoryFragmentSlice, err := fetchLinkFragmentSlice[model.BoardGameCategoryFragment](repository, categoryLinkage, boardGameFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch categories related to board game '%d': %v", boardGameFragment.ID, err)
+	}
+
+	boardGame := model.BoardGame{
+		ID:          boardGameFragment.ID,
+		Title:       boardGameFragment.Title,
+		Description: boardGameFragment.Description,
+		Designers:   designerFragmentSlice,
+		Publishers:  publisherFragmentSlice,
+		Mechanics:   mechanicFragmentSlice,
+		Categories:  categoryFragmentSlice,
+		ReleaseDate: boardGameFragment.ReleaseDate,
+		MinPlayers:  boardGameFragment.MinPlayers,
+		MaxPlayers:  boardGameFragment.MaxPlayers,
+		PlayingTime: boardGameFragment.PlayingTime,
+		MinPlayTime: boardGameFragment.MinPlayTime,
+		MaxPlayTime: boardGameFragment.MaxPlayTime,
+		MinAge:      boardGameFragment.MinAge,
+		Image:       boardGameFragment.Image,
+		Reference:   boardGameFragment.Reference,
+	}
+
+	return boardGame, nil
+}
+
+func (repository *Repository) FetchBoardGameSlice(constraintSlice []string) ([]model.BoardGame, []error) {
+	var boardGameSlice []model.BoardGame
+	var errorSlice []error
+
+	for _, constraint := range constraintSlice {
+		boardGame, err := repository.FetchBoardGame(constraint)
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch and map board game with constraint '%s': %v", constraint, err)
+
+			errorSlice = append(errorSlice, err)
+		}
+
+		if boardGame.ID != 0 {
+			boardGameSlice = append(boardGameSlice, boardGame)
+		}
+	}
+
+	return boardGameSlice, errorSlice
+}
+
+func (repository *Repository) FetchBoardGameExistenceSlice(constraint string) ([]int, []error) {
+	idSlice, err := service.FetchExistenceSlice(repository.connection, database.TableBoardGameFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence slice: %v", err)
+
+		return []int{}, []error{err}
+	}
+
+	if len(idSlice) == 0 {
+		repository.logger.Print("Existence slice appears to be empty.")
+
+		return []int{}, nil
+	}
+
+	return idSlice, nil
+}
+
+// Fetch the fragments related to a board game through one linkage (e.g., designers), joined through its relationship
+// table.
+//
+// Return: fragment slice and nil with success, empty slice and error without.
+func fetchLinkFragmentSlice[F interface{}](repository *Repository, linkage linkage, boardGameFragment model.BoardGameFragment) ([]F, error) {
+	statement, err := database.CreateQuery(
+		"f.*",
+		fmt.Sprintf("%s f", linkage.table),
+		fmt.Sprintf("r.boardgame=%d", boardGameFragment.ID),
+		"",
+		fmt.Sprintf("JOIN %s r ON r.%s = f.id", linkage.bridge, linkage.column),
+	)
+
+	if err != nil {
+		return []F{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return []F{}, err
+	}
+
+	fragmentSlice, err := database.MapQueryResponse[F](rows)
+
+	if err != nil || fragmentSlice == nil {
+		return []F{}, err
+	}
+
+	return fragmentSlice, nil
+}
+
+// Fetch the rating aggregate (i.e., review count and average rating) of a board game.
+//
+// Return: rating aggregate and nil with success, empty rating aggregate and error without.
+func (repository *Repository) FetchBoardGameRating(id int) (reviewModel.RatingAggregate, error) {
+	statement, err := database.CreateQuery("COUNT(rating) AS count, COALESCE(AVG(rating), 0)::FLOAT8 AS average", database.TableBoardGameReviewFragments, fmt.Sprintf("boardgame=%d", id), "")
+
+	if err != nil {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch rating aggregate of board game '%d': %v", id, err)
+
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	response, err := database.MapQueryResponse[reviewModel.RatingAggregate](rows)
+
+	if err != nil || len(response) == 0 {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	return response[0], nil
+}
@@ -0,0 +1,87 @@
+package helper
+
+import (
+	"html"
+	"strings"
+	"time"
+
+	model "github.com/muzzarellimj/grace-material-api/internal/model/boardgame"
+	BGGModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/boardgamegeek.com"
+)
+
+// BoardGameGeek link types of the relationships stored with a board game.
+const (
+	LinkTypeDesigner  = "boardgamedesigner"
+	LinkTypePublisher = "boardgamepublisher"
+	LinkTypeMechanic  = "boardgamemechanic"
+	LinkTypeCategory  = "boardgamecategory"
+)
+
+func MapSearchResultSlice(input []BGGModel.BGGSearchItem) []model.BoardGameSearchResult {
+	var resultSlice []model.BoardGameSearchResult
+
+	for _, result := range input {
+		mappedResult := model.BoardGameSearchResult{
+			ID:          result.ID,
+			Title:       result.Name.Value,
+			ReleaseDate: ParseYearPublished(result.YearPublished.Value),
+		}
+
+		resultSlice = append(resultSlice, mappedResult)
+	}
+
+	return resultSlice
+}
+
+// Extract the primary name of a board game, which falls back to the first name without a primary name.
+//
+// Return: primary name, or an empty string without names.
+func ExtractPrimaryName(names []BGGModel.BGGName) string {
+	for _, name := range names {
+		if name.Type == "primary" {
+			return name.Value
+		}
+	}
+
+	if len(names) == 0 {
+		return ""
+	}
+
+	return names[0].Value
+}
+
+// Extract the links of a board game with a provided link type (e.g., LinkTypeDesigner), excluding placeholders such as
+// "(Uncredited)" and "(Public Domain)".
+//
+// Return: link slice, which may be empty.
+func ExtractLinkSlice(links []BGGModel.BGGLink, linkType string) []BGGModel.BGGLink {
+	var linkSlice []BGGModel.BGGLink
+
+	for _, link := range links {
+		if link.Type == linkType && !strings.HasPrefix(link.Value, "(") {
+			linkSlice = append(linkSlice, link)
+		}
+	}
+
+	return linkSlice
+}
+
+// Format a board game description, which BoardGameGeek escapes twice (e.g., "&amp;mdash;" and "&amp;#10;" for a line
+// break), by unescaping the remaining entities.
+//
+// Return: formatted description.
+func FormatDescription(description string) string {
+	return strings.TrimSpace(html.UnescapeString(description))
+}
+
+// Parse the year a board game was published, which may be negative for ancient games (e.g., Go), as the start of that
+// year.
+//
+// Return: parsed timestamp with a year, 0 without.
+func ParseYearPublished(year int) int64 {
+	if year == 0 {
+		return 0
+	}
+
+	return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+}
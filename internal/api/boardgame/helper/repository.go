@@ -0,0 +1,100 @@
+package helper
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/boardgame"
+	BGGModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/boardgamegeek.com"
+)
+
+// The BoardGameGeek operations required by the board game repository, which are satisfied by a BoardGameGeek client
+// or a fake.
+type Provider interface {
+	BGGGetBoardGame(id string) (BGGModel.BGGThing, error)
+	BGGSearchBoardGame(query string) (BGGModel.BGGSearchResponse, error)
+}
+
+// A board game repository, which fetches, stores, and updates board games in the provided database pool with metadata
+// from the provided BoardGameGeek provider.
+type Repository struct {
+	connection database.PgxPool
+	client     Provider
+	logger     *log.Logger
+}
+
+// Create a board game repository with a database pool, BoardGameGeek provider, and logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, client Provider, logger *log.Logger) *Repository {
+	return &Repository{
+		connection: connection,
+		client:     client,
+		logger:     logger,
+	}
+}
+
+// Search BoardGameGeek board games by name, and map results to the supported search result model.
+//
+// Return: mapped search result slice and nil with success, empty slice and error without.
+func (repository *Repository) SearchBoardGames(query string) ([]model.BoardGameSearchResult, error) {
+	results, err := repository.client.BGGSearchBoardGame(query)
+
+	if err != nil {
+		repository.logger.Printf("Unable to search BoardGameGeek board games with query '%s': %v", query, err)
+
+		return []model.BoardGameSearchResult{}, err
+	}
+
+	return MapSearchResultSlice(results.Items), nil
+}
+
+// Store a board game with a provided BoardGameGeek numeric identifier, unless a board game with that reference already
+// exists.
+//
+// Return: numeric identifier, whether the board game was newly stored, and nil with success; 0, false, and error
+// without. A 0 identifier without error indicates no BoardGameGeek board game matched the identifier.
+func (repository *Repository) StoreBoardGame(id string) (int, bool, error) {
+	reference, err := strconv.Atoi(id)
+
+	if err != nil {
+		repository.logger.Printf("Unable to parse BoardGameGeek identifier '%s': %v", id, err)
+
+		return 0, false, err
+	}
+
+	existingBoardGame, err := service.FetchFragment[model.BoardGameFragment](repository.connection, database.TableBoardGameFragments, fmt.Sprintf("reference=%d", reference))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing board game '%d': %v", reference, err)
+
+		return 0, false, err
+	}
+
+	if existingBoardGame.ID != 0 {
+		return existingBoardGame.ID, false, nil
+	}
+
+	boardGame, err := repository.client.BGGGetBoardGame(strconv.Itoa(reference))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch board game '%d' BoardGameGeek record: %v", reference, err)
+
+		return 0, false, err
+	}
+
+	if boardGame.ID == 0 {
+		return 0, false, nil
+	}
+
+	boardGameId, err := repository.ProcessBoardGameStorage(boardGame)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	return boardGameId, true, nil
+}
@@ -0,0 +1,115 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/boardgame"
+	BGGModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/boardgamegeek.com"
+)
+
+// A relationship of a board game stored from BoardGameGeek links of one type, described by its link type, fragment
+// table and properties, and relationship table, properties, and column.
+type linkage struct {
+	linkType         string
+	table            string
+	properties       []string
+	bridge           string
+	bridgeProperties []string
+	column           string
+}
+
+var (
+	designerLinkage  = linkage{linkType: LinkTypeDesigner, table: database.TableBoardGameDesignerFragments, properties: database.PropertiesBoardGameDesignerFragments, bridge: database.TableBoardGameDesignerRelationships, bridgeProperties: database.PropertiesBoardGameDesignerRelationships, column: "designer"}
+	publisherLinkage = linkage{linkType: LinkTypePublisher, table: database.TableBoardGamePublisherFragments, properties: database.PropertiesBoardGamePublisherFragments, bridge: database.TableBoardGamePublisherRelationships, bridgeProperties: database.PropertiesBoardGamePublisherRelationships, column: "publisher"}
+	mechanicLinkage  = linkage{linkType: LinkTypeMechanic, table: database.TableBoardGameMechanicFragments, properties: database.PropertiesBoardGameMechanicFragments, bridge: database.TableBoardGameMechanicRelationships, bridgeProperties: database.PropertiesBoardGameMechanicRelationships, column: "mechanic"}
+	categoryLinkage  = linkage{linkType: LinkTypeCategory, table: database.TableBoardGameCategoryFragments, properties: database.PropertiesBoardGameCategoryFragments, bridge: database.TableBoardGameCategoryRelationships, bridgeProperties: database.PropertiesBoardGameCategoryRelationships, column: "category"}
+)
+
+var linkages = []linkage{designerLinkage, publisherLinkage, mechanicLinkage, categoryLinkage}
+
+func (repository *Repository) ProcessBoardGameStorage(boardGame BGGModel.BGGThing) (int, error) {
+	boardGameId, err := repository.storeBoardGameFragment(boardGame)
+
+	if err != nil {
+		repository.logger.Printf("Unable to store board game '%d': %v", boardGame.ID, err)
+
+		return 0, err
+	}
+
+	for _, linkage := range linkages {
+		idSlice := repository.processLinkFragmentSlice(linkage, ExtractLinkSlice(boardGame.Links, linkage.linkType))
+
+		service.StoreRelationshipSlice(repository.connection, linkage.bridge, linkage.bridgeProperties, service.RelationshipSliceArgument{
+			SourceName:          "boardgame",
+			SourceArgument:      boardGameId,
+			DestinationName:     linkage.column,
+			DestinationArgument: idSlice,
+		})
+	}
+
+	return boardGameId, nil
+}
+
+func (repository *Repository) storeBoardGameFragment(boardGame BGGModel.BGGThing) (int, error) {
+	boardGameId, err := service.StoreFragment(repository.connection, database.TableBoardGameFragments, database.PropertiesBoardGameFragments, pgx.NamedArgs{
+		"title":         ExtractPrimaryName(boardGame.Names),
+		"description":   FormatDescription(boardGame.Description),
+		"release_date":  ParseYearPublished(boardGame.YearPublished.Value),
+		"min_players":   boardGame.MinPlayers.Value,
+		"max_players":   boardGame.MaxPlayers.Value,
+		"playing_time":  boardGame.PlayingTime.Value,
+		"min_play_time": boardGame.MinPlayTime.Value,
+		"max_play_time": boardGame.MaxPlayTime.Value,
+		"min_age":       boardGame.MinAge.Value,
+		"image":         boardGame.Image,
+		"reference":     boardGame.ID,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store board game '%d' fragment: %v", boardGame.ID, err)
+
+		return 0, err
+	}
+
+	return boardGameId, nil
+}
+
+// Store the fragments of BoardGameGeek links of one type (e.g., designers), reusing existing fragments by reference.
+func (repository *Repository) processLinkFragmentSlice(linkage linkage, links []BGGModel.BGGLink) []int {
+	var idSlice []int
+
+	for _, link := range links {
+		// every linked fragment shares the shape of a designer (i.e., a name and reference)
+		existingFragment, err := service.FetchFragment[model.BoardGameDesignerFragment](repository.connection, linkage.table, fmt.Sprintf("reference=%d", link.ID))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch existing %s '%d' fragment: %v", linkage.column, link.ID, err)
+
+			continue
+		}
+
+		if existingFragment.ID != 0 {
+			idSlice = append(idSlice, existingFragment.ID)
+
+			continue
+		}
+
+		id, err := service.StoreFragment(repository.connection, linkage.table, linkage.properties, pgx.NamedArgs{
+			"name":      link.Value,
+			"reference": link.ID,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store new %s '%d' fragment: %v", linkage.column, link.ID, err)
+		}
+
+		if id != 0 {
+			idSlice = append(idSlice, id)
+		}
+	}
+
+	return idSlice
+}
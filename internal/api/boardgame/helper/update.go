@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/boardgame"
+)
+
+func (repository *Repository) UpdateBoardGameFragment(boardGame model.BoardGameFragment) (int, error) {
+	id, err := service.UpdateFragment(repository.connection, database.TableBoardGameFragments, database.PropertiesBoardGameFragments, fmt.Sprintf("id=%d", boardGame.ID), pgx.NamedArgs{
+		"title":         boardGame.Title,
+		"description":   boardGame.Description,
+		"release_date":  boardGame.ReleaseDate,
+		"min_players":   boardGame.MinPlayers,
+		"max_players":   boardGame.MaxPlayers,
+		"playing_time":  boardGame.PlayingTime,
+		"min_play_time": boardGame.MinPlayTime,
+		"max_play_time": boardGame.MaxPlayTime,
+		"min_age":       boardGame.MinAge,
+		"image":         boardGame.Image,
+		"reference":     boardGame.Reference,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to update board game '%d' fragment: %v", boardGame.ID, err)
+
+		return 0, err
+	}
+
+	return id, nil
+}
@@ -1,19 +1,38 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/muzzarellimj/grace-material-api/internal/api/book/helper"
-	OLAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/openlibrary.org"
+	tagHelper "github.com/muzzarellimj/grace-material-api/internal/api/tag/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/isbn"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
 	model "github.com/muzzarellimj/grace-material-api/internal/model/book"
 )
 
 const errorMessage string = "Unable to fetch book metadata and map to supported data structure."
 
-func HandleGetBook(context *gin.Context) {
+// A book request handler, which holds the dependencies shared between book routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a book request handler with a book repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetBook(context *gin.Context) {
 	idArg := context.Query("id")
 
 	if len(idArg) == 0 {
@@ -42,7 +61,7 @@ func HandleGetBook(context *gin.Context) {
 		constraintSlice = append(constraintSlice, fmt.Sprintf("id=%s", id))
 	}
 
-	bookSlice, errSlice := helper.FetchBookSlice(constraintSlice)
+	bookSlice, errSlice := handler.repository.FetchBookSlice(constraintSlice)
 
 	if len(errSlice) != 0 {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -59,13 +78,30 @@ func HandleGetBook(context *gin.Context) {
 		return
 	}
 
+	if slices.Contains(strings.Split(context.Query("include"), ","), "rating") {
+		for index := range bookSlice {
+			rating, err := handler.repository.FetchBookRating(bookSlice[index].ID)
+
+			if err != nil {
+				context.IndentedJSON(http.StatusInternalServerError, gin.H{
+					"status":  http.StatusInternalServerError,
+					"message": errorMessage,
+				})
+
+				return
+			}
+
+			bookSlice[index].Rating = &rating
+		}
+	}
+
 	context.IndentedJSON(http.StatusOK, gin.H{
 		"status": http.StatusOK,
 		"data":   bookSlice,
 	})
 }
 
-func HandlePutBook(context *gin.Context) {
+func (handler *Handler) HandlePutBook(context *gin.Context) {
 	var book model.BookFragment
 
 	err := context.BindJSON(&book)
@@ -79,7 +115,7 @@ func HandlePutBook(context *gin.Context) {
 		return
 	}
 
-	id, err := helper.UpdateBookFragment(book)
+	id, err := handler.repository.UpdateBookFragment(book)
 
 	if err != nil {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -104,7 +140,7 @@ func HandlePutBook(context *gin.Context) {
 	})
 }
 
-func HandlePostBook(context *gin.Context) {
+func (handler *Handler) HandlePostBook(context *gin.Context) {
 	idArg := context.Query("id")
 
 	if len(idArg) == 0 {
@@ -118,7 +154,16 @@ func HandlePostBook(context *gin.Context) {
 
 	idArg = helper.FormatISBN(context.Query("id"))
 
-	existingBook, err := helper.FetchBook(fmt.Sprintf("isbn13='%s' OR edition_reference='%s'", idArg, idArg))
+	storedBookId, created, err := handler.repository.StoreBook(idArg)
+
+	if errors.Is(err, isbn.ErrInvalidISBN) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid ISBN or edition identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
 
 	if err != nil {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -129,38 +174,40 @@ func HandlePostBook(context *gin.Context) {
 		return
 	}
 
-	if existingBook.ID != 0 {
+	if !created {
 		context.IndentedJSON(http.StatusOK, gin.H{
 			"status": http.StatusOK,
 			"data": map[string]any{
-				"id": existingBook.ID,
+				"id": storedBookId,
 			},
 		})
 
 		return
 	}
 
-	edition, err := OLAPI.OLGetEdition(idArg)
-
-	if err != nil {
-		context.IndentedJSON(http.StatusInternalServerError, gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": errorMessage,
-		})
+	context.IndentedJSON(http.StatusCreated, gin.H{
+		"status": http.StatusCreated,
+		"data": map[string]any{
+			"id": storedBookId,
+		},
+	})
+}
 
-		return
-	}
+// Handle a scanned barcode (an EAN-13, optionally with a 2- or 5-digit add-on) or typed ISBN provided in query parameter
+// 'barcode', which is normalized and stored as a book, responding with its numeric identifier and both ISBN forms.
+func (handler *Handler) HandlePostBookScan(context *gin.Context) {
+	isbn10, isbn13, err := isbn.Forms(context.Query("barcode"))
 
-	if len(edition.Works) == 0 {
-		context.IndentedJSON(http.StatusInternalServerError, gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": errorMessage,
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid ISBN or barcode argument '%s' provided in query parameter 'barcode'.", context.Query("barcode")),
 		})
 
 		return
 	}
 
-	work, err := OLAPI.OLGetWork(helper.ExtractResourceId(edition.Works[0].ID))
+	storedBookId, created, err := handler.repository.StoreBook(isbn13)
 
 	if err != nil {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -171,27 +218,41 @@ func HandlePostBook(context *gin.Context) {
 		return
 	}
 
-	storedBookId, err := helper.ProcessBookStorage(edition, work)
-
-	if err != nil || storedBookId == 0 {
-		context.IndentedJSON(http.StatusInternalServerError, gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": errorMessage,
-		})
+	status := http.StatusOK
 
-		return
+	if created {
+		status = http.StatusCreated
 	}
 
-	context.IndentedJSON(http.StatusCreated, gin.H{
-		"status": http.StatusCreated,
+	context.IndentedJSON(status, gin.H{
+		"status": status,
 		"data": map[string]any{
-			"id": storedBookId,
+			"id":     storedBookId,
+			"isbn10": isbn10,
+			"isbn13": isbn13,
 		},
 	})
 }
 
-func HandleGetBookExistenceSlice(context *gin.Context) {
-	bookExistenceSlice, errSlice := helper.FetchBookExistenceSlice()
+func (handler *Handler) HandleGetBookExistenceSlice(context *gin.Context) {
+	var constraint string
+
+	if tag := context.Query("tag"); tag != "" {
+		principal, ok := middleware.Principal(context)
+
+		if !ok {
+			context.IndentedJSON(http.StatusUnauthorized, gin.H{
+				"status":  http.StatusUnauthorized,
+				"message": "Unable to filter by tag without an authenticated principal.",
+			})
+
+			return
+		}
+
+		constraint = tagHelper.Constraint("id", material.TypeBook, principal.Subject, tag)
+	}
+
+	bookExistenceSlice, errSlice := handler.repository.FetchBookExistenceSlice(constraint)
 
 	if len(errSlice) != 0 {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -214,7 +275,7 @@ func HandleGetBookExistenceSlice(context *gin.Context) {
 	})
 }
 
-func HandleGetBookSearch(context *gin.Context) {
+func (handler *Handler) HandleGetBookSearch(context *gin.Context) {
 	query := context.Query("query")
 
 	if query == "" {
@@ -226,25 +287,28 @@ func HandleGetBookSearch(context *gin.Context) {
 		return
 	}
 
-	results, err := OLAPI.OLSearchBook(query)
+	language := strings.ToLower(context.Query("language"))
 
-	if err != nil {
-		context.IndentedJSON(http.StatusInternalServerError, gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": "Unable to fetch book metadata and map to supported data structure.",
+	if language != "" && !helper.IsLanguage(language) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid language code '%s' provided in query parameter 'language'.", context.Query("language")),
 		})
 
 		return
 	}
 
-	if len(results.Results) == 0 {
-		context.Status(http.StatusNoContent)
+	mappedResults, err := handler.repository.SearchBooks(query, language)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to fetch book metadata and map to supported data structure.",
+		})
 
 		return
 	}
 
-	mappedResults := helper.MapSearchResultSlice(results.Results)
-
 	if len(mappedResults) == 0 {
 		context.Status(http.StatusNoContent)
 
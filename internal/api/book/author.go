@@ -0,0 +1,33 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handle an author request, responding with every author, or those with a display or alternate name containing query
+// parameter 'query', ordered by sort name.
+func (handler *Handler) HandleGetAuthorSlice(context *gin.Context) {
+	authorSlice, err := handler.repository.FetchAuthorSlice(context.Query("query"))
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to fetch authors and map to supported data structure.",
+		})
+
+		return
+	}
+
+	if len(authorSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   authorSlice,
+	})
+}
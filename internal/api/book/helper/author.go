@@ -0,0 +1,41 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/book"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+// Fetch every author, or those with a display or alternate name containing the query, ordered by sort name (e.g.,
+// "Le Guin, Ursula K.").
+//
+// Return: author fragment slice and nil with success, empty slice and error without.
+func (repository *Repository) FetchAuthorSlice(query string) ([]model.BookAuthorFragment, error) {
+	var constraint string
+
+	if query = strings.TrimSpace(query); query != "" {
+		pattern := util.FormatPSQLPattern(query)
+
+		constraint = fmt.Sprintf("display_name ILIKE '%%%s%%' ESCAPE '\\' OR EXISTS (SELECT 1 FROM UNNEST(alternate_names) a WHERE a ILIKE '%%%s%%' ESCAPE '\\')", pattern, pattern)
+	}
+
+	authorSlice, err := service.FetchFragmentSlice[model.BookAuthorFragment](repository.connection, database.TableBookAuthorFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch authors with query '%s': %v", query, err)
+
+		return []model.BookAuthorFragment{}, err
+	}
+
+	slices.SortStableFunc(authorSlice, func(a model.BookAuthorFragment, b model.BookAuthorFragment) int {
+		return cmp.Compare(strings.ToLower(a.SortName), strings.ToLower(b.SortName))
+	})
+
+	return authorSlice, nil
+}
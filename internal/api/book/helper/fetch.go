@@ -1,57 +1,85 @@
 package helper
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
-	"os"
+	"slices"
 
 	"github.com/muzzarellimj/grace-material-api/internal/database"
 	"github.com/muzzarellimj/grace-material-api/internal/database/service"
 	model "github.com/muzzarellimj/grace-material-api/internal/model/book"
+	reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
 )
 
-func FetchBook(constraint string) (model.Book, error) {
+var ErrWorkNotFound = errors.New("work not found")
+
+func (repository *Repository) FetchBook(constraint string) (model.Book, error) {
 	zero := model.Book{}
 
-	bookFragment, err := service.FetchFragment[model.BookFragment](database.Connection, database.TableBookFragments, constraint)
+	bookFragment, err := service.FetchFragment[model.BookFragment](repository.connection, database.TableBookFragments, constraint)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch book with constraint '%s': %v\n", constraint, err)
+		repository.logger.Printf("Unable to fetch book with constraint '%s': %v", constraint, err)
 
 		return zero, err
 	}
 
-	authorFragmentSlice, err := fetchAuthorFragmentSlice(bookFragment)
+	if bookFragment.ID == 0 {
+		return zero, nil
+	}
+
+	authorFragmentSlice, err := repository.fetchAuthorFragmentSlice(bookFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch authors related to book '%d': %v", bookFragment.ID, err)
+	}
+
+	narratorFragmentSlice, err := repository.fetchNarratorFragmentSlice(bookFragment)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch authors related to book '%d': %v\n", bookFragment.ID, err)
+		repository.logger.Printf("Unable to fetch narrators related to book '%d': %v", bookFragment.ID, err)
 	}
 
-	publisherFragmentSlice, err := fetchPublisherFragmentSlice(bookFragment)
+	publisherFragmentSlice, err := repository.fetchPublisherFragmentSlice(bookFragment)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch publishers related to book '%d': %v\n", bookFragment.ID, err)
+		repository.logger.Printf("Unable to fetch publishers related to book '%d': %v", bookFragment.ID, err)
 	}
 
-	topicFragmentSlice, err := fetchTopicFragmentSlice(bookFragment)
+	topicFragmentSlice, err := repository.fetchTopicFragmentSlice(bookFragment)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch topics related to book '%d': %v\n", bookFragment.ID, err)
+		repository.logger.Printf("Unable to fetch topics related to book '%d': %v", bookFragment.ID, err)
 	}
 
-	book := mapBook(bookFragment, authorFragmentSlice, publisherFragmentSlice, topicFragmentSlice)
+	seriesSlice, err := repository.fetchSeriesSlice(bookFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch series related to book '%d': %v", bookFragment.ID, err)
+	}
+
+	workFragment, err := service.FetchFragment[model.BookWorkFragment](repository.connection, database.TableBookWorkFragments, fmt.Sprintf("reference='%s'", util.FormatPSQLString(bookFragment.WorkReference)))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch work related to book '%d': %v", bookFragment.ID, err)
+	}
+
+	book := mapBook(bookFragment, workFragment, authorFragmentSlice, narratorFragmentSlice, publisherFragmentSlice, topicFragmentSlice, seriesSlice)
 
 	return book, nil
 }
 
-func FetchBookSlice(constraintSlice []string) ([]model.Book, []error) {
+func (repository *Repository) FetchBookSlice(constraintSlice []string) ([]model.Book, []error) {
 	var bookSlice []model.Book
 	var errSlice []error
 
 	for _, constraint := range constraintSlice {
-		book, err := FetchBook(constraint)
+		book, err := repository.FetchBook(constraint)
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch and map book with constraint '%s': %v\n", constraint, err)
+			repository.logger.Printf("Unable to fetch and map book with constraint '%s': %v", constraint, err)
 
 			errSlice = append(errSlice, err)
 		}
@@ -64,17 +92,17 @@ func FetchBookSlice(constraintSlice []string) ([]model.Book, []error) {
 	return bookSlice, errSlice
 }
 
-func FetchBookExistenceSlice() ([]int, []error) {
-	idSlice, err := service.FetchExistenceSlice(database.Connection, database.TableBookFragments)
+func (repository *Repository) FetchBookExistenceSlice(constraint string) ([]int, []error) {
+	idSlice, err := service.FetchExistenceSlice(repository.connection, database.TableBookFragments, constraint)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch existence slice: %v\n", err)
+		repository.logger.Printf("Unable to fetch existence slice: %v", err)
 
 		return []int{}, []error{err}
 	}
 
 	if len(idSlice) == 0 {
-		fmt.Fprint(os.Stderr, "Existence slice appears to be empty.")
+		repository.logger.Print("Existence slice appears to be empty.")
 
 		return []int{}, nil
 	}
@@ -82,11 +110,90 @@ func FetchBookExistenceSlice() ([]int, []error) {
 	return idSlice, nil
 }
 
-func fetchAuthorFragmentSlice(bookFragment model.BookFragment) ([]model.BookAuthorFragment, error) {
-	bookAuthorRelationshipSlice, err := service.FetchRelationshipSlice[model.BookAuthorRelationship](database.Connection, database.TableBookAuthorRelationships, fmt.Sprintf("book=%d", bookFragment.ID))
+// Fetch a work with its editions, ordered by publish date, where the edition constraint may restrict the editions
+// (e.g., to those in the collection of a user) or be empty.
+//
+// Return: work and nil with success, empty work and ErrWorkNotFound or error without.
+func (repository *Repository) FetchWork(constraint string, editionConstraint string) (model.Work, error) {
+	workFragment, err := service.FetchFragment[model.BookWorkFragment](repository.connection, database.TableBookWorkFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch work with constraint '%s': %v", constraint, err)
+
+		return model.Work{}, err
+	}
+
+	if workFragment.ID == 0 {
+		return model.Work{}, ErrWorkNotFound
+	}
+
+	topicRelationshipSlice, err := service.FetchRelationshipSlice[model.BookWorkTopicRelationship](repository.connection, database.TableBookWorkTopicRelationships, fmt.Sprintf("work=%d", workFragment.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between work '%d' and topics: %v", workFragment.ID, err)
+
+		return model.Work{}, err
+	}
+
+	work := model.Work{
+		ID:          workFragment.ID,
+		Title:       workFragment.Title,
+		Description: workFragment.Description,
+		Topics:      []model.BookTopicFragment{},
+		Reference:   workFragment.Reference,
+		Editions:    []model.Book{},
+	}
+
+	for _, relationship := range topicRelationshipSlice {
+		topicFragment, err := service.FetchFragment[model.BookTopicFragment](repository.connection, database.TableBookTopicFragments, fmt.Sprintf("id=%d", relationship.Topic))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch topic '%d': %v", relationship.Topic, err)
+		}
+
+		if topicFragment.ID != 0 {
+			work.Topics = append(work.Topics, topicFragment)
+		}
+	}
+
+	workConstraint := fmt.Sprintf("work_reference='%s'", util.FormatPSQLString(workFragment.Reference))
+
+	if editionConstraint != "" {
+		workConstraint = fmt.Sprintf("%s AND (%s)", workConstraint, editionConstraint)
+	}
+
+	idSlice, err := service.FetchExistenceSlice(repository.connection, database.TableBookFragments, workConstraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch editions of work '%d': %v", workFragment.ID, err)
+
+		return model.Work{}, err
+	}
+
+	for _, id := range idSlice {
+		book, err := repository.FetchBook(fmt.Sprintf("id=%d", id))
+
+		if err != nil {
+			return model.Work{}, err
+		}
+
+		if book.ID != 0 {
+			work.Editions = append(work.Editions, book)
+		}
+	}
+
+	slices.SortStableFunc(work.Editions, func(a model.Book, b model.Book) int {
+		return cmp.Compare(a.PublishDate, b.PublishDate)
+	})
+
+	return work, nil
+}
+
+func (repository *Repository) fetchAuthorFragmentSlice(bookFragment model.BookFragment) ([]model.BookAuthorFragment, error) {
+	bookAuthorRelationshipSlice, err := service.FetchRelationshipSlice[model.BookAuthorRelationship](repository.connection, database.TableBookAuthorRelationships, fmt.Sprintf("book=%d", bookFragment.ID))
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch relationships between book '%d' and authors: %v\n", bookFragment.ID, err)
+		repository.logger.Printf("Unable to fetch relationships between book '%d' and authors: %v", bookFragment.ID, err)
 
 		return []model.BookAuthorFragment{}, err
 	}
@@ -94,10 +201,10 @@ func fetchAuthorFragmentSlice(bookFragment model.BookFragment) ([]model.BookAuth
 	var authorFragmentSlice []model.BookAuthorFragment
 
 	for _, relationship := range bookAuthorRelationshipSlice {
-		authorFragment, err := service.FetchFragment[model.BookAuthorFragment](database.Connection, database.TableBookAuthorFragments, fmt.Sprintf("id=%d", relationship.Author))
+		authorFragment, err := service.FetchFragment[model.BookAuthorFragment](repository.connection, database.TableBookAuthorFragments, fmt.Sprintf("id=%d", relationship.Author))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch author '%d': %v\n", relationship.Author, err)
+			repository.logger.Printf("Unable to fetch author '%d': %v", relationship.Author, err)
 		}
 
 		if authorFragment.ID != 0 {
@@ -108,11 +215,37 @@ func fetchAuthorFragmentSlice(bookFragment model.BookFragment) ([]model.BookAuth
 	return authorFragmentSlice, nil
 }
 
-func fetchPublisherFragmentSlice(bookFragment model.BookFragment) ([]model.BookPublisherFragment, error) {
-	bookPublisherRelationshipSlice, err := service.FetchRelationshipSlice[model.BookPublisherRelationship](database.Connection, database.TableBookPublisherRelationships, fmt.Sprintf("book=%d", bookFragment.ID))
+func (repository *Repository) fetchNarratorFragmentSlice(bookFragment model.BookFragment) ([]model.BookNarratorFragment, error) {
+	bookNarratorRelationshipSlice, err := service.FetchRelationshipSlice[model.BookNarratorRelationship](repository.connection, database.TableBookNarratorRelationships, fmt.Sprintf("book=%d", bookFragment.ID))
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch relationships between book '%d' and publishers: %v\n", bookFragment.ID, err)
+		repository.logger.Printf("Unable to fetch relationships between book '%d' and narrators: %v", bookFragment.ID, err)
+
+		return []model.BookNarratorFragment{}, err
+	}
+
+	var narratorFragmentSlice []model.BookNarratorFragment
+
+	for _, relationship := range bookNarratorRelationshipSlice {
+		narratorFragment, err := service.FetchFragment[model.BookNarratorFragment](repository.connection, database.TableBookNarratorFragments, fmt.Sprintf("id=%d", relationship.Narrator))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch narrator '%d': %v", relationship.Narrator, err)
+		}
+
+		if narratorFragment.ID != 0 {
+			narratorFragmentSlice = append(narratorFragmentSlice, narratorFragment)
+		}
+	}
+
+	return narratorFragmentSlice, nil
+}
+
+func (repository *Repository) fetchPublisherFragmentSlice(bookFragment model.BookFragment) ([]model.BookPublisherFragment, error) {
+	bookPublisherRelationshipSlice, err := service.FetchRelationshipSlice[model.BookPublisherRelationship](repository.connection, database.TableBookPublisherRelationships, fmt.Sprintf("book=%d", bookFragment.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between book '%d' and publishers: %v", bookFragment.ID, err)
 
 		return []model.BookPublisherFragment{}, err
 	}
@@ -120,10 +253,10 @@ func fetchPublisherFragmentSlice(bookFragment model.BookFragment) ([]model.BookP
 	var publisherFragmentSlice []model.BookPublisherFragment
 
 	for _, relationship := range bookPublisherRelationshipSlice {
-		publisherFragment, err := service.FetchFragment[model.BookPublisherFragment](database.Connection, database.TableBookPublisherFragments, fmt.Sprintf("id=%d", relationship.Publisher))
+		publisherFragment, err := service.FetchFragment[model.BookPublisherFragment](repository.connection, database.TableBookPublisherFragments, fmt.Sprintf("id=%d", relationship.Publisher))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch publisher '%d': %v\n", relationship.Publisher, err)
+			repository.logger.Printf("Unable to fetch publisher '%d': %v", relationship.Publisher, err)
 		}
 
 		if publisherFragment.ID != 0 {
@@ -134,11 +267,11 @@ func fetchPublisherFragmentSlice(bookFragment model.BookFragment) ([]model.BookP
 	return publisherFragmentSlice, nil
 }
 
-func fetchTopicFragmentSlice(bookFragment model.BookFragment) ([]model.BookTopicFragment, error) {
-	bookTopicRelationshipSlice, err := service.FetchRelationshipSlice[model.BookTopicRelationship](database.Connection, database.TableBookTopicRelationships, fmt.Sprintf("book=%d", bookFragment.ID))
+func (repository *Repository) fetchTopicFragmentSlice(bookFragment model.BookFragment) ([]model.BookTopicFragment, error) {
+	bookTopicRelationshipSlice, err := service.FetchRelationshipSlice[model.BookTopicRelationship](repository.connection, database.TableBookTopicRelationships, fmt.Sprintf("book=%d", bookFragment.ID))
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch relationships between book '%d' and topics: %v\n", bookFragment.ID, err)
+		repository.logger.Printf("Unable to fetch relationships between book '%d' and topics: %v", bookFragment.ID, err)
 
 		return []model.BookTopicFragment{}, err
 	}
@@ -146,10 +279,10 @@ func fetchTopicFragmentSlice(bookFragment model.BookFragment) ([]model.BookTopic
 	var topicFragmentSlice []model.BookTopicFragment
 
 	for _, relationship := range bookTopicRelationshipSlice {
-		topicFragment, err := service.FetchFragment[model.BookTopicFragment](database.Connection, database.TableBookTopicFragments, fmt.Sprintf("id=%d", relationship.Topic))
+		topicFragment, err := service.FetchFragment[model.BookTopicFragment](repository.connection, database.TableBookTopicFragments, fmt.Sprintf("id=%d", relationship.Topic))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch topic '%d': %v\n", relationship.Topic, err)
+			repository.logger.Printf("Unable to fetch topic '%d': %v", relationship.Topic, err)
 		}
 
 		if topicFragment.ID != 0 {
@@ -160,11 +293,45 @@ func fetchTopicFragmentSlice(bookFragment model.BookFragment) ([]model.BookTopic
 	return topicFragmentSlice, nil
 }
 
-func mapBook(bookFragment model.BookFragment, authorFragmentSlice []model.BookAuthorFragment, publisherFragmentSlice []model.BookPublisherFragment, topicFragmentSlice []model.BookTopicFragment) model.Book {
+func (repository *Repository) fetchSeriesSlice(bookFragment model.BookFragment) ([]model.BookSeries, error) {
+	bookSeriesRelationshipSlice, err := service.FetchRelationshipSlice[model.BookSeriesRelationship](repository.connection, database.TableBookSeriesRelationships, fmt.Sprintf("book=%d", bookFragment.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between book '%d' and series: %v", bookFragment.ID, err)
+
+		return []model.BookSeries{}, err
+	}
+
+	var seriesSlice []model.BookSeries
+
+	for _, relationship := range bookSeriesRelationshipSlice {
+		seriesFragment, err := service.FetchFragment[model.BookSeriesFragment](repository.connection, database.TableBookSeriesFragments, fmt.Sprintf("id=%d", relationship.Series))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch series '%d': %v", relationship.Series, err)
+		}
+
+		if seriesFragment.ID != 0 {
+			seriesSlice = append(seriesSlice, model.BookSeries{ID: seriesFragment.ID, Name: seriesFragment.Name, Position: relationship.Position})
+		}
+	}
+
+	return seriesSlice, nil
+}
+
+func mapBook(bookFragment model.BookFragment, workFragment model.BookWorkFragment, authorFragmentSlice []model.BookAuthorFragment, narratorFragmentSlice []model.BookNarratorFragment, publisherFragmentSlice []model.BookPublisherFragment, topicFragmentSlice []model.BookTopicFragment, seriesSlice []model.BookSeries) model.Book {
 	if authorFragmentSlice == nil {
 		authorFragmentSlice = make([]model.BookAuthorFragment, 0)
 	}
 
+	if bookFragment.Languages == nil {
+		bookFragment.Languages = make([]string, 0)
+	}
+
+	if narratorFragmentSlice == nil {
+		narratorFragmentSlice = make([]model.BookNarratorFragment, 0)
+	}
+
 	if publisherFragmentSlice == nil {
 		publisherFragmentSlice = make([]model.BookPublisherFragment, 0)
 	}
@@ -173,16 +340,27 @@ func mapBook(bookFragment model.BookFragment, authorFragmentSlice []model.BookAu
 		topicFragmentSlice = make([]model.BookTopicFragment, 0)
 	}
 
+	if seriesSlice == nil {
+		seriesSlice = make([]model.BookSeries, 0)
+	}
+
 	return model.Book{
 		ID:               bookFragment.ID,
 		Title:            bookFragment.Title,
 		Subtitle:         bookFragment.Subtitle,
-		Description:      bookFragment.Description,
+		Description:      workFragment.Description,
 		Authors:          authorFragmentSlice,
+		Narrators:        narratorFragmentSlice,
 		Publishers:       publisherFragmentSlice,
 		Topics:           topicFragmentSlice,
+		Series:           seriesSlice,
 		PublishDate:      bookFragment.PublishDate,
 		Pages:            bookFragment.Pages,
+		Format:           bookFragment.Format,
+		Duration:         bookFragment.Duration,
+		Languages:        bookFragment.Languages,
+		OriginalLanguage: bookFragment.OriginalLanguage,
+		TranslationOf:    bookFragment.TranslationOf,
 		ISBN10:           bookFragment.ISBN10,
 		ISBN13:           bookFragment.ISBN13,
 		Image:            bookFragment.Image,
@@ -190,3 +368,30 @@ func mapBook(bookFragment model.BookFragment, authorFragmentSlice []model.BookAu
 		WorkReference:    bookFragment.WorkReference,
 	}
 }
+
+// Fetch the rating aggregate (i.e., review count and average rating) of a book.
+//
+// Return: rating aggregate and nil with success, empty rating aggregate and error without.
+func (repository *Repository) FetchBookRating(id int) (reviewModel.RatingAggregate, error) {
+	statement, err := database.CreateQuery("COUNT(rating) AS count, COALESCE(AVG(rating), 0)::FLOAT8 AS average", database.TableBookReviewFragments, fmt.Sprintf("book=%d", id), "")
+
+	if err != nil {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch rating aggregate of book '%d': %v", id, err)
+
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	response, err := database.MapQueryResponse[reviewModel.RatingAggregate](rows)
+
+	if err != nil || len(response) == 0 {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	return response[0], nil
+}
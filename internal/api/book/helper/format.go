@@ -4,16 +4,27 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/muzzarellimj/grace-material-api/internal/isbn"
 	model "github.com/muzzarellimj/grace-material-api/internal/model/book"
 	OLModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/openlibrary.org"
+	"github.com/muzzarellimj/grace-material-api/internal/personname"
 	"github.com/muzzarellimj/grace-material-api/internal/util"
 )
 
+// Map OL search results to the supported search result model, collapsing results of the same work into the first,
+// which holds the total edition count of the work.
+//
+// Return: mapped search result slice.
 func MapSearchResultSlice(input []OLModel.OLBookSearchResult) []model.BookSearchResult {
 	var resultSlice []model.BookSearchResult
 
+	workIndexes := make(map[string]int)
+
 	for _, result := range input {
 		if len(result.ID) == 0 {
 			fmt.Fprint(os.Stdout, "Unable to map OL search result; result did not contain an edition identifier.\n", result)
@@ -51,14 +62,31 @@ func MapSearchResultSlice(input []OLModel.OLBookSearchResult) []model.BookSearch
 			continue
 		}
 
+		work := ExtractResourceId(result.WorkID)
+		editions := max(result.EditionCount, len(result.ID))
+
+		if index, exists := workIndexes[work]; exists && work != "" {
+			resultSlice[index].Editions += editions
+
+			continue
+		}
+
 		mappedResult := model.BookSearchResult{
 			ID:          id,
 			Title:       result.Title,
 			Authors:     result.Authors,
 			PublishDate: publishDate,
 			Image:       FormatImagePath(id),
+			Work:        work,
+			Editions:    editions,
+			Languages:   result.Languages,
 		}
 
+		if mappedResult.Languages == nil {
+			mappedResult.Languages = []string{}
+		}
+
+		workIndexes[work] = len(resultSlice)
 		resultSlice = append(resultSlice, mappedResult)
 	}
 
@@ -72,6 +100,30 @@ func FormatISBN(isbn string) string {
 	return strings.ReplaceAll(isbn, "-", "")
 }
 
+// Determine whether an identifier is an OL edition identifier (e.g., "OL37765857M") rather than an ISBN.
+//
+// Return: true if an edition identifier, false if not.
+func IsEditionId(id string) bool {
+	return regexp.MustCompile("^OL[0-9]+M$").MatchString(id)
+}
+
+// Extract the ISBN-10 and ISBN-13 of an edition, deriving either from the other when absent or invalid.
+//
+// Return: ISBN-10 and ISBN-13, each empty when neither can be derived.
+func ExtractISBNForms(isbn10Slice []string, isbn13Slice []string) (string, string) {
+	for _, candidateSlice := range [][]string{isbn13Slice, isbn10Slice} {
+		for _, candidate := range candidateSlice {
+			isbn10, isbn13, err := isbn.Forms(candidate)
+
+			if err == nil {
+				return isbn10, isbn13
+			}
+		}
+	}
+
+	return ExtractISBN(isbn10Slice), ExtractISBN(isbn13Slice)
+}
+
 func FormatImagePath(id string) string {
 	return fmt.Sprintf("https://covers.openlibrary.org/b/olid/%s-L.jpg", id)
 }
@@ -102,27 +154,62 @@ func ExtractISBN(slice []string) string {
 	return FormatISBN(slice[0])
 }
 
-// Extract the first, middle, and last name components from a full name.
+// Extract the first, middle, and last name components from a full name (see personname.Parse), where a suffix (e.g.,
+// "Jr.") is kept with the last name.
 //
 // Return: first, middle, and last name, each is empty as necessary.
-func ExtractName(name string) (string, string, string) {
-	nameSlice := strings.Split(name, " ")
+func ExtractName(name string, alternates ...string) (string, string, string) {
+	parsed := personname.Parse(name, alternates...)
+
+	return parsed.First, parsed.Middle, strings.TrimSpace(parsed.Last + " " + parsed.Suffix)
+}
+
+// Extract the distinct alternate names of an author (e.g., transliterations and pen names), excluding the name itself.
+//
+// Return: alternate name slice, which is empty when there are none.
+func ExtractAlternateNames(name string, alternates []string) []string {
+	alternateSlice := []string{}
+	seen := map[string]bool{strings.ToLower(strings.TrimSpace(name)): true}
 
-	switch length := len(nameSlice); length {
+	for _, alternate := range alternates {
+		alternate = strings.Join(strings.Fields(alternate), " ")
 
-	case 1:
-		return nameSlice[0], "", ""
+		if alternate == "" || seen[strings.ToLower(alternate)] {
+			continue
+		}
 
-	case 2:
-		return nameSlice[0], "", nameSlice[1]
+		seen[strings.ToLower(alternate)] = true
+		alternateSlice = append(alternateSlice, alternate)
+	}
 
-	case 3:
-		return nameSlice[0], nameSlice[1], nameSlice[2]
+	return alternateSlice
+}
 
-	default:
-		return name, "", ""
+// Extract a series name and position from an OL series statement; e.g., "The Expanse ; 1", "The Expanse #2.5", and
+// "(The Expanse, book 3)", and "The Expanse (4)" become "The Expanse" and 1, 2.5, 3, and 4.
+//
+// Return: series name and position, where the position is 0 when none is stated.
+func ExtractSeries(statement string) (string, float64) {
+	statement = strings.TrimSpace(statement)
 
+	if strings.HasPrefix(statement, "(") && strings.HasSuffix(statement, ")") {
+		statement = strings.TrimSpace(statement[1 : len(statement)-1])
 	}
+
+	pattern := regexp.MustCompile(`(?i)^(.+?)[\s,;:#(]+(?:(?:book|bk\.?|volume|vol\.?|v\.|number|no\.?|part|pt\.?)\s*)?#?\s*(\d+(?:\.\d+)?)\)?$`)
+	match := pattern.FindStringSubmatch(statement)
+
+	if match == nil {
+		return strings.TrimRight(statement, " ,;:"), 0
+	}
+
+	position, err := strconv.ParseFloat(match[2], 64)
+
+	if err != nil {
+		return strings.TrimRight(statement, " ,;:"), 0
+	}
+
+	return strings.TrimRight(match[1], " ,;:"), position
 }
 
 // Extract an OL resource identifier from a resource reference key; e.g., "/books/OL...M" becomes "OL...M".
@@ -133,3 +220,186 @@ func ExtractResourceId(key string) string {
 
 	return pattern.FindString(key)
 }
+
+// The normalized formats of an edition, where an audiobook is measured in minutes rather than pages.
+const (
+	FormatHardcover = "hardcover"
+	FormatPaperback = "paperback"
+	FormatEbook     = "ebook"
+	FormatAudiobook = "audiobook"
+)
+
+// The maximum length of a stored format, as limited by the books table.
+const maximumFormatLength = 64
+
+// Patterns of the physical formats and language codes of OL editions, compiled once rather than once per edition.
+var (
+	audioPattern     = regexp.MustCompile(`(?i)audio|sound (?:disc|recording)|\bcds?\b|\bmp3\b|cassette`)
+	ebookPattern     = regexp.MustCompile(`e-?book|electronic|digital|kindle|epub|pdf`)
+	paperbackPattern = regexp.MustCompile(`paper|soft|mass market|pocket`)
+	hardcoverPattern = regexp.MustCompile(`hard|cloth|library binding|leather`)
+	languagePattern  = regexp.MustCompile("^[a-z]{3}$")
+)
+
+// Extract the format of an OL edition, where any audio edition (e.g., physical format "Audio CD" or "MP3 CD", or a
+// pagination of "10 sound discs") is an audiobook, any electronic edition (e.g., "E-book" or "Kindle Edition") is an
+// ebook, any bound edition is a hardcover (e.g., "Hardback" or "Library Binding") or paperback (e.g., "Mass Market
+// Paperback" or "Softcover"), and any other physical format is kept in lowercase (e.g., "Board Book" becomes
+// "board book") and truncated to the maximum format length.
+//
+// Return: extracted format, or an empty string without a physical format.
+func ExtractFormat(edition OLModel.OLEditionResponse) string {
+	if audioPattern.MatchString(edition.Format) || audioPattern.MatchString(edition.Pagination) {
+		return FormatAudiobook
+	}
+
+	format := strings.ToLower(strings.Join(strings.Fields(edition.Format), " "))
+
+	switch {
+	case ebookPattern.MatchString(format):
+		return FormatEbook
+	case paperbackPattern.MatchString(format):
+		return FormatPaperback
+	case hardcoverPattern.MatchString(format):
+		return FormatHardcover
+	}
+
+	if utf8.RuneCountInString(format) > maximumFormatLength {
+		format = strings.TrimSpace(string([]rune(format)[:maximumFormatLength]))
+	}
+
+	return format
+}
+
+// Extract the language code of an OL language reference; e.g., "/languages/eng" becomes "eng".
+//
+// Return: extracted language code, or an empty string without a language reference or with a reference which is not
+// a language code.
+func ExtractLanguage(reference OLModel.OLResourceReference) string {
+	language := strings.TrimPrefix(reference.ID, "/languages/")
+
+	if !IsLanguage(language) {
+		return ""
+	}
+
+	return language
+}
+
+// Extract the distinct language codes of OL language references.
+//
+// Return: extracted language code slice, which is empty when there are none.
+func ExtractLanguages(referenceSlice []OLModel.OLResourceReference) []string {
+	languageSlice := []string{}
+
+	for _, reference := range referenceSlice {
+		language := ExtractLanguage(reference)
+
+		if language != "" && !slices.Contains(languageSlice, language) {
+			languageSlice = append(languageSlice, language)
+		}
+	}
+
+	return languageSlice
+}
+
+// Extract the original language of an OL edition, which is known only for translations.
+//
+// Return: original language code, or an empty string when unknown.
+func ExtractOriginalLanguage(edition OLModel.OLEditionResponse) string {
+	if len(edition.TranslatedFrom) == 0 {
+		return ""
+	}
+
+	return ExtractLanguage(edition.TranslatedFrom[0])
+}
+
+// Determine whether a language is a three-letter MARC language code (e.g., "eng" or "pol"), as used by OL.
+//
+// Return: true if a language code, false if not.
+func IsLanguage(language string) bool {
+	return languagePattern.MatchString(language)
+}
+
+// Extract the duration of an audiobook in minutes from a duration statement, which OL keeps in the pagination of an
+// edition; e.g., "10 CDs (12 hr., 30 min.)", "12 hours 30 minutes", and "12:30:00" become 750.
+//
+// Return: duration in minutes, or 0 without a duration.
+func ExtractDuration(statement string) int {
+	clock := regexp.MustCompile(`\b(\d{1,3}):([0-5]\d)(?::([0-5]\d))?\b`).FindStringSubmatch(statement)
+
+	if clock != nil {
+		hours, _ := strconv.Atoi(clock[1])
+		minutes, _ := strconv.Atoi(clock[2])
+		seconds, _ := strconv.Atoi(clock[3])
+
+		if clock[3] == "" {
+			return hours*60 + minutes
+		}
+
+		return hours*60 + minutes + (seconds+30)/60
+	}
+
+	var duration float64
+
+	hours := regexp.MustCompile(`(?i)(\d+(?:\.\d+)?)\s*(?:h|hrs?|hours?)\b`).FindStringSubmatch(statement)
+
+	if hours != nil {
+		value, _ := strconv.ParseFloat(hours[1], 64)
+		duration += value * 60
+	}
+
+	minutes := regexp.MustCompile(`(?i)(\d+)\s*(?:m|mins?|minutes?)\b`).FindStringSubmatch(statement)
+
+	if minutes != nil {
+		value, _ := strconv.ParseFloat(minutes[1], 64)
+		duration += value
+	}
+
+	return int(duration + 0.5)
+}
+
+// Extract the narrators of an OL edition from its contributors (e.g., role "Narrator" or "Reader"), its contribution
+// statements (e.g., "Peter Kenny (Narrator)"), or its by statement (e.g., "read by Peter Kenny and Jane Doe").
+//
+// Return: distinct narrator name slice, which is empty when there are none.
+func ExtractNarrators(edition OLModel.OLEditionResponse) []string {
+	var nameSlice []string
+
+	for _, contributor := range edition.Contributors {
+		if regexp.MustCompile(`(?i)narrat|reader|read by|performer`).MatchString(contributor.Role) {
+			nameSlice = append(nameSlice, contributor.Name)
+		}
+	}
+
+	contribution := regexp.MustCompile(`(?i)^(.+?)\s*\((?:narrator|reader|read by|performer)\)$`)
+
+	for _, statement := range edition.Contributions {
+		if match := contribution.FindStringSubmatch(strings.TrimSpace(statement)); match != nil {
+			nameSlice = append(nameSlice, match[1])
+		}
+	}
+
+	if len(nameSlice) == 0 {
+		match := regexp.MustCompile(`(?i)\b(?:read|narrated|performed)\s+by\s+([^.;]+)`).FindStringSubmatch(edition.ByStatement)
+
+		if match != nil {
+			nameSlice = regexp.MustCompile(`\s*(?:,|\band\b|&)\s*`).Split(match[1], -1)
+		}
+	}
+
+	narratorSlice := []string{}
+	seen := make(map[string]bool)
+
+	for _, name := range nameSlice {
+		name = strings.Join(strings.Fields(name), " ")
+
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+
+		seen[strings.ToLower(name)] = true
+		narratorSlice = append(narratorSlice, name)
+	}
+
+	return narratorSlice
+}
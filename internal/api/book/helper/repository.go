@@ -0,0 +1,128 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/isbn"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/book"
+	OLModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/openlibrary.org"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+// The OpenLibrary operations required by the book repository, which are satisfied by an OpenLibrary client or a fake.
+type Provider interface {
+	OLGetAuthor(id string) (OLModel.OLAuthorResponse, error)
+	OLGetEdition(id string) (OLModel.OLEditionResponse, error)
+	OLGetWork(id string) (OLModel.OLWorkResponse, error)
+	OLSearchBook(query string, language string) (OLModel.OLBookSearchResponse, error)
+}
+
+// A book repository, which fetches, stores, and updates books in the provided database pool with
+// metadata from the provided OpenLibrary provider.
+type Repository struct {
+	connection database.PgxPool
+	client     Provider
+	logger     *log.Logger
+}
+
+// Create a book repository with a database pool, OpenLibrary provider, and logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, client Provider, logger *log.Logger) *Repository {
+	return &Repository{
+		connection: connection,
+		client:     client,
+		logger:     logger,
+	}
+}
+
+// Search OpenLibrary editions by title, author, or ISBN, optionally in a MARC language (e.g., "eng"), and map results
+// to the supported search result model.
+//
+// Return: mapped search result slice and nil with success, empty slice and error without.
+func (repository *Repository) SearchBooks(query string, language string) ([]model.BookSearchResult, error) {
+	results, err := repository.client.OLSearchBook(query, language)
+
+	if err != nil {
+		repository.logger.Printf("Unable to search OL editions with query '%s' and language '%s': %v", query, language, err)
+
+		return []model.BookSearchResult{}, err
+	}
+
+	return MapSearchResultSlice(results.Results), nil
+}
+
+// Store a book with a provided OL edition identifier or ISBN, which is normalized to an ISBN-13 (see isbn.Normalize),
+// unless a book with that identifier already exists.
+//
+// Return: numeric identifier, whether the book was newly stored, and nil with success; 0, false, and
+// isbn.ErrInvalidISBN before any provider call or error without.
+func (repository *Repository) StoreBook(id string) (int, bool, error) {
+	constraint := fmt.Sprintf("edition_reference='%s'", util.FormatPSQLString(id))
+
+	if !IsEditionId(id) {
+		isbn10, isbn13, err := isbn.Forms(id)
+
+		if err != nil {
+			return 0, false, err
+		}
+
+		id = isbn13
+		constraint = fmt.Sprintf("isbn13='%s'", isbn13)
+
+		if isbn10 != "" {
+			constraint += fmt.Sprintf(" OR isbn10='%s'", isbn10)
+		}
+	}
+
+	existingBook, err := repository.FetchBook(constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing book '%s': %v", id, err)
+
+		return 0, false, err
+	}
+
+	if existingBook.ID != 0 {
+		return existingBook.ID, false, nil
+	}
+
+	edition, err := repository.client.OLGetEdition(id)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch edition '%s' OL record: %v", id, err)
+
+		return 0, false, err
+	}
+
+	if len(edition.Works) == 0 {
+		err := errors.New("unable to store edition without related work")
+
+		repository.logger.Printf("Unable to store edition '%s' without related work: %v", id, err)
+
+		return 0, false, err
+	}
+
+	work, err := repository.client.OLGetWork(ExtractResourceId(edition.Works[0].ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch work '%s' OL record: %v", edition.Works[0].ID, err)
+
+		return 0, false, err
+	}
+
+	bookId, err := repository.ProcessBookStorage(edition, work)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	if bookId == 0 {
+		return 0, false, errors.New("unable to store book")
+	}
+
+	return bookId, true, nil
+}
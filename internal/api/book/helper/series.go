@@ -0,0 +1,211 @@
+package helper
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/book"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+var (
+	ErrBookNotFound   = errors.New("book not found")
+	ErrSeriesNotFound = errors.New("series not found")
+	ErrInvalidSeries  = errors.New("series name must not be empty and position must not be negative")
+)
+
+// Fetch every series, ordered by name.
+//
+// Return: series fragment slice and nil with success, empty slice and error without.
+func (repository *Repository) FetchSeriesSlice() ([]model.BookSeriesFragment, error) {
+	seriesSlice, err := service.FetchFragmentSlice[model.BookSeriesFragment](repository.connection, database.TableBookSeriesFragments, "")
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch series: %v", err)
+
+		return []model.BookSeriesFragment{}, err
+	}
+
+	slices.SortStableFunc(seriesSlice, func(a model.BookSeriesFragment, b model.BookSeriesFragment) int {
+		return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+
+	return seriesSlice, nil
+}
+
+// Fetch a series with its books in reading order (i.e., by position, then publish date).
+//
+// Return: series and nil with success, empty series and ErrSeriesNotFound or error without.
+func (repository *Repository) FetchSeries(id int) (model.Series, error) {
+	seriesFragment, err := service.FetchFragment[model.BookSeriesFragment](repository.connection, database.TableBookSeriesFragments, fmt.Sprintf("id=%d", id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch series '%d': %v", id, err)
+
+		return model.Series{}, err
+	}
+
+	if seriesFragment.ID == 0 {
+		return model.Series{}, ErrSeriesNotFound
+	}
+
+	relationshipSlice, err := service.FetchRelationshipSlice[model.BookSeriesRelationship](repository.connection, database.TableBookSeriesRelationships, fmt.Sprintf("series=%d", id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between series '%d' and books: %v", id, err)
+
+		return model.Series{}, err
+	}
+
+	series := model.Series{
+		ID:    seriesFragment.ID,
+		Name:  seriesFragment.Name,
+		Books: []model.SeriesBook{},
+	}
+
+	for _, relationship := range relationshipSlice {
+		book, err := repository.FetchBook(fmt.Sprintf("id=%d", relationship.Book))
+
+		if err != nil {
+			return model.Series{}, err
+		}
+
+		if book.ID != 0 {
+			series.Books = append(series.Books, model.SeriesBook{Position: relationship.Position, Book: book})
+		}
+	}
+
+	slices.SortStableFunc(series.Books, func(a model.SeriesBook, b model.SeriesBook) int {
+		if a.Position != b.Position {
+			return cmp.Compare(a.Position, b.Position)
+		}
+
+		return cmp.Compare(a.Book.PublishDate, b.Book.PublishDate)
+	})
+
+	return series, nil
+}
+
+// Add a book to a series by name, storing the series on first sight, or move it to a new position when already added.
+//
+// Return: book series and nil with success, empty book series and ErrInvalidSeries or ErrBookNotFound, or error
+// without.
+func (repository *Repository) StoreBookSeries(bookId int, update model.SeriesUpdate) (model.BookSeries, error) {
+	update.Name = strings.TrimSpace(update.Name)
+
+	if update.Name == "" || update.Position < 0 {
+		return model.BookSeries{}, ErrInvalidSeries
+	}
+
+	bookSlice, err := service.FetchExistenceSlice(repository.connection, database.TableBookFragments, fmt.Sprintf("id=%d", bookId))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence of book '%d': %v", bookId, err)
+
+		return model.BookSeries{}, err
+	}
+
+	if len(bookSlice) == 0 {
+		return model.BookSeries{}, ErrBookNotFound
+	}
+
+	seriesFragment, err := service.FetchFragment[model.BookSeriesFragment](repository.connection, database.TableBookSeriesFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(update.Name)))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing series '%s' fragment: %v", update.Name, err)
+
+		return model.BookSeries{}, err
+	}
+
+	if seriesFragment.ID == 0 {
+		seriesFragment.Name = update.Name
+		seriesFragment.ID, err = service.StoreFragment(repository.connection, database.TableBookSeriesFragments, database.PropertiesBookSeriesFragments, pgx.NamedArgs{
+			"name": update.Name,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store new series '%s' fragment: %v", update.Name, err)
+
+			return model.BookSeries{}, err
+		}
+	}
+
+	_, err = service.DeleteRelationship(repository.connection, database.TableBookSeriesRelationships, fmt.Sprintf("book=%d AND series=%d", bookId, seriesFragment.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to remove book '%d' from series '%d': %v", bookId, seriesFragment.ID, err)
+
+		return model.BookSeries{}, err
+	}
+
+	err = service.StoreRelationship(repository.connection, database.TableBookSeriesRelationships, database.PropertiesBookSeriesRelationships, pgx.NamedArgs{
+		"book":     bookId,
+		"series":   seriesFragment.ID,
+		"position": update.Position,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to add book '%d' to series '%d': %v", bookId, seriesFragment.ID, err)
+
+		return model.BookSeries{}, err
+	}
+
+	return model.BookSeries{ID: seriesFragment.ID, Name: seriesFragment.Name, Position: update.Position}, nil
+}
+
+// Remove a book from a series.
+//
+// Return: whether the book was in the series and nil with success, false and error without.
+func (repository *Repository) RemoveBookSeries(bookId int, seriesId int) (bool, error) {
+	count, err := service.DeleteRelationship(repository.connection, database.TableBookSeriesRelationships, fmt.Sprintf("book=%d AND series=%d", bookId, seriesId))
+
+	if err != nil {
+		repository.logger.Printf("Unable to remove book '%d' from series '%d': %v", bookId, seriesId, err)
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Rename a series.
+//
+// Return: series fragment and nil with success, empty series fragment and ErrInvalidSeries or ErrSeriesNotFound, or
+// error without.
+func (repository *Repository) UpdateSeries(id int, name string) (model.BookSeriesFragment, error) {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return model.BookSeriesFragment{}, ErrInvalidSeries
+	}
+
+	seriesSlice, err := service.FetchExistenceSlice(repository.connection, database.TableBookSeriesFragments, fmt.Sprintf("id=%d", id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence of series '%d': %v", id, err)
+
+		return model.BookSeriesFragment{}, err
+	}
+
+	if len(seriesSlice) == 0 {
+		return model.BookSeriesFragment{}, ErrSeriesNotFound
+	}
+
+	_, err = service.UpdateFragment(repository.connection, database.TableBookSeriesFragments, database.PropertiesBookSeriesFragments, fmt.Sprintf("id=%d", id), pgx.NamedArgs{
+		"name": name,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to update series '%d' fragment: %v", id, err)
+
+		return model.BookSeriesFragment{}, err
+	}
+
+	return model.BookSeriesFragment{ID: id, Name: name}, nil
+}
@@ -2,70 +2,146 @@ package helper
 
 import (
 	"fmt"
-	"os"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
-	OLAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/openlibrary.org"
 	"github.com/muzzarellimj/grace-material-api/internal/database"
 	"github.com/muzzarellimj/grace-material-api/internal/database/service"
 	model "github.com/muzzarellimj/grace-material-api/internal/model/book"
 	OLModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/openlibrary.org"
+	"github.com/muzzarellimj/grace-material-api/internal/personname"
 	"github.com/muzzarellimj/grace-material-api/internal/util"
 )
 
-func ProcessBookStorage(edition OLModel.OLEditionResponse, work OLModel.OLWorkResponse) (int, error) {
-	bookId, err := storeBookFragment(edition, work)
+func (repository *Repository) ProcessBookStorage(edition OLModel.OLEditionResponse, work OLModel.OLWorkResponse) (int, error) {
+	err := repository.processWorkStorage(work)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to store book '%s' fragment: %v\n", ExtractResourceId(edition.ID), err)
+		repository.logger.Printf("Unable to store work '%s': %v", ExtractResourceId(work.ID), err)
 
 		return 0, err
 	}
 
-	authorIdSlice := processAuthorFragmentSliceStorage(edition.Authors)
-	publisherIdSlice := processPublisherFragmentSliceStorage(edition.Publishers)
-	topicIdSlice := processTopicFragmentSliceStorage(work.Subjects)
+	bookId, err := repository.storeBookFragment(edition, work)
 
-	service.StoreRelationshipSlice(database.Connection, database.TableBookAuthorRelationships, database.PropertiesBookAuthorRelationships, service.RelationshipSliceArgument{
+	if err != nil {
+		repository.logger.Printf("Unable to store book '%s' fragment: %v", ExtractResourceId(edition.ID), err)
+
+		return 0, err
+	}
+
+	authorIdSlice := repository.processAuthorFragmentSliceStorage(edition.Authors)
+	narratorIdSlice := repository.processNarratorFragmentSliceStorage(ExtractNarrators(edition))
+	publisherIdSlice := repository.processPublisherFragmentSliceStorage(edition.Publishers)
+
+	seriesSlice := edition.Series
+
+	if len(seriesSlice) == 0 {
+		seriesSlice = work.Series
+	}
+
+	for _, statement := range seriesSlice {
+		name, position := ExtractSeries(statement)
+
+		_, err := repository.StoreBookSeries(bookId, model.SeriesUpdate{Name: name, Position: position})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store book '%d' series '%s': %v", bookId, statement, err)
+		}
+	}
+
+	service.StoreRelationshipSlice(repository.connection, database.TableBookAuthorRelationships, database.PropertiesBookAuthorRelationships, service.RelationshipSliceArgument{
 		SourceName:          "book",
 		SourceArgument:      bookId,
 		DestinationName:     "author",
 		DestinationArgument: authorIdSlice,
 	})
 
-	service.StoreRelationshipSlice(database.Connection, database.TableBookPublisherRelationships, database.PropertiesBookPublisherRelationships, service.RelationshipSliceArgument{
+	service.StoreRelationshipSlice(repository.connection, database.TableBookNarratorRelationships, database.PropertiesBookNarratorRelationships, service.RelationshipSliceArgument{
 		SourceName:          "book",
 		SourceArgument:      bookId,
-		DestinationName:     "publisher",
-		DestinationArgument: publisherIdSlice,
+		DestinationName:     "narrator",
+		DestinationArgument: narratorIdSlice,
 	})
 
-	service.StoreRelationshipSlice(database.Connection, database.TableBookTopicRelationships, database.PropertiesBookTopicRelationships, service.RelationshipSliceArgument{
+	service.StoreRelationshipSlice(repository.connection, database.TableBookPublisherRelationships, database.PropertiesBookPublisherRelationships, service.RelationshipSliceArgument{
 		SourceName:          "book",
 		SourceArgument:      bookId,
+		DestinationName:     "publisher",
+		DestinationArgument: publisherIdSlice,
+	})
+
+	return bookId, nil
+}
+
+// Store a work with its description and topics, which are shared between its editions, unless a work with the same OL
+// work identifier already exists.
+//
+// Return: nil with success, error without.
+func (repository *Repository) processWorkStorage(work OLModel.OLWorkResponse) error {
+	existingWorkSlice, err := service.FetchExistenceSlice(repository.connection, database.TableBookWorkFragments, fmt.Sprintf("reference='%s'", util.FormatPSQLString(ExtractResourceId(work.ID))))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing work '%s': %v", ExtractResourceId(work.ID), err)
+
+		return err
+	}
+
+	if len(existingWorkSlice) != 0 {
+		return nil
+	}
+
+	workId, err := service.StoreFragment(repository.connection, database.TableBookWorkFragments, database.PropertiesBookWorkFragments, pgx.NamedArgs{
+		"title":       work.Title,
+		"description": ExtractDescription(work.Description),
+		"reference":   ExtractResourceId(work.ID),
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store work fragment '%s': %v", ExtractResourceId(work.ID), err)
+
+		return err
+	}
+
+	topicIdSlice := repository.processTopicFragmentSliceStorage(work.Subjects)
+
+	service.StoreRelationshipSlice(repository.connection, database.TableBookWorkTopicRelationships, database.PropertiesBookWorkTopicRelationships, service.RelationshipSliceArgument{
+		SourceName:          "work",
+		SourceArgument:      workId,
 		DestinationName:     "topic",
 		DestinationArgument: topicIdSlice,
 	})
 
-	return bookId, nil
+	return nil
 }
 
-func storeBookFragment(edition OLModel.OLEditionResponse, work OLModel.OLWorkResponse) (int, error) {
-	bookId, err := service.StoreFragment(database.Connection, database.TableBookFragments, database.PropertiesBookFragments, pgx.NamedArgs{
+func (repository *Repository) storeBookFragment(edition OLModel.OLEditionResponse, work OLModel.OLWorkResponse) (int, error) {
+	isbn10, isbn13 := ExtractISBNForms(edition.ISBN10, edition.ISBN13)
+	format, duration := ExtractFormat(edition), 0
+
+	if format == FormatAudiobook {
+		duration = ExtractDuration(edition.Pagination)
+	}
+
+	bookId, err := service.StoreFragment(repository.connection, database.TableBookFragments, database.PropertiesBookFragments, pgx.NamedArgs{
 		"title":             edition.Title,
 		"subtitle":          edition.Subtitle,
-		"description":       ExtractDescription(work.Description),
 		"publish_date":      util.ParseDateTime(edition.PublishDate),
 		"pages":             edition.Pages,
-		"isbn10":            ExtractISBN(edition.ISBN10),
-		"isbn13":            ExtractISBN(edition.ISBN13),
+		"format":            format,
+		"duration":          duration,
+		"languages":         ExtractLanguages(edition.Languages),
+		"original_language": ExtractOriginalLanguage(edition),
+		"translation_of":    strings.TrimSpace(edition.TranslationOf),
+		"isbn10":            isbn10,
+		"isbn13":            isbn13,
 		"image":             fmt.Sprintf("https://covers.openlibrary.org/b/olid/%s-L.jpg", ExtractResourceId(edition.ID)),
 		"edition_reference": ExtractResourceId(edition.ID),
 		"work_reference":    ExtractResourceId(work.ID),
 	})
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to store book fragment '%s': %v\n", ExtractResourceId(edition.ID), err)
+		repository.logger.Printf("Unable to store book fragment '%s': %v", ExtractResourceId(edition.ID), err)
 
 		return 0, err
 	}
@@ -73,14 +149,14 @@ func storeBookFragment(edition OLModel.OLEditionResponse, work OLModel.OLWorkRes
 	return bookId, nil
 }
 
-func processAuthorFragmentSliceStorage(authors []OLModel.OLResourceReference) []int {
+func (repository *Repository) processAuthorFragmentSliceStorage(authors []OLModel.OLResourceReference) []int {
 	var authorIdSlice []int
 
 	for _, resource := range authors {
-		existingAuthorFragment, err := service.FetchFragment[model.BookAuthorFragment](database.Connection, database.TableBookAuthorFragments, fmt.Sprintf("reference='%s'", ExtractResourceId(resource.ID)))
+		existingAuthorFragment, err := service.FetchFragment[model.BookAuthorFragment](repository.connection, database.TableBookAuthorFragments, fmt.Sprintf("reference='%s'", ExtractResourceId(resource.ID)))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch existing author '%s' fragment: %v\n", ExtractResourceId(resource.ID), err)
+			repository.logger.Printf("Unable to fetch existing author '%s' fragment: %v", ExtractResourceId(resource.ID), err)
 
 			continue
 		}
@@ -91,27 +167,30 @@ func processAuthorFragmentSliceStorage(authors []OLModel.OLResourceReference) []
 			continue
 		}
 
-		author, err := OLAPI.OLGetAuthor(ExtractResourceId(resource.ID))
+		author, err := repository.client.OLGetAuthor(ExtractResourceId(resource.ID))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch author '%s' OL record: %v\n", ExtractResourceId(resource.ID), err)
+			repository.logger.Printf("Unable to fetch author '%s' OL record: %v", ExtractResourceId(resource.ID), err)
 
 			continue
 		}
 
-		firstName, middleName, lastName := ExtractName(author.Name)
-
-		authorId, err := service.StoreFragment(database.Connection, database.TableBookAuthorFragments, database.PropertiesBookAuthorFragments, pgx.NamedArgs{
-			"first_name":  firstName,
-			"middle_name": middleName,
-			"last_name":   lastName,
-			"biography":   author.Biography,
-			"image":       fmt.Sprintf("https://covers.openlibrary.org/a/olid/%s-L.jpg", ExtractResourceId(author.ID)),
-			"reference":   ExtractResourceId(author.ID),
+		name := personname.Parse(author.Name, author.AlternateNames...)
+
+		authorId, err := service.StoreFragment(repository.connection, database.TableBookAuthorFragments, database.PropertiesBookAuthorFragments, pgx.NamedArgs{
+			"first_name":      name.First,
+			"middle_name":     name.Middle,
+			"last_name":       strings.TrimSpace(name.Last + " " + name.Suffix),
+			"display_name":    name.Display(),
+			"sort_name":       name.Sort(),
+			"alternate_names": ExtractAlternateNames(author.Name, author.AlternateNames),
+			"biography":       author.Biography,
+			"image":           fmt.Sprintf("https://covers.openlibrary.org/a/olid/%s-L.jpg", ExtractResourceId(author.ID)),
+			"reference":       ExtractResourceId(author.ID),
 		})
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to store new author '%s' fragment: %v\n", ExtractResourceId(author.ID), err)
+			repository.logger.Printf("Unable to store new author '%s' fragment: %v", ExtractResourceId(author.ID), err)
 
 			continue
 		}
@@ -124,14 +203,52 @@ func processAuthorFragmentSliceStorage(authors []OLModel.OLResourceReference) []
 	return authorIdSlice
 }
 
-func processPublisherFragmentSliceStorage(publishers []string) []int {
+// Store narrators, matched by name, where the sort name is parsed from the name (see personname.Parse).
+func (repository *Repository) processNarratorFragmentSliceStorage(names []string) []int {
+	var narratorIdSlice []int
+
+	for _, name := range names {
+		existingNarratorFragment, err := service.FetchFragment[model.BookNarratorFragment](repository.connection, database.TableBookNarratorFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(name)))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch existing narrator '%s' fragment: %v", name, err)
+
+			continue
+		}
+
+		if existingNarratorFragment.ID != 0 {
+			narratorIdSlice = append(narratorIdSlice, existingNarratorFragment.ID)
+
+			continue
+		}
+
+		narratorId, err := service.StoreFragment(repository.connection, database.TableBookNarratorFragments, database.PropertiesBookNarratorFragments, pgx.NamedArgs{
+			"name":      name,
+			"sort_name": personname.Parse(name).Sort(),
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store new narrator '%s' fragment: %v", name, err)
+
+			continue
+		}
+
+		if narratorId != 0 {
+			narratorIdSlice = append(narratorIdSlice, narratorId)
+		}
+	}
+
+	return narratorIdSlice
+}
+
+func (repository *Repository) processPublisherFragmentSliceStorage(publishers []string) []int {
 	var publisherIdSlice []int
 
 	for _, publisher := range publishers {
-		existingPublisherFragment, err := service.FetchFragment[model.BookPublisherFragment](database.Connection, database.TableBookPublisherFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(publisher)))
+		existingPublisherFragment, err := service.FetchFragment[model.BookPublisherFragment](repository.connection, database.TableBookPublisherFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(publisher)))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch existing publisher '%s' fragment: %v\n", publisher, err)
+			repository.logger.Printf("Unable to fetch existing publisher '%s' fragment: %v", publisher, err)
 
 			continue
 		}
@@ -142,12 +259,12 @@ func processPublisherFragmentSliceStorage(publishers []string) []int {
 			continue
 		}
 
-		publisherId, err := service.StoreFragment(database.Connection, database.TableBookPublisherFragments, database.PropertiesBookPublisherFragments, pgx.NamedArgs{
+		publisherId, err := service.StoreFragment(repository.connection, database.TableBookPublisherFragments, database.PropertiesBookPublisherFragments, pgx.NamedArgs{
 			"name": publisher,
 		})
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to store new publisher '%s' fragment: %v\n", publisher, err)
+			repository.logger.Printf("Unable to store new publisher '%s' fragment: %v", publisher, err)
 
 			continue
 		}
@@ -160,14 +277,14 @@ func processPublisherFragmentSliceStorage(publishers []string) []int {
 	return publisherIdSlice
 }
 
-func processTopicFragmentSliceStorage(topics []string) []int {
+func (repository *Repository) processTopicFragmentSliceStorage(topics []string) []int {
 	var topicIdSlice []int
 
 	for _, topic := range topics {
-		existingTopicFragment, err := service.FetchFragment[model.BookTopicFragment](database.Connection, database.TableBookTopicFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(topic)))
+		existingTopicFragment, err := service.FetchFragment[model.BookTopicFragment](repository.connection, database.TableBookTopicFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(topic)))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch existing topic '%s' fragment: %v\n", topic, err)
+			repository.logger.Printf("Unable to fetch existing topic '%s' fragment: %v", topic, err)
 
 			continue
 		}
@@ -178,12 +295,12 @@ func processTopicFragmentSliceStorage(topics []string) []int {
 			continue
 		}
 
-		topicId, err := service.StoreFragment(database.Connection, database.TableBookTopicFragments, database.PropertiesBookTopicFragments, pgx.NamedArgs{
+		topicId, err := service.StoreFragment(repository.connection, database.TableBookTopicFragments, database.PropertiesBookTopicFragments, pgx.NamedArgs{
 			"name": topic,
 		})
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to store new topic '%s' fragment: %v\n", topic, err)
+			repository.logger.Printf("Unable to store new topic '%s' fragment: %v", topic, err)
 
 			continue
 		}
@@ -2,7 +2,6 @@ package helper
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/muzzarellimj/grace-material-api/internal/database"
@@ -10,13 +9,21 @@ import (
 	model "github.com/muzzarellimj/grace-material-api/internal/model/book"
 )
 
-func UpdateBookFragment(book model.BookFragment) (int, error) {
-	id, err := service.UpdateFragment(database.Connection, database.TableBookFragments, database.PropertiesBookFragments, fmt.Sprintf("id=%d", book.ID), pgx.NamedArgs{
+func (repository *Repository) UpdateBookFragment(book model.BookFragment) (int, error) {
+	if book.Languages == nil {
+		book.Languages = []string{}
+	}
+
+	id, err := service.UpdateFragment(repository.connection, database.TableBookFragments, database.PropertiesBookFragments, fmt.Sprintf("id=%d", book.ID), pgx.NamedArgs{
 		"title":             book.Title,
 		"subtitle":          book.Subtitle,
-		"description":       book.Description,
 		"publish_date":      book.PublishDate,
 		"pages":             book.Pages,
+		"format":            book.Format,
+		"duration":          book.Duration,
+		"languages":         book.Languages,
+		"original_language": book.OriginalLanguage,
+		"translation_of":    book.TranslationOf,
 		"isbn10":            book.ISBN10,
 		"isbn13":            book.ISBN13,
 		"image":             book.Image,
@@ -25,7 +32,26 @@ func UpdateBookFragment(book model.BookFragment) (int, error) {
 	})
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to update book '%d' fragment: %v\n", book.ID, err)
+		repository.logger.Printf("Unable to update book '%d' fragment: %v", book.ID, err)
+
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Update the title and description of a work, which are shared between its editions, where the OL work identifier
+// linking editions to the work may not be changed.
+//
+// Return: numeric identifier and nil with success, 0 and error without.
+func (repository *Repository) UpdateWorkFragment(work model.BookWorkFragment) (int, error) {
+	id, err := service.UpdateFragment(repository.connection, database.TableBookWorkFragments, []string{"title", "description"}, fmt.Sprintf("id=%d", work.ID), pgx.NamedArgs{
+		"title":       work.Title,
+		"description": work.Description,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to update work '%d' fragment: %v", work.ID, err)
 
 		return 0, err
 	}
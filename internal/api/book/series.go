@@ -0,0 +1,210 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/book/helper"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/book"
+)
+
+func (handler *Handler) HandleGetSeriesSlice(context *gin.Context) {
+	seriesSlice, err := handler.repository.FetchSeriesSlice()
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to fetch series and map to supported data structure.",
+		})
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   seriesSlice,
+	})
+}
+
+// Handle a series request, responding with the series and its books in reading order.
+func (handler *Handler) HandleGetSeries(context *gin.Context) {
+	id, ok := bindSeriesId(context)
+
+	if !ok {
+		return
+	}
+
+	series, err := handler.repository.FetchSeries(id)
+
+	if err != nil {
+		respondWithSeriesError(context, err)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   series,
+	})
+}
+
+func (handler *Handler) HandlePutSeries(context *gin.Context) {
+	id, ok := bindSeriesId(context)
+
+	if !ok {
+		return
+	}
+
+	var update model.SeriesUpdate
+
+	err := context.BindJSON(&update)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to series model.",
+		})
+
+		return
+	}
+
+	series, err := handler.repository.UpdateSeries(id, update.Name)
+
+	if err != nil {
+		respondWithSeriesError(context, err)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   series,
+	})
+}
+
+// Handle the addition of the book in query parameter 'id' to a series, by name and position, or the move of the book
+// to a new position in a series it is already in.
+func (handler *Handler) HandlePostBookSeries(context *gin.Context) {
+	id, err := strconv.Atoi(context.Query("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	var update model.SeriesUpdate
+
+	err = context.BindJSON(&update)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to series model.",
+		})
+
+		return
+	}
+
+	series, err := handler.repository.StoreBookSeries(id, update)
+
+	if err != nil {
+		respondWithSeriesError(context, err)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   series,
+	})
+}
+
+func (handler *Handler) HandleDeleteBookSeries(context *gin.Context) {
+	id, err := strconv.Atoi(context.Query("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	seriesId, err := strconv.Atoi(context.Query("series"))
+
+	if err != nil || seriesId <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid series identifier argument '%s' provided in query parameter 'series'.", context.Query("series")),
+		})
+
+		return
+	}
+
+	removed, err := handler.repository.RemoveBookSeries(id, seriesId)
+
+	if err != nil {
+		respondWithSeriesError(context, err)
+
+		return
+	}
+
+	if !removed {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find book '%d' in series '%d'.", id, seriesId),
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
+
+func bindSeriesId(context *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(context.Param("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid series identifier argument '%s' provided in route parameter 'id'.", context.Param("id")),
+		})
+
+		return 0, false
+	}
+
+	return id, true
+}
+
+func respondWithSeriesError(context *gin.Context, err error) {
+	switch {
+	case errors.Is(err, helper.ErrSeriesNotFound):
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find series with numeric identifier '%s'.", context.Param("id")),
+		})
+	case errors.Is(err, helper.ErrBookNotFound):
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find book with numeric identifier '%s'.", context.Query("id")),
+		})
+	case errors.Is(err, helper.ErrInvalidSeries):
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Invalid series provided: name must not be empty and position must not be negative.",
+		})
+	default:
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to modify series.",
+		})
+	}
+}
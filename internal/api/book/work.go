@@ -0,0 +1,119 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/book/helper"
+	collectionHelper "github.com/muzzarellimj/grace-material-api/internal/api/collection/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/book"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+// Handle a work request, by numeric identifier or OL work identifier in query parameter 'id', responding with the work
+// and its stored editions, or only the editions in the collection of the principal when query parameter 'owned' is
+// 'true'.
+func (handler *Handler) HandleGetWork(context *gin.Context) {
+	idArg := context.Query("id")
+
+	var constraint string
+
+	if id, err := strconv.Atoi(idArg); err == nil && id > 0 {
+		constraint = fmt.Sprintf("id=%d", id)
+	} else if reference := helper.ExtractResourceId(idArg); reference != "" && reference == idArg {
+		constraint = fmt.Sprintf("reference='%s'", util.FormatPSQLString(reference))
+	} else {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid work identifier argument '%s' provided in query parameter 'id'.", idArg),
+		})
+
+		return
+	}
+
+	var editionConstraint string
+
+	if context.Query("owned") == "true" {
+		principal, ok := middleware.Principal(context)
+
+		if !ok {
+			context.IndentedJSON(http.StatusUnauthorized, gin.H{
+				"status":  http.StatusUnauthorized,
+				"message": "Unable to filter by owned editions without an authenticated principal.",
+			})
+
+			return
+		}
+
+		editionConstraint = collectionHelper.Constraint("id", material.TypeBook, principal.Subject)
+	}
+
+	work, err := handler.repository.FetchWork(constraint, editionConstraint)
+
+	if errors.Is(err, helper.ErrWorkNotFound) {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find work with identifier '%s'.", idArg),
+		})
+
+		return
+	}
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to fetch work and map to supported data structure.",
+		})
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   work,
+	})
+}
+
+func (handler *Handler) HandlePutWork(context *gin.Context) {
+	var work model.BookWorkFragment
+
+	err := context.BindJSON(&work)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to work model.",
+		})
+
+		return
+	}
+
+	id, err := handler.repository.UpdateWorkFragment(work)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to update work fragment.",
+		})
+
+		return
+	}
+
+	if id == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data": map[string]any{
+			"id": id,
+		},
+	})
+}
@@ -0,0 +1,191 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/collection/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+)
+
+const errorMessage string = "Unable to fetch collection and map to supported data structure."
+
+// A collection request handler, which holds the dependencies shared between collection routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a collection request handler with a collection repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetCollection(context *gin.Context) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to fetch collection without an authenticated principal.",
+		})
+
+		return
+	}
+
+	var materialTypes []string
+
+	if typeArg := context.Query("type"); typeArg != "" {
+		materialTypes = strings.Split(typeArg, ",")
+
+		for _, materialType := range materialTypes {
+			if !handler.repository.Supports(materialType) {
+				context.IndentedJSON(http.StatusBadRequest, gin.H{
+					"status":  http.StatusBadRequest,
+					"message": fmt.Sprintf("Invalid material type argument '%s' provided in query parameter 'type'.", materialType),
+				})
+
+				return
+			}
+		}
+	}
+
+	collection, err := handler.repository.FetchCollection(principal.Subject, materialTypes, context.Query("tag"))
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if collection.IsEmpty() {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   collection,
+	})
+}
+
+func (handler *Handler) HandlePostCollectionItem(context *gin.Context) {
+	principal, materialType, id, ok := handler.bindItemRequest(context)
+
+	if !ok {
+		return
+	}
+
+	exists, created, err := handler.repository.AddCollectionItem(principal, materialType, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to add material to collection.",
+		})
+
+		return
+	}
+
+	if !exists {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find %s with numeric identifier '%d'.", materialType, id),
+		})
+
+		return
+	}
+
+	status := http.StatusOK
+
+	if created {
+		status = http.StatusCreated
+	}
+
+	context.IndentedJSON(status, gin.H{
+		"status": status,
+		"data": map[string]any{
+			"type": materialType,
+			"id":   id,
+		},
+	})
+}
+
+func (handler *Handler) HandleDeleteCollectionItem(context *gin.Context) {
+	principal, materialType, id, ok := handler.bindItemRequest(context)
+
+	if !ok {
+		return
+	}
+
+	removed, err := handler.repository.RemoveCollectionItem(principal, materialType, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to remove material from collection.",
+		})
+
+		return
+	}
+
+	if !removed {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find %s with numeric identifier '%d' in collection.", materialType, id),
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
+
+// Bind the principal subject, material type route parameter, and numeric identifier query parameter shared by
+// collection item routes, responding with an error when any is missing or invalid.
+func (handler *Handler) bindItemRequest(context *gin.Context) (string, string, int, bool) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to modify collection without an authenticated principal.",
+		})
+
+		return "", "", 0, false
+	}
+
+	materialType := context.Param("type")
+
+	if !handler.repository.Supports(materialType) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material type argument '%s' provided in route parameter 'type'.", materialType),
+		})
+
+		return "", "", 0, false
+	}
+
+	id, err := strconv.Atoi(context.Query("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return "", "", 0, false
+	}
+
+	return principal.Subject, materialType, id, true
+}
@@ -0,0 +1,158 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	tagHelper "github.com/muzzarellimj/grace-material-api/internal/api/tag/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/collection"
+)
+
+// Fetch the collection owned by the user with the provided reference, including items of the provided material
+// types (or every enabled material type when none are provided) and, optionally, only items with a tag name of the
+// user, most recently added first.
+//
+// Return: collection and nil with success, empty collection and error without.
+func (repository *Repository) FetchCollection(reference string, materialTypes []string, tag string) (model.Collection, error) {
+	fragment, err := repository.ResolveCollection(reference)
+
+	if err != nil {
+		return model.Collection{}, err
+	}
+
+	if len(materialTypes) == 0 {
+		materialTypes = repository.materialTypes
+	}
+
+	collection := model.Collection{
+		ID:          fragment.ID,
+		Name:        fragment.Name,
+		Books:       []model.CollectionItem{},
+		Games:       []model.CollectionItem{},
+		Movies:      []model.CollectionItem{},
+		Shows:       []model.CollectionItem{},
+		Albums:      []model.CollectionItem{},
+		BoardGames:  []model.CollectionItem{},
+		Comics:      []model.CollectionItem{},
+		Podcasts:    []model.CollectionItem{},
+		DateCreated: fragment.DateCreated,
+	}
+
+	for _, materialType := range materialTypes {
+		if !repository.Supports(materialType) {
+			continue
+		}
+
+		collectable, _ := lookup(materialType)
+
+		constraint := fmt.Sprintf("r.collection=%d", fragment.ID)
+
+		if tag != "" {
+			constraint = fmt.Sprintf("%s AND %s", constraint, tagHelper.Constraint("m.id", materialType, reference, tag))
+		}
+
+		itemSlice, err := repository.fetchCollectionItemSlice(collectable, constraint)
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch '%s' items in collection '%d': %v", materialType, fragment.ID, err)
+
+			return model.Collection{}, err
+		}
+
+		switch materialType {
+		case material.TypeBook:
+			collection.Books = itemSlice
+		case material.TypeGame:
+			collection.Games = itemSlice
+		case material.TypeMovie:
+			collection.Movies = itemSlice
+		case material.TypeShow:
+			collection.Shows = itemSlice
+		case material.TypeAlbum:
+			collection.Albums = itemSlice
+		case material.TypeBoardGame:
+			collection.BoardGames = itemSlice
+		case material.TypeComic:
+			collection.Comics = itemSlice
+		case material.TypePodcast:
+			collection.Podcasts = itemSlice
+		}
+	}
+
+	return collection, nil
+}
+
+func (repository *Repository) fetchCollectionItemSlice(collectable collectable, constraint string) ([]model.CollectionItem, error) {
+	selection := "m.id, m.title, m.image, r.date_added"
+
+	if collectable.columns != "" {
+		selection = fmt.Sprintf("%s, %s", selection, collectable.columns)
+	}
+
+	statement, err := database.CreateQuery(
+		selection,
+		fmt.Sprintf("%s r", collectable.bridge),
+		constraint,
+		"",
+		fmt.Sprintf("JOIN %s m ON m.id = r.%s", collectable.Table, collectable.Column),
+	)
+
+	if err != nil {
+		return []model.CollectionItem{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return []model.CollectionItem{}, err
+	}
+
+	itemSlice, err := database.MapQueryResponse[model.CollectionItem](rows)
+
+	if err != nil {
+		return []model.CollectionItem{}, err
+	}
+
+	slices.SortStableFunc(itemSlice, func(a model.CollectionItem, b model.CollectionItem) int {
+		return cmp.Compare(b.DateAdded, a.DateAdded)
+	})
+
+	if itemSlice == nil {
+		itemSlice = []model.CollectionItem{}
+	}
+
+	return itemSlice, nil
+}
+
+func (repository *Repository) fetchMaterialExistence(collectable collectable, id int) (bool, error) {
+	return repository.fetchExistence(collectable.Table, fmt.Sprintf("id=%d", id))
+}
+
+func (repository *Repository) fetchItemExistence(collection int, collectable collectable, id int) (bool, error) {
+	return repository.fetchExistence(collectable.bridge, fmt.Sprintf("collection=%d AND %s=%d", collection, collectable.Column, id))
+}
+
+func (repository *Repository) fetchExistence(table string, constraint string) (bool, error) {
+	statement, err := database.CreateQuery("1", table, constraint, "")
+
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return false, err
+	}
+
+	response, err := database.MapQueryResponse[int](rows)
+
+	if err != nil {
+		return false, err
+	}
+
+	return len(response) > 0, nil
+}
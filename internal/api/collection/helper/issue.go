@@ -0,0 +1,142 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	comicHelper "github.com/muzzarellimj/grace-material-api/internal/api/comic/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/collection"
+)
+
+// Add an issue of a comic to the collection owned by the user with the provided reference (i.e., mark the issue as
+// owned), unless it is already collected.
+//
+// Return: whether the issue exists, whether it was newly added, and nil with success; false, false, and error without.
+func (repository *Repository) AddCollectionIssue(reference string, issue int) (bool, bool, error) {
+	exists, err := repository.fetchExistence(database.TableComicIssueFragments, fmt.Sprintf("id=%d", issue))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence of issue '%d': %v", issue, err)
+
+		return false, false, err
+	}
+
+	if !exists {
+		return false, false, nil
+	}
+
+	collection, err := repository.ResolveCollection(reference)
+
+	if err != nil {
+		return false, false, err
+	}
+
+	collected, err := repository.fetchExistence(database.TableCollectionIssueRelationships, fmt.Sprintf("collection=%d AND issue=%d", collection.ID, issue))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence of issue '%d' in collection '%d': %v", issue, collection.ID, err)
+
+		return false, false, err
+	}
+
+	if collected {
+		return true, false, nil
+	}
+
+	err = service.StoreRelationship(repository.connection, database.TableCollectionIssueRelationships, database.PropertiesCollectionIssueRelationships, pgx.NamedArgs{
+		"collection": collection.ID,
+		"issue":      issue,
+		"date_added": time.Now().Unix(),
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store issue '%d' in collection '%d': %v", issue, collection.ID, err)
+
+		return false, false, err
+	}
+
+	return true, true, nil
+}
+
+// Remove an issue of a comic from the collection owned by the user with the provided reference.
+//
+// Return: whether the issue was collected and nil with success, false and error without.
+func (repository *Repository) RemoveCollectionIssue(reference string, issue int) (bool, error) {
+	collection, err := repository.ResolveCollection(reference)
+
+	if err != nil {
+		return false, err
+	}
+
+	count, err := service.DeleteRelationship(repository.connection, database.TableCollectionIssueRelationships, fmt.Sprintf("collection=%d AND issue=%d", collection.ID, issue))
+
+	if err != nil {
+		repository.logger.Printf("Unable to remove issue '%d' from collection '%d': %v", issue, collection.ID, err)
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Fetch the issues of a comic in the collection owned by the user with the provided reference, in volume and issue
+// order.
+//
+// Return: collected issue slice and nil with success, empty slice and error without.
+func (repository *Repository) FetchCollectionIssueSlice(reference string, comic int) ([]model.CollectionIssue, error) {
+	collection, err := repository.ResolveCollection(reference)
+
+	if err != nil {
+		return []model.CollectionIssue{}, err
+	}
+
+	statement, err := database.CreateQuery(
+		"i.id, i.volume, v.title AS volume_title, v.start_date AS volume_start_date, i.number, i.title, i.image, r.date_added",
+		fmt.Sprintf("%s r", database.TableCollectionIssueRelationships),
+		fmt.Sprintf("r.collection=%d AND i.comic=%d", collection.ID, comic),
+		"",
+		fmt.Sprintf("JOIN %s i ON i.id = r.issue", database.TableComicIssueFragments),
+		fmt.Sprintf("JOIN %s v ON v.id = i.volume", database.TableComicVolumeFragments),
+	)
+
+	if err != nil {
+		return []model.CollectionIssue{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch issues of comic '%d' in collection '%d': %v", comic, collection.ID, err)
+
+		return []model.CollectionIssue{}, err
+	}
+
+	issueSlice, err := database.MapQueryResponse[model.CollectionIssue](rows)
+
+	if err != nil {
+		return []model.CollectionIssue{}, err
+	}
+
+	slices.SortStableFunc(issueSlice, func(a model.CollectionIssue, b model.CollectionIssue) int {
+		if a.VolumeStartDate != b.VolumeStartDate {
+			return cmp.Compare(a.VolumeStartDate, b.VolumeStartDate)
+		}
+
+		if a.Volume != b.Volume {
+			return cmp.Compare(a.Volume, b.Volume)
+		}
+
+		return comicHelper.CompareIssueNumber(a.Number, b.Number)
+	})
+
+	if issueSlice == nil {
+		issueSlice = []model.CollectionIssue{}
+	}
+
+	return issueSlice, nil
+}
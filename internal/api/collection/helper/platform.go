@@ -0,0 +1,56 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+var ErrInvalidPlatform = errors.New("platform must be one of the platforms of the game")
+
+// Set the platform a game is owned on in the collection owned by the user with the provided reference, where the
+// platform is the numeric identifier of one of the platforms of the game, or 0 to clear it. The user and collection are
+// not stored on first sight, since a user without a collection has no collected game.
+//
+// Return: whether the game is collected and nil with success; false and ErrInvalidPlatform with a platform the game
+// was not released on, or false and error without.
+func (repository *Repository) SetCollectionGamePlatform(reference string, game int, platform int) (bool, error) {
+	var argument any
+
+	if platform != 0 {
+		released, err := repository.fetchExistence(database.TableGamePlatformRelationships, fmt.Sprintf("game=%d AND platform=%d", game, platform))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch existence of platform '%d' of game '%d': %v", platform, game, err)
+
+			return false, err
+		}
+
+		if !released {
+			return false, ErrInvalidPlatform
+		}
+
+		argument = platform
+	}
+
+	constraint := fmt.Sprintf(
+		"collection IN (SELECT c.id FROM %s c JOIN %s u ON u.id = c.owner WHERE u.reference='%s') AND game=%d",
+		database.TableCollectionFragments, database.TableUserFragments, util.FormatPSQLString(reference), game,
+	)
+
+	count, err := service.UpdateRelationship(repository.connection, database.TableCollectionGameRelationships, database.PropertiesCollectionGamePlatform, constraint, pgx.NamedArgs{
+		"platform": argument,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to set platform of game '%d' in collection of user '%s': %v", game, reference, err)
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,151 @@
+package helper
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/collection"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+const defaultCollectionName string = "Collection"
+
+// A material type which may be collected, described by its collection relationship table and properties, and any
+// further columns of the relationship table selected with each item (e.g., 'r.platform').
+type collectable struct {
+	material.Material
+
+	bridge     string
+	properties []string
+	columns    string
+}
+
+var collectables = map[string]collectable{
+	material.TypeBook:      {bridge: database.TableCollectionBookRelationships, properties: database.PropertiesCollectionBookRelationships},
+	material.TypeGame:      {bridge: database.TableCollectionGameRelationships, properties: database.PropertiesCollectionGameRelationships, columns: "r.platform"},
+	material.TypeMovie:     {bridge: database.TableCollectionMovieRelationships, properties: database.PropertiesCollectionMovieRelationships},
+	material.TypeShow:      {bridge: database.TableCollectionShowRelationships, properties: database.PropertiesCollectionShowRelationships},
+	material.TypeAlbum:     {bridge: database.TableCollectionAlbumRelationships, properties: database.PropertiesCollectionAlbumRelationships},
+	material.TypeBoardGame: {bridge: database.TableCollectionBoardGameRelationships, properties: database.PropertiesCollectionBoardGameRelationships},
+	material.TypeComic:     {bridge: database.TableCollectionComicRelationships, properties: database.PropertiesCollectionComicRelationships},
+	material.TypePodcast:   {bridge: database.TableCollectionPodcastRelationships, properties: database.PropertiesCollectionPodcastRelationships},
+}
+
+func lookup(materialType string) (collectable, bool) {
+	collectable, exists := collectables[materialType]
+
+	if !exists {
+		return collectable, false
+	}
+
+	collectable.Material, exists = material.Lookup(materialType)
+
+	return collectable, exists
+}
+
+// Create a constraint to filter materials of a type by those in the collection of the user with the provided
+// reference, where the column holds the material numeric identifier (e.g., "m.id").
+//
+// Return: constraint, which matches nothing for an unknown material type.
+func Constraint(column string, materialType string, reference string) string {
+	collectable, exists := lookup(materialType)
+
+	if !exists {
+		return "FALSE"
+	}
+
+	return fmt.Sprintf(
+		"%s IN (SELECT r.%s FROM %s r JOIN %s c ON c.id = r.collection JOIN %s u ON u.id = c.owner WHERE u.reference='%s')",
+		column, collectable.Column, collectable.bridge, database.TableCollectionFragments, database.TableUserFragments, util.FormatPSQLString(reference),
+	)
+}
+
+// A collection repository, which fetches and modifies per-user collections of the enabled material types in the
+// provided database pool.
+type Repository struct {
+	connection    database.PgxPool
+	users         *userHelper.Repository
+	materialTypes []string
+	logger        *log.Logger
+}
+
+// Create a collection repository with a database pool, user repository, enabled material types (e.g., "book"), and
+// logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, users *userHelper.Repository, materialTypes []string, logger *log.Logger) *Repository {
+	return &Repository{
+		connection:    connection,
+		users:         users,
+		materialTypes: materialTypes,
+		logger:        logger,
+	}
+}
+
+// Determine whether a material type is known and enabled.
+//
+// Return: true if supported, false if not.
+func (repository *Repository) Supports(materialType string) bool {
+	_, exists := lookup(materialType)
+
+	return exists && slices.Contains(repository.materialTypes, materialType)
+}
+
+// Resolve the collection owned by the user with the provided reference, storing the user and a default collection on
+// first sight, or fetching the collection stored by a concurrent first request.
+//
+// Return: collection fragment and nil with success, empty collection fragment and error without.
+func (repository *Repository) ResolveCollection(reference string) (model.CollectionFragment, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return model.CollectionFragment{}, err
+	}
+
+	existingCollection, err := service.FetchFragment[model.CollectionFragment](repository.connection, database.TableCollectionFragments, fmt.Sprintf("owner=%d", user.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch collection owned by user '%d': %v", user.ID, err)
+
+		return model.CollectionFragment{}, err
+	}
+
+	if existingCollection.ID != 0 {
+		return existingCollection, nil
+	}
+
+	collection := model.CollectionFragment{
+		Owner:       user.ID,
+		Name:        defaultCollectionName,
+		DateCreated: time.Now().Unix(),
+	}
+
+	collection.ID, err = service.StoreFragment(repository.connection, database.TableCollectionFragments, database.PropertiesCollectionFragments, pgx.NamedArgs{
+		"owner":        collection.Owner,
+		"name":         collection.Name,
+		"date_created": collection.DateCreated,
+	})
+
+	if database.IsUniqueViolation(err) {
+		concurrentCollection, fetchErr := service.FetchFragment[model.CollectionFragment](repository.connection, database.TableCollectionFragments, fmt.Sprintf("owner=%d", user.ID))
+
+		if fetchErr == nil && concurrentCollection.ID != 0 {
+			return concurrentCollection, nil
+		}
+	}
+
+	if err != nil {
+		repository.logger.Printf("Unable to store collection owned by user '%d': %v", user.ID, err)
+
+		return model.CollectionFragment{}, err
+	}
+
+	return collection, nil
+}
@@ -0,0 +1,85 @@
+package helper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+)
+
+// Add a material to the collection owned by the user with the provided reference, unless it is already collected.
+//
+// Return: whether the material exists, whether it was newly added, and nil with success; false, false, and error
+// without.
+func (repository *Repository) AddCollectionItem(reference string, materialType string, id int) (bool, bool, error) {
+	collectable, _ := lookup(materialType)
+
+	exists, err := repository.fetchMaterialExistence(collectable, id)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence of %s '%d': %v", materialType, id, err)
+
+		return false, false, err
+	}
+
+	if !exists {
+		return false, false, nil
+	}
+
+	collection, err := repository.ResolveCollection(reference)
+
+	if err != nil {
+		return false, false, err
+	}
+
+	collected, err := repository.fetchItemExistence(collection.ID, collectable, id)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence of %s '%d' in collection '%d': %v", materialType, id, collection.ID, err)
+
+		return false, false, err
+	}
+
+	if collected {
+		return true, false, nil
+	}
+
+	err = service.StoreRelationship(repository.connection, collectable.bridge, collectable.properties, pgx.NamedArgs{
+		"collection":       collection.ID,
+		collectable.Column: id,
+		"date_added":       time.Now().Unix(),
+		"platform":         nil,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store %s '%d' in collection '%d': %v", materialType, id, collection.ID, err)
+
+		return false, false, err
+	}
+
+	return true, true, nil
+}
+
+// Remove a material from the collection owned by the user with the provided reference.
+//
+// Return: whether the material was collected and nil with success, false and error without.
+func (repository *Repository) RemoveCollectionItem(reference string, materialType string, id int) (bool, error) {
+	collectable, _ := lookup(materialType)
+
+	collection, err := repository.ResolveCollection(reference)
+
+	if err != nil {
+		return false, err
+	}
+
+	count, err := service.DeleteRelationship(repository.connection, collectable.bridge, fmt.Sprintf("collection=%d AND %s=%d", collection.ID, collectable.Column, id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to remove %s '%d' from collection '%d': %v", materialType, id, collection.ID, err)
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+)
+
+// Handle an owned issue request, by comic numeric identifier in query parameter 'id', responding with the issues of
+// the comic in the collection in volume and issue order.
+func (handler *Handler) HandleGetCollectionIssueSlice(context *gin.Context) {
+	principal, id, ok := handler.bindIssueRequest(context)
+
+	if !ok {
+		return
+	}
+
+	issueSlice, err := handler.repository.FetchCollectionIssueSlice(principal, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(issueSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   issueSlice,
+	})
+}
+
+// Handle an issue being marked as owned, by issue numeric identifier in query parameter 'id'.
+func (handler *Handler) HandlePostCollectionIssue(context *gin.Context) {
+	principal, id, ok := handler.bindIssueRequest(context)
+
+	if !ok {
+		return
+	}
+
+	exists, created, err := handler.repository.AddCollectionIssue(principal, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to add issue to collection.",
+		})
+
+		return
+	}
+
+	if !exists {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find issue with numeric identifier '%d'.", id),
+		})
+
+		return
+	}
+
+	status := http.StatusOK
+
+	if created {
+		status = http.StatusCreated
+	}
+
+	context.IndentedJSON(status, gin.H{
+		"status": status,
+		"data": map[string]any{
+			"type":  material.TypeComic,
+			"issue": id,
+		},
+	})
+}
+
+func (handler *Handler) HandleDeleteCollectionIssue(context *gin.Context) {
+	principal, id, ok := handler.bindIssueRequest(context)
+
+	if !ok {
+		return
+	}
+
+	removed, err := handler.repository.RemoveCollectionIssue(principal, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to remove issue from collection.",
+		})
+
+		return
+	}
+
+	if !removed {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find issue with numeric identifier '%d' in collection.", id),
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
+
+// Bind a collection item request (see bindItemRequest) of a material type with issues (i.e., comics), responding with
+// an error for any other type.
+func (handler *Handler) bindIssueRequest(context *gin.Context) (string, int, bool) {
+	principal, materialType, id, ok := handler.bindItemRequest(context)
+
+	if !ok {
+		return "", 0, false
+	}
+
+	if materialType != material.TypeComic {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material type argument '%s' provided in route parameter 'type'; only '%s' has issues.", materialType, material.TypeComic),
+		})
+
+		return "", 0, false
+	}
+
+	return principal, id, true
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/collection/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+)
+
+// Handle the platform a game is owned on being set, by game numeric identifier in query parameter 'id' and platform
+// numeric identifier in query parameter 'platform', where platform 0 clears it.
+func (handler *Handler) HandlePutCollectionPlatform(context *gin.Context) {
+	principal, materialType, id, ok := handler.bindItemRequest(context)
+
+	if !ok {
+		return
+	}
+
+	if materialType != material.TypeGame {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material type argument '%s' provided in route parameter 'type'; only '%s' has platforms.", materialType, material.TypeGame),
+		})
+
+		return
+	}
+
+	platform, err := strconv.Atoi(context.Query("platform"))
+
+	if err != nil || platform < 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid platform identifier argument '%s' provided in query parameter 'platform'.", context.Query("platform")),
+		})
+
+		return
+	}
+
+	collected, err := handler.repository.SetCollectionGamePlatform(principal, id, platform)
+
+	if errors.Is(err, helper.ErrInvalidPlatform) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid platform identifier argument '%d'; game '%d' was not released on the platform.", platform, id),
+		})
+
+		return
+	}
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to set platform of game in collection.",
+		})
+
+		return
+	}
+
+	if !collected {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find game with numeric identifier '%d' in collection.", id),
+		})
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data": map[string]any{
+			"type":     material.TypeGame,
+			"id":       id,
+			"platform": platform,
+		},
+	})
+}
@@ -0,0 +1,283 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/comic/helper"
+	tagHelper "github.com/muzzarellimj/grace-material-api/internal/api/tag/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/comic"
+)
+
+const errorMessage string = "Unable to fetch comic metadata and map to supported data structure."
+
+// A comic request handler, which holds the dependencies shared between comic routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a comic request handler with a comic repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetComic(context *gin.Context) {
+	idArg := context.Query("id")
+
+	if len(idArg) == 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	idSlice := strings.Split(idArg, ",")
+
+	if len(idSlice) == 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	var constraintSlice []string
+
+	for _, id := range idSlice {
+		constraintSlice = append(constraintSlice, fmt.Sprintf("id=%s", id))
+	}
+
+	comicSlice, errorSlice := handler.repository.FetchComicSlice(constraintSlice)
+
+	if len(errorSlice) != 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(comicSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	if slices.Contains(strings.Split(context.Query("include"), ","), "rating") {
+		for index := range comicSlice {
+			rating, err := handler.repository.FetchComicRating(comicSlice[index].ID)
+
+			if err != nil {
+				context.IndentedJSON(http.StatusInternalServerError, gin.H{
+					"status":  http.StatusInternalServerError,
+					"message": errorMessage,
+				})
+
+				return
+			}
+
+			comicSlice[index].Rating = &rating
+		}
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   comicSlice,
+	})
+}
+
+func (handler *Handler) HandlePutComic(context *gin.Context) {
+	var comic model.ComicFragment
+
+	err := context.BindJSON(&comic)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to comic model.",
+		})
+
+		return
+	}
+
+	id, err := handler.repository.UpdateComicFragment(comic)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to update comic fragment.",
+		})
+
+		return
+	}
+
+	if id == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data": map[string]any{
+			"id": id,
+		},
+	})
+}
+
+// Handle a comic storage request, by Comic Vine volume numeric identifier in query parameter 'id' and, to store the
+// volume as another volume of a stored comic, the comic numeric identifier in optional query parameter 'comic'.
+func (handler *Handler) HandlePostComic(context *gin.Context) {
+	idArg := context.Query("id")
+
+	if len(idArg) == 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	var comic int
+
+	if comicArg := context.Query("comic"); comicArg != "" {
+		var err error
+
+		comic, err = strconv.Atoi(comicArg)
+
+		if err != nil || comic <= 0 {
+			context.IndentedJSON(http.StatusBadRequest, gin.H{
+				"status":  http.StatusBadRequest,
+				"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'comic'.", comicArg),
+			})
+
+			return
+		}
+	}
+
+	storedComicId, created, err := handler.repository.StoreComic(idArg, comic)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if storedComicId == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	if !created {
+		context.IndentedJSON(http.StatusOK, gin.H{
+			"status": http.StatusOK,
+			"data": map[string]any{
+				"id": storedComicId,
+			},
+		})
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusCreated, gin.H{
+		"status": http.StatusCreated,
+		"data": map[string]any{
+			"id": storedComicId,
+		},
+	})
+}
+
+func (handler *Handler) HandleGetComicExistenceSlice(context *gin.Context) {
+	var constraint string
+
+	if tag := context.Query("tag"); tag != "" {
+		principal, ok := middleware.Principal(context)
+
+		if !ok {
+			context.IndentedJSON(http.StatusUnauthorized, gin.H{
+				"status":  http.StatusUnauthorized,
+				"message": "Unable to filter by tag without an authenticated principal.",
+			})
+
+			return
+		}
+
+		constraint = tagHelper.Constraint("id", material.TypeComic, principal.Subject, tag)
+	}
+
+	comicExistenceSlice, errSlice := handler.repository.FetchComicExistenceSlice(constraint)
+
+	if len(errSlice) != 0 {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(comicExistenceSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   comicExistenceSlice,
+	})
+}
+
+func (handler *Handler) HandleGetComicSearch(context *gin.Context) {
+	query := context.Query("query")
+
+	if query == "" {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid search term '%s' provided in query parameter 'query'.", context.Query("query")),
+		})
+
+		return
+	}
+
+	mappedResults, err := handler.repository.SearchComics(query)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to fetch comic metadata and map to supported data structure.",
+		})
+
+		return
+	}
+
+	if len(mappedResults) > 0 {
+		context.IndentedJSON(http.StatusOK, gin.H{
+			"status": http.StatusOK,
+			"data":   mappedResults,
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
@@ -0,0 +1,258 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/comic"
+	reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+)
+
+func (repository *Repository) FetchComic(constraint string) (model.Comic, error) {
+	zero := model.Comic{}
+
+	comicFragment, err := service.FetchFragment[model.ComicFragment](repository.connection, database.TableComicFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch comic with constraint '%s': %v", constraint, err)
+
+		return zero, err
+	}
+
+	if comicFragment.ID == 0 {
+		return zero, nil
+	}
+
+	creatorSlice, err := repository.fetchCreatorSlice(comicFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch creators related to comic '%d': %v", comicFragment.ID, err)
+	}
+
+	publisherFragmentSlice, err := repository.fetchPublisherFragmentSlice(comicFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch publishers related to comic '%d': %v", comicFragment.ID, err)
+	}
+
+	volumeSlice, err := repository.fetchVolumeSlice(comicFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch volumes of comic '%d': %v", comicFragment.ID, err)
+	}
+
+	comic := mapComic(comicFragment, creatorSlice, publisherFragmentSlice, volumeSlice)
+
+	return comic, nil
+}
+
+func (repository *Repository) FetchComicSlice(constraintSlice []string) ([]model.Comic, []error) {
+	var comicSlice []model.Comic
+	var errorSlice []error
+
+	for _, constraint := range constraintSlice {
+		comic, err := repository.FetchComic(constraint)
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch and map comic with constraint '%s': %v", constraint, err)
+
+			errorSlice = append(errorSlice, err)
+		}
+
+		if comic.ID != 0 {
+			comicSlice = append(comicSlice, comic)
+		}
+	}
+
+	return comicSlice, errorSlice
+}
+
+func (repository *Repository) FetchComicExistenceSlice(constraint string) ([]int, []error) {
+	idSlice, err := service.FetchExistenceSlice(repository.connection, database.TableComicFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence slice: %v", err)
+
+		return []int{}, []error{err}
+	}
+
+	if len(idSlice) == 0 {
+		repository.logger.Print("Existence slice appears to be empty.")
+
+		return []int{}, nil
+	}
+
+	return idSlice, nil
+}
+
+// Fetch the creators of a comic with their roles, joined through the creator relationship table, in name order.
+func (repository *Repository) fetchCreatorSlice(comicFragment model.ComicFragment) ([]model.ComicCreator, error) {
+	statement, err := database.CreateQuery(
+		"f.id, f.name, r.role, f.reference",
+		fmt.Sprintf("%s f", database.TableComicCreatorFragments),
+		fmt.Sprintf("r.comic=%d", comicFragment.ID),
+		"",
+		fmt.Sprintf("JOIN %s r ON r.creator = f.id", database.TableComicCreatorRelationships),
+	)
+
+	if err != nil {
+		return []model.ComicCreator{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return []model.ComicCreator{}, err
+	}
+
+	creatorSlice, err := database.MapQueryResponse[model.ComicCreator](rows)
+
+	if err != nil {
+		return []model.ComicCreator{}, err
+	}
+
+	slices.SortStableFunc(creatorSlice, func(a model.ComicCreator, b model.ComicCreator) int {
+		if a.Name != b.Name {
+			return cmp.Compare(a.Name, b.Name)
+		}
+
+		return cmp.Compare(a.Role, b.Role)
+	})
+
+	return creatorSlice, nil
+}
+
+func (repository *Repository) fetchPublisherFragmentSlice(comicFragment model.ComicFragment) ([]model.ComicPublisherFragment, error) {
+	zero := []model.ComicPublisherFragment{}
+
+	comicPublisherRelationshipSlice, err := service.FetchRelationshipSlice[model.ComicPublisherRelationship](repository.connection, database.TableComicPublisherRelationships, fmt.Sprintf("comic=%d", comicFragment.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between comic '%d' and publishers: %v", comicFragment.ID, err)
+
+		return zero, err
+	}
+
+	var publisherFragmentSlice []model.ComicPublisherFragment
+
+	for _, relationship := range comicPublisherRelationshipSlice {
+		publisherFragment, err := service.FetchFragment[model.ComicPublisherFragment](repository.connection, database.TableComicPublisherFragments, fmt.Sprintf("id=%d", relationship.Publisher))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch publisher '%d': %v", relationship.Publisher, err)
+		}
+
+		if publisherFragment.ID != 0 {
+			publisherFragmentSlice = append(publisherFragmentSlice, publisherFragment)
+		}
+	}
+
+	return publisherFragmentSlice, nil
+}
+
+// Fetch the volumes of a comic in start date order, each with its issues in issue number order.
+func (repository *Repository) fetchVolumeSlice(comicFragment model.ComicFragment) ([]model.ComicVolume, error) {
+	volumeFragmentSlice, err := service.FetchFragmentSlice[model.ComicVolumeFragment](repository.connection, database.TableComicVolumeFragments, fmt.Sprintf("comic=%d", comicFragment.ID))
+
+	if err != nil {
+		return []model.ComicVolume{}, err
+	}
+
+	issueFragmentSlice, err := service.FetchFragmentSlice[model.ComicIssueFragment](repository.connection, database.TableComicIssueFragments, fmt.Sprintf("comic=%d", comicFragment.ID))
+
+	if err != nil {
+		return []model.ComicVolume{}, err
+	}
+
+	slices.SortStableFunc(volumeFragmentSlice, func(a model.ComicVolumeFragment, b model.ComicVolumeFragment) int {
+		if a.StartDate != b.StartDate {
+			return cmp.Compare(a.StartDate, b.StartDate)
+		}
+
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	slices.SortStableFunc(issueFragmentSlice, func(a model.ComicIssueFragment, b model.ComicIssueFragment) int {
+		return CompareIssueNumber(a.Number, b.Number)
+	})
+
+	var volumeSlice []model.ComicVolume
+
+	for _, volumeFragment := range volumeFragmentSlice {
+		volume := model.ComicVolume{
+			ID:          volumeFragment.ID,
+			Title:       volumeFragment.Title,
+			Description: volumeFragment.Description,
+			StartDate:   volumeFragment.StartDate,
+			Image:       volumeFragment.Image,
+			Reference:   volumeFragment.Reference,
+			Issues:      []model.ComicIssueFragment{},
+		}
+
+		for _, issueFragment := range issueFragmentSlice {
+			if issueFragment.Volume == volumeFragment.ID {
+				volume.Issues = append(volume.Issues, issueFragment)
+			}
+		}
+
+		volumeSlice = append(volumeSlice, volume)
+	}
+
+	return volumeSlice, nil
+}
+
+func mapComic(comicFragment model.ComicFragment, creatorSlice []model.ComicCreator, publisherFragmentSlice []model.ComicPublisherFragment, volumeSlice []model.ComicVolume) model.Comic {
+	if creatorSlice == nil {
+		creatorSlice = make([]model.ComicCreator, 0)
+	}
+
+	if publisherFragmentSlice == nil {
+		publisherFragmentSlice = make([]model.ComicPublisherFragment, 0)
+	}
+
+	if volumeSlice == nil {
+		volumeSlice = make([]model.ComicVolume, 0)
+	}
+
+	return model.Comic{
+		ID:          comicFragment.ID,
+		Title:       comicFragment.Title,
+		Description: comicFragment.Description,
+		Creators:    creatorSlice,
+		Publishers:  publisherFragmentSlice,
+		Volumes:     volumeSlice,
+		StartDate:   comicFragment.StartDate,
+		Issues:      comicFragment.Issues,
+		Image:       comicFragment.Image,
+	}
+}
+
+// Fetch the rating aggregate (i.e., review count and average rating) of a comic.
+//
+// Return: rating aggregate and nil with success, empty rating aggregate and error without.
+func (repository *Repository) FetchComicRating(id int) (reviewModel.RatingAggregate, error) {
+	statement, err := database.CreateQuery("COUNT(rating) AS count, COALESCE(AVG(rating), 0)::FLOAT8 AS average", database.TableComicReviewFragments, fmt.Sprintf("comic=%d", id), "")
+
+	if err != nil {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch rating aggregate of comic '%d': %v", id, err)
+
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	response, err := database.MapQueryResponse[reviewModel.RatingAggregate](rows)
+
+	if err != nil || len(response) == 0 {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	return response[0], nil
+}
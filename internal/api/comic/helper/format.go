@@ -0,0 +1,116 @@
+package helper
+
+import (
+	"cmp"
+	"html"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	model "github.com/muzzarellimj/grace-material-api/internal/model/comic"
+	CVModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/comicvine.gamespot.com"
+)
+
+var (
+	markupPattern     = regexp.MustCompile(`<[^>]*>`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+	numberPattern     = regexp.MustCompile(`^-?\d+(\.\d+)?`)
+)
+
+func MapSearchResultSlice(input []CVModel.CVSearchResult) []model.ComicSearchResult {
+	var resultSlice []model.ComicSearchResult
+
+	for _, result := range input {
+		mappedResult := model.ComicSearchResult{
+			ID:        result.ID,
+			Title:     result.Name,
+			Publisher: result.Publisher.Name,
+			StartDate: ParseStartYear(result.StartYear),
+			Issues:    result.CountOfIssues,
+			Image:     result.Image.OriginalURL,
+		}
+
+		resultSlice = append(resultSlice, mappedResult)
+	}
+
+	return resultSlice
+}
+
+// Format the description of a volume, which prefers the short plain text summary (i.e., the deck) and falls back to the
+// full HTML description with its markup removed.
+//
+// Return: formatted description, or an empty string without a summary or description.
+func FormatDescription(deck string, description string) string {
+	if deck = strings.TrimSpace(deck); deck != "" {
+		return deck
+	}
+
+	description = markupPattern.ReplaceAllString(description, " ")
+	description = html.UnescapeString(description)
+
+	return strings.TrimSpace(whitespacePattern.ReplaceAllString(description, " "))
+}
+
+// Parse the start year of a volume, which Comic Vine provides as a string that may be empty or malformed (e.g.,
+// '2012' or '20l2').
+//
+// Return: Unix timestamp of the start of the year, or 0 without a valid year.
+func ParseStartYear(year string) int64 {
+	parsed, err := strconv.Atoi(strings.TrimSpace(year))
+
+	if err != nil || parsed <= 0 {
+		return 0
+	}
+
+	return time.Date(parsed, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+}
+
+// Split the comma-separated roles of a credited person (e.g., 'writer, artist') into distinct lowercase roles.
+//
+// Return: role slice, which is empty without roles.
+func SplitRoleSlice(role string) []string {
+	var roleSlice []string
+
+	for _, value := range strings.Split(role, ",") {
+		value = strings.ToLower(strings.TrimSpace(value))
+
+		if value == "" || slices.Contains(roleSlice, value) {
+			continue
+		}
+
+		roleSlice = append(roleSlice, value)
+	}
+
+	return roleSlice
+}
+
+// Compare issue numbers as published, where numeric prefixes are compared as numbers (e.g., '2' before '10', and '1'
+// before '1.MU'), a half issue ('½') sorts as 0.5, and an issue without a number (e.g., 'Annual') sorts last.
+//
+// Return: negative if a sorts before b, positive if after, 0 if equal.
+func CompareIssueNumber(a string, b string) int {
+	if order := cmp.Compare(parseIssueNumber(a), parseIssueNumber(b)); order != 0 {
+		return order
+	}
+
+	return cmp.Compare(a, b)
+}
+
+func parseIssueNumber(number string) float64 {
+	number = strings.ReplaceAll(strings.TrimSpace(number), "½", ".5")
+
+	if strings.HasPrefix(number, ".") {
+		number = "0" + number
+	}
+
+	parsed, err := strconv.ParseFloat(numberPattern.FindString(number), 64)
+
+	if err != nil {
+		return math.Inf(1)
+	}
+
+	return parsed
+}
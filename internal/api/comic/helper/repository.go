@@ -0,0 +1,118 @@
+package helper
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/comic"
+	CVModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/comicvine.gamespot.com"
+)
+
+// The Comic Vine operations required by the comic repository, which are satisfied by a Comic Vine client or a fake.
+type Provider interface {
+	CVGetVolume(id string) (CVModel.CVVolume, error)
+	CVGetIssue(id string) (CVModel.CVIssue, error)
+	CVGetVolumeIssues(id string) ([]CVModel.CVIssue, error)
+	CVSearchVolume(query string) ([]CVModel.CVSearchResult, error)
+}
+
+// A comic repository, which fetches, stores, and updates comics, with their volumes and issues, in the provided
+// database pool with metadata from the provided Comic Vine provider.
+type Repository struct {
+	connection database.PgxPool
+	client     Provider
+	logger     *log.Logger
+}
+
+// Create a comic repository with a database pool, Comic Vine provider, and logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, client Provider, logger *log.Logger) *Repository {
+	return &Repository{
+		connection: connection,
+		client:     client,
+		logger:     logger,
+	}
+}
+
+// Search Comic Vine volumes by name, and map results to the supported search result model.
+//
+// Return: mapped search result slice and nil with success, empty slice and error without.
+func (repository *Repository) SearchComics(query string) ([]model.ComicSearchResult, error) {
+	results, err := repository.client.CVSearchVolume(query)
+
+	if err != nil {
+		repository.logger.Printf("Unable to search Comic Vine volumes with query '%s': %v", query, err)
+
+		return []model.ComicSearchResult{}, err
+	}
+
+	return MapSearchResultSlice(results), nil
+}
+
+// Store a Comic Vine volume with a provided numeric identifier, with its issues, as a new comic or, with the numeric
+// identifier of a stored comic, as another volume of that comic (e.g., a relaunch of a series), unless a volume with
+// that reference already exists.
+//
+// Return: comic numeric identifier, whether the volume was newly stored, and nil with success; 0, false, and error
+// without. A 0 identifier without error indicates no Comic Vine volume or stored comic matched the identifiers.
+func (repository *Repository) StoreComic(id string, comic int) (int, bool, error) {
+	reference, err := strconv.Atoi(id)
+
+	if err != nil {
+		repository.logger.Printf("Unable to parse Comic Vine volume identifier '%s': %v", id, err)
+
+		return 0, false, err
+	}
+
+	existingVolume, err := service.FetchFragment[model.ComicVolumeFragment](repository.connection, database.TableComicVolumeFragments, fmt.Sprintf("reference=%d", reference))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing volume '%d': %v", reference, err)
+
+		return 0, false, err
+	}
+
+	if existingVolume.ID != 0 {
+		return existingVolume.Comic, false, nil
+	}
+
+	var comicFragment model.ComicFragment
+
+	if comic != 0 {
+		comicFragment, err = service.FetchFragment[model.ComicFragment](repository.connection, database.TableComicFragments, fmt.Sprintf("id=%d", comic))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch comic '%d': %v", comic, err)
+
+			return 0, false, err
+		}
+
+		if comicFragment.ID == 0 {
+			return 0, false, nil
+		}
+	}
+
+	volume, err := repository.client.CVGetVolume(strconv.Itoa(reference))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch volume '%d' Comic Vine record: %v", reference, err)
+
+		return 0, false, err
+	}
+
+	if volume.ID == 0 {
+		return 0, false, nil
+	}
+
+	comicId, err := repository.ProcessComicStorage(volume, comicFragment)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	return comicId, true, nil
+}
@@ -0,0 +1,243 @@
+package helper
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/comic"
+	CVModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/comicvine.gamespot.com"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+// Store a volume with its issues, creators, and publisher as a new comic or, with a stored comic fragment, as another
+// volume of that comic, where the volume and issue counts of a stored comic are updated to include the volume.
+//
+// Return: comic numeric identifier and nil with success, 0 and error without.
+func (repository *Repository) ProcessComicStorage(volume CVModel.CVVolume, comicFragment model.ComicFragment) (int, error) {
+	issueSlice, err := repository.client.CVGetVolumeIssues(strconv.Itoa(volume.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch issues of volume '%d' Comic Vine record: %v", volume.ID, err)
+	}
+
+	issueCount := len(issueSlice)
+
+	if err != nil {
+		issueCount = volume.CountOfIssues
+	}
+
+	comicId, err := repository.storeComicFragment(volume, comicFragment, issueCount)
+
+	if err != nil {
+		return 0, err
+	}
+
+	volumeId, err := service.StoreFragment(repository.connection, database.TableComicVolumeFragments, database.PropertiesComicVolumeFragments, pgx.NamedArgs{
+		"comic":       comicId,
+		"title":       volume.Name,
+		"description": FormatDescription(volume.Deck, volume.Description),
+		"start_date":  ParseStartYear(volume.StartYear),
+		"issues":      issueCount,
+		"image":       volume.Image.OriginalURL,
+		"reference":   volume.ID,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store volume '%d' fragment: %v", volume.ID, err)
+
+		return 0, err
+	}
+
+	for _, issue := range issueSlice {
+		_, err := service.StoreFragment(repository.connection, database.TableComicIssueFragments, database.PropertiesComicIssueFragments, pgx.NamedArgs{
+			"comic":      comicId,
+			"volume":     volumeId,
+			"number":     issue.IssueNumber,
+			"title":      issue.Name,
+			"cover_date": util.ParseDateTime(issue.CoverDate),
+			"image":      issue.Image.OriginalURL,
+			"reference":  issue.ID,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store issue '%d' of volume '%d' fragment: %v", issue.ID, volume.ID, err)
+		}
+	}
+
+	repository.processCreatorStorage(comicId, volume)
+	repository.processPublisherStorage(comicId, volume.Publisher)
+
+	return comicId, nil
+}
+
+// Store a new comic fragment from a volume or, with a stored comic fragment, update its volume and issue counts and
+// start date to include the volume.
+func (repository *Repository) storeComicFragment(volume CVModel.CVVolume, comicFragment model.ComicFragment, issueCount int) (int, error) {
+	startDate := ParseStartYear(volume.StartYear)
+
+	if comicFragment.ID == 0 {
+		comicId, err := service.StoreFragment(repository.connection, database.TableComicFragments, database.PropertiesComicFragments, pgx.NamedArgs{
+			"title":       volume.Name,
+			"description": FormatDescription(volume.Deck, volume.Description),
+			"start_date":  startDate,
+			"volumes":     1,
+			"issues":      issueCount,
+			"image":       volume.Image.OriginalURL,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store comic of volume '%d' fragment: %v", volume.ID, err)
+
+			return 0, err
+		}
+
+		return comicId, nil
+	}
+
+	comicFragment.Volumes++
+	comicFragment.Issues += issueCount
+
+	if comicFragment.StartDate == 0 || (startDate != 0 && startDate < comicFragment.StartDate) {
+		comicFragment.StartDate = startDate
+	}
+
+	_, err := repository.UpdateComicFragment(comicFragment)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return comicFragment.ID, nil
+}
+
+// Store the creators of a comic with their roles, as credited on the first issue of a volume (i.e., the creative team
+// that launched the volume), where a creator and role already related to the comic is not related again.
+func (repository *Repository) processCreatorStorage(comicId int, volume CVModel.CVVolume) {
+	if volume.FirstIssue.ID == 0 {
+		return
+	}
+
+	issue, err := repository.client.CVGetIssue(strconv.Itoa(volume.FirstIssue.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch issue '%d' Comic Vine record: %v", volume.FirstIssue.ID, err)
+
+		return
+	}
+
+	existingRelationshipSlice, err := service.FetchRelationshipSlice[model.ComicCreatorRelationship](repository.connection, database.TableComicCreatorRelationships, fmt.Sprintf("comic=%d", comicId))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between comic '%d' and creators: %v", comicId, err)
+
+		return
+	}
+
+	for _, credit := range issue.PersonCredits {
+		creatorId := repository.processCreatorFragment(credit)
+
+		if creatorId == 0 {
+			continue
+		}
+
+		for _, role := range SplitRoleSlice(credit.Role) {
+			related := slices.ContainsFunc(existingRelationshipSlice, func(relationship model.ComicCreatorRelationship) bool {
+				return relationship.Creator == creatorId && relationship.Role == role
+			})
+
+			if related {
+				continue
+			}
+
+			err := service.StoreRelationship(repository.connection, database.TableComicCreatorRelationships, database.PropertiesComicCreatorRelationships, pgx.NamedArgs{
+				"comic":   comicId,
+				"creator": creatorId,
+				"role":    role,
+			})
+
+			if err != nil {
+				repository.logger.Printf("Unable to store relationship between comic '%d' and creator '%d': %v", comicId, creatorId, err)
+			}
+		}
+	}
+}
+
+func (repository *Repository) processCreatorFragment(credit CVModel.CVPersonCredits) int {
+	existingCreatorFragment, err := service.FetchFragment[model.ComicCreatorFragment](repository.connection, database.TableComicCreatorFragments, fmt.Sprintf("reference=%d", credit.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing creator '%d' fragment: %v", credit.ID, err)
+
+		return 0
+	}
+
+	if existingCreatorFragment.ID != 0 {
+		return existingCreatorFragment.ID
+	}
+
+	creatorId, err := service.StoreFragment(repository.connection, database.TableComicCreatorFragments, database.PropertiesComicCreatorFragments, pgx.NamedArgs{
+		"name":      credit.Name,
+		"reference": credit.ID,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store new creator '%d' fragment: %v", credit.ID, err)
+	}
+
+	return creatorId
+}
+
+// Store the publisher of a volume, unless the comic is already related to it (e.g., by an earlier volume).
+func (repository *Repository) processPublisherStorage(comicId int, publisher CVModel.CVResource) {
+	if publisher.ID == 0 {
+		return
+	}
+
+	existingPublisherFragment, err := service.FetchFragment[model.ComicPublisherFragment](repository.connection, database.TableComicPublisherFragments, fmt.Sprintf("reference=%d", publisher.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing publisher '%d' fragment: %v", publisher.ID, err)
+
+		return
+	}
+
+	publisherId := existingPublisherFragment.ID
+
+	if publisherId == 0 {
+		publisherId, err = service.StoreFragment(repository.connection, database.TableComicPublisherFragments, database.PropertiesComicPublisherFragments, pgx.NamedArgs{
+			"name":      publisher.Name,
+			"reference": publisher.ID,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store new publisher '%d' fragment: %v", publisher.ID, err)
+
+			return
+		}
+	} else {
+		existingRelationshipSlice, err := service.FetchRelationshipSlice[model.ComicPublisherRelationship](repository.connection, database.TableComicPublisherRelationships, fmt.Sprintf("comic=%d AND publisher=%d", comicId, publisherId))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch relationship between comic '%d' and publisher '%d': %v", comicId, publisherId, err)
+
+			return
+		}
+
+		if len(existingRelationshipSlice) != 0 {
+			return
+		}
+	}
+
+	err = service.StoreRelationship(repository.connection, database.TableComicPublisherRelationships, database.PropertiesComicPublisherRelationships, pgx.NamedArgs{
+		"comic":     comicId,
+		"publisher": publisherId,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store relationship between comic '%d' and publisher '%d': %v", comicId, publisherId, err)
+	}
+}
@@ -0,0 +1,29 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/comic"
+)
+
+func (repository *Repository) UpdateComicFragment(comic model.ComicFragment) (int, error) {
+	id, err := service.UpdateFragment(repository.connection, database.TableComicFragments, database.PropertiesComicFragments, fmt.Sprintf("id=%d", comic.ID), pgx.NamedArgs{
+		"title":       comic.Title,
+		"description": comic.Description,
+		"start_date":  comic.StartDate,
+		"volumes":     comic.Volumes,
+		"issues":      comic.Issues,
+		"image":       comic.Image,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to update comic '%d' fragment: %v", comic.ID, err)
+
+		return 0, err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/archive"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+)
+
+// An export request handler, which holds the dependencies shared between export routes.
+type Handler struct {
+	archiver *archive.Archiver
+}
+
+// Create an export request handler with an archiver.
+//
+// Return: configured handler.
+func NewHandler(archiver *archive.Archiver) *Handler {
+	return &Handler{
+		archiver: archiver,
+	}
+}
+
+// Handle an export of the materials and per-user data of the authenticated user as an attachment, in the format
+// provided in query parameter 'format' (default 'json'), where 'csv' also requires query parameter 'type'.
+func (handler *Handler) HandleGetExport(context *gin.Context) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to export without an authenticated principal.",
+		})
+
+		return
+	}
+
+	format := context.DefaultQuery("format", archive.FormatJSON)
+
+	if !archive.SupportsFormat(format) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid format argument '%s' provided in query parameter 'format'.", format),
+		})
+
+		return
+	}
+
+	materialType := context.Query("type")
+
+	if format == archive.FormatCSV && !handler.archiver.Supports(materialType) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material type argument '%s' provided in query parameter 'type'.", materialType),
+		})
+
+		return
+	}
+
+	exportArchive, err := handler.archiver.Export(principal.Subject)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to export collection and map to supported data structure.",
+		})
+
+		return
+	}
+
+	name := fmt.Sprintf("grace-export-%s", time.Unix(exportArchive.DateCreated, 0).UTC().Format("20060102"))
+	contentType := "application/json"
+
+	switch format {
+	case archive.FormatNDJSON:
+		name += ".ndjson"
+		contentType = "application/x-ndjson"
+	case archive.FormatCSV:
+		name += fmt.Sprintf("-%s.csv", materialType)
+		contentType = "text/csv"
+	case archive.FormatArchive:
+		name += ".archive.json"
+	default:
+		name += ".json"
+	}
+
+	context.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", name))
+	context.Header("Content-Type", fmt.Sprintf("%s; charset=utf-8", contentType))
+	context.Status(http.StatusOK)
+
+	switch format {
+	case archive.FormatNDJSON:
+		err = archive.WriteNDJSON(context.Writer, exportArchive)
+	case archive.FormatCSV:
+		err = archive.WriteCSV(context.Writer, exportArchive, materialType)
+	case archive.FormatArchive:
+		err = archive.WriteArchive(context.Writer, exportArchive)
+	default:
+		err = archive.WriteJSON(context.Writer, exportArchive)
+	}
+
+	if err != nil {
+		context.Error(err)
+	}
+}
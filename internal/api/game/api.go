@@ -3,18 +3,35 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/muzzarellimj/grace-material-api/internal/api/game/helper"
-	IGDBAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/igdb.com"
+	tagHelper "github.com/muzzarellimj/grace-material-api/internal/api/tag/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
 	model "github.com/muzzarellimj/grace-material-api/internal/model/game"
-	IGDBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/igdb.com"
 )
 
 const errorMessage string = "Unable to fetch game metadata and map to supported data structure."
 
-func HandleGetGame(context *gin.Context) {
+// A game request handler, which holds the dependencies shared between game routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a game request handler with a game repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetGame(context *gin.Context) {
 	idArg := context.Query("id")
 
 	if len(idArg) == 0 {
@@ -43,7 +60,7 @@ func HandleGetGame(context *gin.Context) {
 		constraintSlice = append(constraintSlice, fmt.Sprintf("id=%s", id))
 	}
 
-	gameSlice, errSlice := helper.FetchGameSlice(constraintSlice)
+	gameSlice, errSlice := handler.repository.FetchGameSlice(constraintSlice)
 
 	if len(errSlice) != 0 {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -60,13 +77,30 @@ func HandleGetGame(context *gin.Context) {
 		return
 	}
 
+	if slices.Contains(strings.Split(context.Query("include"), ","), "rating") {
+		for index := range gameSlice {
+			rating, err := handler.repository.FetchGameRating(gameSlice[index].ID)
+
+			if err != nil {
+				context.IndentedJSON(http.StatusInternalServerError, gin.H{
+					"status":  http.StatusInternalServerError,
+					"message": errorMessage,
+				})
+
+				return
+			}
+
+			gameSlice[index].Rating = &rating
+		}
+	}
+
 	context.IndentedJSON(http.StatusOK, gin.H{
 		"status": http.StatusOK,
 		"data":   gameSlice,
 	})
 }
 
-func HandlePutGame(context *gin.Context) {
+func (handler *Handler) HandlePutGame(context *gin.Context) {
 	var game model.GameFragment
 
 	err := context.BindJSON(&game)
@@ -80,7 +114,7 @@ func HandlePutGame(context *gin.Context) {
 		return
 	}
 
-	id, err := helper.UpdateGameFragment(game)
+	id, err := handler.repository.UpdateGameFragment(game)
 
 	if err != nil {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -105,7 +139,7 @@ func HandlePutGame(context *gin.Context) {
 	})
 }
 
-func HandlePostGame(context *gin.Context) {
+func (handler *Handler) HandlePostGame(context *gin.Context) {
 	idArg := context.Query("id")
 
 	if len(idArg) == 0 {
@@ -117,7 +151,7 @@ func HandlePostGame(context *gin.Context) {
 		return
 	}
 
-	existingGame, err := helper.FetchGame(fmt.Sprintf("reference=%s", idArg))
+	storedGameId, created, err := handler.repository.StoreGame(idArg)
 
 	if err != nil {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -128,20 +162,52 @@ func HandlePostGame(context *gin.Context) {
 		return
 	}
 
-	if existingGame.ID != 0 {
+	if storedGameId == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	if !created {
 		context.IndentedJSON(http.StatusOK, gin.H{
 			"status": http.StatusOK,
 			"data": map[string]any{
-				"id": existingGame.ID,
+				"id": storedGameId,
 			},
 		})
 
 		return
 	}
 
-	game, err := IGDBAPI.IGDBGetResource[IGDBModel.IGDBGameResponse](IGDBAPI.IGDBEndpointGame, fmt.Sprintf("fields id,cover.*,first_release_date,franchises.*,genres.*,involved_companies.*,name,platforms.*,storyline,summary; where id=%s;", idArg))
+	context.IndentedJSON(http.StatusCreated, gin.H{
+		"status": http.StatusCreated,
+		"data": map[string]any{
+			"id": storedGameId,
+		},
+	})
+}
+
+func (handler *Handler) HandleGetGameExistenceSlice(context *gin.Context) {
+	var constraint string
+
+	if tag := context.Query("tag"); tag != "" {
+		principal, ok := middleware.Principal(context)
+
+		if !ok {
+			context.IndentedJSON(http.StatusUnauthorized, gin.H{
+				"status":  http.StatusUnauthorized,
+				"message": "Unable to filter by tag without an authenticated principal.",
+			})
+
+			return
+		}
+
+		constraint = tagHelper.Constraint("id", material.TypeGame, principal.Subject, tag)
+	}
+
+	gameExistenceSlice, errSlice := handler.repository.FetchGameExistenceSlice(constraint)
 
-	if err != nil {
+	if len(errSlice) != 0 {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
 			"status":  http.StatusInternalServerError,
 			"message": errorMessage,
@@ -150,35 +216,33 @@ func HandlePostGame(context *gin.Context) {
 		return
 	}
 
-	if game.ID == 0 {
+	if len(gameExistenceSlice) == 0 {
 		context.Status(http.StatusNoContent)
 
 		return
 	}
 
-	storedGameId, err := helper.ProcessGameStorage(game)
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   gameExistenceSlice,
+	})
+}
 
-	if err != nil {
-		context.IndentedJSON(http.StatusInternalServerError, gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": errorMessage,
+func (handler *Handler) HandleGetGameSearch(context *gin.Context) {
+	query := context.Query("query")
+
+	if query == "" {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid search term '%s' provided in query parameter 'query'.", context.Query("query")),
 		})
 
 		return
 	}
 
-	context.IndentedJSON(http.StatusCreated, gin.H{
-		"status": http.StatusCreated,
-		"data": map[string]any{
-			"id": storedGameId,
-		},
-	})
-}
-
-func HandleGetGameExistenceSlice(context *gin.Context) {
-	gameExistenceSlice, errSlice := helper.FetchGameExistenceSlice()
+	mappedResults, err := handler.repository.SearchGames(query)
 
-	if len(errSlice) != 0 {
+	if err != nil {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
 			"status":  http.StatusInternalServerError,
 			"message": errorMessage,
@@ -187,31 +251,31 @@ func HandleGetGameExistenceSlice(context *gin.Context) {
 		return
 	}
 
-	if len(gameExistenceSlice) == 0 {
-		context.Status(http.StatusNoContent)
+	if len(mappedResults) > 0 {
+		context.IndentedJSON(http.StatusOK, gin.H{
+			"status": http.StatusOK,
+			"data":   mappedResults,
+		})
 
 		return
 	}
 
-	context.IndentedJSON(http.StatusOK, gin.H{
-		"status": http.StatusOK,
-		"data":   gameExistenceSlice,
-	})
+	context.Status(http.StatusNoContent)
 }
 
-func HandleGetGameSearch(context *gin.Context) {
-	query := context.Query("query")
+func (handler *Handler) HandleGetGameSimilar(context *gin.Context) {
+	id, err := strconv.Atoi(context.Query("id"))
 
-	if query == "" {
+	if err != nil || id <= 0 {
 		context.IndentedJSON(http.StatusBadRequest, gin.H{
 			"status":  http.StatusBadRequest,
-			"message": fmt.Sprintf("Invalid search term '%s' provided in query parameter 'query'.", context.Query("query")),
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
 		})
 
 		return
 	}
 
-	results, err := IGDBAPI.IGDBGetResourceSlice[IGDBModel.IGDBGameSearchResponse](IGDBAPI.IGDBEndpointGame, fmt.Sprintf(`fields id,name,cover.*,first_release_date; search "%s"; where (status=0 | status=null) & category=0;`, query))
+	mappedResults, exists, err := handler.repository.SimilarGames(id)
 
 	if err != nil {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -222,9 +286,16 @@ func HandleGetGameSearch(context *gin.Context) {
 		return
 	}
 
-	if len(results) > 0 {
-		mappedResults := helper.MapSearchResultSlice(results)
+	if !exists {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find game with numeric identifier '%d'.", id),
+		})
+
+		return
+	}
 
+	if len(mappedResults) > 0 {
 		context.IndentedJSON(http.StatusOK, gin.H{
 			"status": http.StatusOK,
 			"data":   mappedResults,
@@ -1,63 +1,72 @@
 package helper
 
 import (
+	"cmp"
 	"fmt"
-	"os"
+	"slices"
+	"strings"
 
 	"github.com/muzzarellimj/grace-material-api/internal/database"
 	"github.com/muzzarellimj/grace-material-api/internal/database/service"
 	model "github.com/muzzarellimj/grace-material-api/internal/model/game"
+	reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
 )
 
-func FetchGame(constraint string) (model.Game, error) {
+func (repository *Repository) FetchGame(constraint string) (model.Game, error) {
 	zero := model.Game{}
 
-	gameFragment, err := service.FetchFragment[model.GameFragment](database.Connection, database.TableGameFragments, constraint)
+	gameFragment, err := service.FetchFragment[model.GameFragment](repository.connection, database.TableGameFragments, constraint)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch game with constraint '%s': %v\n", constraint, err)
+		repository.logger.Printf("Unable to fetch game with constraint '%s': %v", constraint, err)
 
 		return zero, err
 	}
 
-	franchiseFragmentSlice, err := fetchFranchiseFragmentSlice(gameFragment)
+	franchiseFragmentSlice, err := repository.fetchFranchiseFragmentSlice(gameFragment)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch franchises related to game '%d': %v\n", gameFragment.ID, err)
+		repository.logger.Printf("Unable to fetch franchises related to game '%d': %v", gameFragment.ID, err)
 	}
 
-	genreFragmentSlice, err := fetchGenreFragmentSlice(gameFragment)
+	genreFragmentSlice, err := repository.fetchGenreFragmentSlice(gameFragment)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch genres related to game '%d': %v\n", gameFragment.ID, err)
+		repository.logger.Printf("Unable to fetch genres related to game '%d': %v", gameFragment.ID, err)
 	}
 
-	platformFragmentSlice, err := fetchPlatformFragmentSlice(gameFragment)
+	platformFragmentSlice, err := repository.fetchPlatformFragmentSlice(gameFragment)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch platforms related to game '%d': %v\n", gameFragment.ID, err)
+		repository.logger.Printf("Unable to fetch platforms related to game '%d': %v", gameFragment.ID, err)
 	}
 
-	studioFragmentSlice, err := fetchStudioFragmentSlice(gameFragment)
+	studioFragmentSlice, err := repository.fetchStudioFragmentSlice(gameFragment)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch studios related to game '%d': %v\n", gameFragment.ID, err)
+		repository.logger.Printf("Unable to fetch studios related to game '%d': %v", gameFragment.ID, err)
 	}
 
-	game := mapGame(gameFragment, franchiseFragmentSlice, genreFragmentSlice, platformFragmentSlice, studioFragmentSlice)
+	releaseSlice, err := repository.fetchReleaseSlice(gameFragment, platformFragmentSlice)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch releases of game '%d': %v", gameFragment.ID, err)
+	}
+
+	game := mapGame(gameFragment, franchiseFragmentSlice, genreFragmentSlice, platformFragmentSlice, studioFragmentSlice, releaseSlice)
 
 	return game, nil
 }
 
-func FetchGameSlice(constraintSlice []string) ([]model.Game, []error) {
+func (repository *Repository) FetchGameSlice(constraintSlice []string) ([]model.Game, []error) {
 	var gameSlice []model.Game
 	var errSlice []error
 
 	for _, constraint := range constraintSlice {
-		game, err := FetchGame(constraint)
+		game, err := repository.FetchGame(constraint)
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch and map game with constraint '%s': %v\n", constraint, err)
+			repository.logger.Printf("Unable to fetch and map game with constraint '%s': %v", constraint, err)
 
 			errSlice = append(errSlice, err)
 		}
@@ -70,17 +79,17 @@ func FetchGameSlice(constraintSlice []string) ([]model.Game, []error) {
 	return gameSlice, errSlice
 }
 
-func FetchGameExistenceSlice() ([]int, []error) {
-	idSlice, err := service.FetchExistenceSlice(database.Connection, database.TableGameFragments)
+func (repository *Repository) FetchGameExistenceSlice(constraint string) ([]int, []error) {
+	idSlice, err := service.FetchExistenceSlice(repository.connection, database.TableGameFragments, constraint)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch existence slice: %v\n", err)
+		repository.logger.Printf("Unable to fetch existence slice: %v", err)
 
 		return []int{}, []error{err}
 	}
 
 	if len(idSlice) == 0 {
-		fmt.Fprint(os.Stderr, "Existence slice appears to be empty.")
+		repository.logger.Print("Existence slice appears to be empty.")
 
 		return []int{}, nil
 	}
@@ -88,11 +97,11 @@ func FetchGameExistenceSlice() ([]int, []error) {
 	return idSlice, nil
 }
 
-func fetchFranchiseFragmentSlice(gameFragment model.GameFragment) ([]model.GameFranchiseFragment, error) {
-	gameFranchiseRelationshipSlice, err := service.FetchRelationshipSlice[model.GameFranchiseRelationship](database.Connection, database.TableGameFranchiseRelationships, fmt.Sprintf("game=%d", gameFragment.ID))
+func (repository *Repository) fetchFranchiseFragmentSlice(gameFragment model.GameFragment) ([]model.GameFranchiseFragment, error) {
+	gameFranchiseRelationshipSlice, err := service.FetchRelationshipSlice[model.GameFranchiseRelationship](repository.connection, database.TableGameFranchiseRelationships, fmt.Sprintf("game=%d", gameFragment.ID))
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch relationships between game '%d' and franchises: %v\n", gameFragment.ID, err)
+		repository.logger.Printf("Unable to fetch relationships between game '%d' and franchises: %v", gameFragment.ID, err)
 
 		return []model.GameFranchiseFragment{}, err
 	}
@@ -100,10 +109,10 @@ func fetchFranchiseFragmentSlice(gameFragment model.GameFragment) ([]model.GameF
 	var franchiseFragmentSlice []model.GameFranchiseFragment
 
 	for _, relationship := range gameFranchiseRelationshipSlice {
-		franchiseFragment, err := service.FetchFragment[model.GameFranchiseFragment](database.Connection, database.TableGameFranchiseFragments, fmt.Sprintf("id=%d", relationship.Franchise))
+		franchiseFragment, err := service.FetchFragment[model.GameFranchiseFragment](repository.connection, database.TableGameFranchiseFragments, fmt.Sprintf("id=%d", relationship.Franchise))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch franchise '%d': %v\n", relationship.Franchise, err)
+			repository.logger.Printf("Unable to fetch franchise '%d': %v", relationship.Franchise, err)
 		}
 
 		if franchiseFragment.ID != 0 {
@@ -114,11 +123,11 @@ func fetchFranchiseFragmentSlice(gameFragment model.GameFragment) ([]model.GameF
 	return franchiseFragmentSlice, nil
 }
 
-func fetchGenreFragmentSlice(gameFragment model.GameFragment) ([]model.GameGenreFragment, error) {
-	gameGenreRelationshipSlice, err := service.FetchRelationshipSlice[model.GameGenreRelationship](database.Connection, database.TableGameGenreRelationships, fmt.Sprintf("game=%d", gameFragment.ID))
+func (repository *Repository) fetchGenreFragmentSlice(gameFragment model.GameFragment) ([]model.GameGenreFragment, error) {
+	gameGenreRelationshipSlice, err := service.FetchRelationshipSlice[model.GameGenreRelationship](repository.connection, database.TableGameGenreRelationships, fmt.Sprintf("game=%d", gameFragment.ID))
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch relationships between game '%d' and genres: %v\n", gameFragment.ID, err)
+		repository.logger.Printf("Unable to fetch relationships between game '%d' and genres: %v", gameFragment.ID, err)
 
 		return []model.GameGenreFragment{}, err
 	}
@@ -126,10 +135,10 @@ func fetchGenreFragmentSlice(gameFragment model.GameFragment) ([]model.GameGenre
 	var genreFragmentSlice []model.GameGenreFragment
 
 	for _, relationship := range gameGenreRelationshipSlice {
-		genreFragment, err := service.FetchFragment[model.GameGenreFragment](database.Connection, database.TableGameGenreFragments, fmt.Sprintf("id=%d", relationship.Genre))
+		genreFragment, err := service.FetchFragment[model.GameGenreFragment](repository.connection, database.TableGameGenreFragments, fmt.Sprintf("id=%d", relationship.Genre))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch genre '%d': %v\n", relationship.Genre, err)
+			repository.logger.Printf("Unable to fetch genre '%d': %v", relationship.Genre, err)
 		}
 
 		if genreFragment.ID != 0 {
@@ -140,11 +149,11 @@ func fetchGenreFragmentSlice(gameFragment model.GameFragment) ([]model.GameGenre
 	return genreFragmentSlice, nil
 }
 
-func fetchPlatformFragmentSlice(gameFragment model.GameFragment) ([]model.GamePlatformFragment, error) {
-	gamePlatformRelationshipSlice, err := service.FetchRelationshipSlice[model.GamePlatformRelationship](database.Connection, database.TableGamePlatformRelationships, fmt.Sprintf("game=%d", gameFragment.ID))
+func (repository *Repository) fetchPlatformFragmentSlice(gameFragment model.GameFragment) ([]model.GamePlatformFragment, error) {
+	gamePlatformRelationshipSlice, err := service.FetchRelationshipSlice[model.GamePlatformRelationship](repository.connection, database.TableGamePlatformRelationships, fmt.Sprintf("game=%d", gameFragment.ID))
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch relationships between game '%d' and platforms: %v\n", gameFragment.ID, err)
+		repository.logger.Printf("Unable to fetch relationships between game '%d' and platforms: %v", gameFragment.ID, err)
 
 		return []model.GamePlatformFragment{}, err
 	}
@@ -152,10 +161,10 @@ func fetchPlatformFragmentSlice(gameFragment model.GameFragment) ([]model.GamePl
 	var platformFragmentSlice []model.GamePlatformFragment
 
 	for _, relationship := range gamePlatformRelationshipSlice {
-		platformFragment, err := service.FetchFragment[model.GamePlatformFragment](database.Connection, database.TableGamePlatformFragments, fmt.Sprintf("id=%d", relationship.Platform))
+		platformFragment, err := service.FetchFragment[model.GamePlatformFragment](repository.connection, database.TableGamePlatformFragments, fmt.Sprintf("id=%d", relationship.Platform))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch platform '%d': %v\n", relationship.Platform, err)
+			repository.logger.Printf("Unable to fetch platform '%d': %v", relationship.Platform, err)
 		}
 
 		if platformFragment.ID != 0 {
@@ -166,11 +175,11 @@ func fetchPlatformFragmentSlice(gameFragment model.GameFragment) ([]model.GamePl
 	return platformFragmentSlice, nil
 }
 
-func fetchStudioFragmentSlice(gameFragment model.GameFragment) ([]model.GameStudioFragment, error) {
-	gameStudioRelationshipSlice, err := service.FetchRelationshipSlice[model.GameStudioRelationship](database.Connection, database.TableGameStudioRelationships, fmt.Sprintf("game=%d", gameFragment.ID))
+func (repository *Repository) fetchStudioFragmentSlice(gameFragment model.GameFragment) ([]model.GameStudioFragment, error) {
+	gameStudioRelationshipSlice, err := service.FetchRelationshipSlice[model.GameStudioRelationship](repository.connection, database.TableGameStudioRelationships, fmt.Sprintf("game=%d", gameFragment.ID))
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch relationships between game '%d' and studios: %v\n", gameFragment.ID, err)
+		repository.logger.Printf("Unable to fetch relationships between game '%d' and studios: %v", gameFragment.ID, err)
 
 		return []model.GameStudioFragment{}, err
 	}
@@ -178,10 +187,10 @@ func fetchStudioFragmentSlice(gameFragment model.GameFragment) ([]model.GameStud
 	var studioFragmentSlice []model.GameStudioFragment
 
 	for _, relationship := range gameStudioRelationshipSlice {
-		studioFragment, err := service.FetchFragment[model.GameStudioFragment](database.Connection, database.TableGameStudioFragments, fmt.Sprintf("id=%d", relationship.Studio))
+		studioFragment, err := service.FetchFragment[model.GameStudioFragment](repository.connection, database.TableGameStudioFragments, fmt.Sprintf("id=%d", relationship.Studio))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch studio '%d': %v\n", relationship.Studio, err)
+			repository.logger.Printf("Unable to fetch studio '%d': %v", relationship.Studio, err)
 		}
 
 		if studioFragment.ID != 0 {
@@ -192,7 +201,45 @@ func fetchStudioFragmentSlice(gameFragment model.GameFragment) ([]model.GameStud
 	return studioFragmentSlice, nil
 }
 
-func mapGame(gameFragment model.GameFragment, franchiseFragmentSlice []model.GameFranchiseFragment, genreFragmentSlice []model.GameGenreFragment, platformFragmentSlice []model.GamePlatformFragment, studioFragmentSlice []model.GameStudioFragment) model.Game {
+// Fetch the releases of a game per platform and region, in release date order, where each platform is one of the
+// platforms of the game.
+func (repository *Repository) fetchReleaseSlice(gameFragment model.GameFragment, platformFragmentSlice []model.GamePlatformFragment) ([]model.GameRelease, error) {
+	releaseFragmentSlice, err := service.FetchFragmentSlice[model.GameReleaseFragment](repository.connection, database.TableGameReleaseFragments, fmt.Sprintf("game=%d", gameFragment.ID))
+
+	if err != nil {
+		return []model.GameRelease{}, err
+	}
+
+	var releaseSlice []model.GameRelease
+
+	for _, releaseFragment := range releaseFragmentSlice {
+		index := slices.IndexFunc(platformFragmentSlice, func(platform model.GamePlatformFragment) bool {
+			return platform.ID == releaseFragment.Platform
+		})
+
+		if index == -1 {
+			continue
+		}
+
+		releaseSlice = append(releaseSlice, model.GameRelease{
+			Platform:    platformFragmentSlice[index],
+			Region:      releaseFragment.Region,
+			ReleaseDate: releaseFragment.ReleaseDate,
+		})
+	}
+
+	slices.SortStableFunc(releaseSlice, func(a model.GameRelease, b model.GameRelease) int {
+		if a.ReleaseDate != b.ReleaseDate {
+			return cmp.Compare(a.ReleaseDate, b.ReleaseDate)
+		}
+
+		return cmp.Compare(a.Platform.Name, b.Platform.Name)
+	})
+
+	return releaseSlice, nil
+}
+
+func mapGame(gameFragment model.GameFragment, franchiseFragmentSlice []model.GameFranchiseFragment, genreFragmentSlice []model.GameGenreFragment, platformFragmentSlice []model.GamePlatformFragment, studioFragmentSlice []model.GameStudioFragment, releaseSlice []model.GameRelease) model.Game {
 	if franchiseFragmentSlice == nil {
 		franchiseFragmentSlice = make([]model.GameFranchiseFragment, 0)
 	}
@@ -209,6 +256,10 @@ func mapGame(gameFragment model.GameFragment, franchiseFragmentSlice []model.Gam
 		studioFragmentSlice = make([]model.GameStudioFragment, 0)
 	}
 
+	if releaseSlice == nil {
+		releaseSlice = make([]model.GameRelease, 0)
+	}
+
 	return model.Game{
 		ID:          gameFragment.ID,
 		Title:       gameFragment.Title,
@@ -219,7 +270,56 @@ func mapGame(gameFragment model.GameFragment, franchiseFragmentSlice []model.Gam
 		Platforms:   platformFragmentSlice,
 		Studios:     studioFragmentSlice,
 		ReleaseDate: gameFragment.ReleaseDate,
+		Releases:    releaseSlice,
 		Image:       gameFragment.Image,
 		Reference:   gameFragment.Reference,
 	}
 }
+
+// Fetch the rating aggregate (i.e., review count and average rating) of a game.
+//
+// Return: rating aggregate and nil with success, empty rating aggregate and error without.
+func (repository *Repository) FetchGameRating(id int) (reviewModel.RatingAggregate, error) {
+	statement, err := database.CreateQuery("COUNT(rating) AS count, COALESCE(AVG(rating), 0)::FLOAT8 AS average", database.TableGameReviewFragments, fmt.Sprintf("game=%d", id), "")
+
+	if err != nil {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch rating aggregate of game '%d': %v", id, err)
+
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	response, err := database.MapQueryResponse[reviewModel.RatingAggregate](rows)
+
+	if err != nil || len(response) == 0 {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	return response[0], nil
+}
+
+// Fetch the references of stored games among the provided IGDB numeric identifiers.
+func (repository *Repository) fetchStoredReferenceSlice(referenceSlice []string) ([]int, error) {
+	if len(referenceSlice) == 0 {
+		return []int{}, nil
+	}
+
+	statement, err := database.CreateQuery("reference", database.TableGameFragments, fmt.Sprintf("reference IN (%s)", strings.Join(referenceSlice, ",")), "")
+
+	if err != nil {
+		return []int{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return []int{}, err
+	}
+
+	return database.MapQueryResponse[int](rows)
+}
@@ -1,7 +1,9 @@
 package helper
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 
 	model "github.com/muzzarellimj/grace-material-api/internal/model/game"
 	IGDBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/igdb.com"
@@ -27,3 +29,62 @@ func MapSearchResultSlice(input []IGDBModel.IGDBGameSearchResponse) []model.Game
 func FormatImagePath(hash string) string {
 	return fmt.Sprintf("https://images.igdb.com/igdb/image/upload/t_%s/%s.jpg", "cover_big", hash)
 }
+
+// The region names of the IGDB region enumeration.
+var regions = map[int]string{
+	1:  "europe",
+	2:  "north_america",
+	3:  "australia",
+	4:  "new_zealand",
+	5:  "japan",
+	6:  "china",
+	7:  "asia",
+	8:  "worldwide",
+	9:  "korea",
+	10: "brazil",
+}
+
+// Format an IGDB region enumeration as a region name; e.g., 2 becomes "north_america".
+//
+// Return: region name, or "unknown" for an unknown region.
+func FormatRegion(region int) string {
+	name, exists := regions[region]
+
+	if !exists {
+		return "unknown"
+	}
+
+	return name
+}
+
+// Extract the releases of a game with a known platform and date, collapsing releases on the same platform in the same
+// region (e.g., a re-release) into the earliest, in release date order.
+//
+// Return: extracted release slice, which is empty when there are none.
+func ExtractReleaseSlice(releaseDates []IGDBModel.IGDBNestedReleaseDate) []IGDBModel.IGDBNestedReleaseDate {
+	releaseSlice := []IGDBModel.IGDBNestedReleaseDate{}
+
+	for _, release := range releaseDates {
+		if release.Platform == 0 || release.Date == 0 {
+			continue
+		}
+
+		index := slices.IndexFunc(releaseSlice, func(existing IGDBModel.IGDBNestedReleaseDate) bool {
+			return existing.Platform == release.Platform && FormatRegion(existing.Region) == FormatRegion(release.Region)
+		})
+
+		if index == -1 {
+			releaseSlice = append(releaseSlice, release)
+
+			continue
+		}
+
+		releaseSlice[index].Date = min(releaseSlice[index].Date, release.Date)
+	}
+
+	slices.SortStableFunc(releaseSlice, func(a IGDBModel.IGDBNestedReleaseDate, b IGDBModel.IGDBNestedReleaseDate) int {
+		return cmp.Compare(a.Date, b.Date)
+	})
+
+	return releaseSlice
+}
@@ -0,0 +1,177 @@
+package helper
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/game"
+	IGDBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/igdb.com"
+)
+
+// The IGDB operations required by the game repository, which are satisfied by an IGDB client or a fake.
+type Provider interface {
+	IGDBGetGame(id string) (IGDBModel.IGDBGameResponse, error)
+	IGDBSearchGame(query string) ([]IGDBModel.IGDBGameSearchResponse, error)
+	IGDBGetCompany(id int) (IGDBModel.IGDBCompanyResponse, error)
+	IGDBGetSimilarGames(id int) ([]IGDBModel.IGDBGameSearchResponse, error)
+	IGDBGetSteamGameSlice(appid string) ([]IGDBModel.IGDBExternalGameResponse, error)
+}
+
+// A game repository, which fetches, stores, and updates games in the provided database pool with
+// metadata from the provided IGDB provider.
+type Repository struct {
+	connection database.PgxPool
+	client     Provider
+	logger     *log.Logger
+}
+
+// Create a game repository with a database pool, IGDB provider, and logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, client Provider, logger *log.Logger) *Repository {
+	return &Repository{
+		connection: connection,
+		client:     client,
+		logger:     logger,
+	}
+}
+
+// Search IGDB games by name, and map results to the supported search result model.
+//
+// Return: mapped search result slice and nil with success, empty slice and error without.
+func (repository *Repository) SearchGames(query string) ([]model.GameSearchResult, error) {
+	results, err := repository.client.IGDBSearchGame(query)
+
+	if err != nil {
+		repository.logger.Printf("Unable to search IGDB games with query '%s': %v", query, err)
+
+		return []model.GameSearchResult{}, err
+	}
+
+	return MapSearchResultSlice(results), nil
+}
+
+// Fetch candidates similar to a stored game from IGDB, excluding games already stored, and map them to the supported
+// search result model.
+//
+// Return: mapped search result slice, whether the game exists, and nil with success; empty slice, false, and error
+// without.
+func (repository *Repository) SimilarGames(id int) ([]model.GameSearchResult, bool, error) {
+	game, err := service.FetchFragment[model.GameFragment](repository.connection, database.TableGameFragments, fmt.Sprintf("id=%d", id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch game '%d': %v", id, err)
+
+		return []model.GameSearchResult{}, false, err
+	}
+
+	if game.ID == 0 {
+		return []model.GameSearchResult{}, false, nil
+	}
+
+	results, err := repository.client.IGDBGetSimilarGames(game.Reference)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch IGDB games similar to '%d': %v", game.Reference, err)
+
+		return []model.GameSearchResult{}, false, err
+	}
+
+	var referenceSlice []string
+
+	for _, result := range results {
+		referenceSlice = append(referenceSlice, strconv.Itoa(result.ID))
+	}
+
+	storedReferenceSlice, err := repository.fetchStoredReferenceSlice(referenceSlice)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch stored games similar to '%d': %v", game.Reference, err)
+
+		return []model.GameSearchResult{}, false, err
+	}
+
+	candidateSlice := []model.GameSearchResult{}
+
+	for _, result := range MapSearchResultSlice(results) {
+		if !slices.Contains(storedReferenceSlice, result.ID) {
+			candidateSlice = append(candidateSlice, result)
+		}
+	}
+
+	return candidateSlice, true, nil
+}
+
+// Resolve a Steam application identifier to the IGDB numeric identifiers of the games it references, which is
+// usually one.
+//
+// Return: IGDB numeric identifier slice and nil with success, empty slice and error without.
+func (repository *Repository) ResolveSteamGame(appid string) ([]int, error) {
+	externalGameSlice, err := repository.client.IGDBGetSteamGameSlice(appid)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch IGDB games of Steam application '%s': %v", appid, err)
+
+		return []int{}, err
+	}
+
+	referenceSlice := []int{}
+
+	for _, externalGame := range externalGameSlice {
+		if externalGame.Game != 0 && !slices.Contains(referenceSlice, externalGame.Game) {
+			referenceSlice = append(referenceSlice, externalGame.Game)
+		}
+	}
+
+	return referenceSlice, nil
+}
+
+// Store a game with a provided IGDB numeric identifier, unless a game with that reference already exists.
+//
+// Return: numeric identifier, whether the game was newly stored, and nil with success; 0, false, and error without.
+// A 0 identifier without error indicates no IGDB game matched the identifier.
+func (repository *Repository) StoreGame(id string) (int, bool, error) {
+	reference, err := strconv.Atoi(id)
+
+	if err != nil {
+		repository.logger.Printf("Unable to parse IGDB game identifier '%s': %v", id, err)
+
+		return 0, false, err
+	}
+
+	existingGame, err := repository.FetchGame(fmt.Sprintf("reference=%d", reference))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing game '%d': %v", reference, err)
+
+		return 0, false, err
+	}
+
+	if existingGame.ID != 0 {
+		return existingGame.ID, false, nil
+	}
+
+	game, err := repository.client.IGDBGetGame(strconv.Itoa(reference))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch game '%d' IGDB record: %v", reference, err)
+
+		return 0, false, err
+	}
+
+	if game.ID == 0 {
+		return 0, false, nil
+	}
+
+	gameId, err := repository.ProcessGameStorage(game)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	return gameId, true, nil
+}
@@ -2,52 +2,52 @@ package helper
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/jackc/pgx/v5"
-	IGDBAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/igdb.com"
 	"github.com/muzzarellimj/grace-material-api/internal/database"
 	"github.com/muzzarellimj/grace-material-api/internal/database/service"
 	model "github.com/muzzarellimj/grace-material-api/internal/model/game"
 	IGDBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/igdb.com"
 )
 
-func ProcessGameStorage(game IGDBModel.IGDBGameResponse) (int, error) {
-	gameId, err := storeGameFragment(game)
+func (repository *Repository) ProcessGameStorage(game IGDBModel.IGDBGameResponse) (int, error) {
+	gameId, err := repository.storeGameFragment(game)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to store game '%d' fragment: %v\n", game.ID, err)
+		repository.logger.Printf("Unable to store game '%d' fragment: %v", game.ID, err)
 
 		return 0, err
 	}
 
-	franchiseIdSlice := processFranchiseFragmentSlice(game.Franchises)
-	genreIdSlice := processGenreFragmentSlice(game.Genres)
-	platformIdSlice := processPlatformFragmentSlice(game.Platforms)
-	studioIdSlice := processStudioFragmentSlice(game.InvolvedCompanies)
+	franchiseIdSlice := repository.processFranchiseFragmentSlice(game.Franchises)
+	genreIdSlice := repository.processGenreFragmentSlice(game.Genres)
+	platformIdSlice := repository.processPlatformFragmentSlice(game.Platforms)
+	studioIdSlice := repository.processStudioFragmentSlice(game.InvolvedCompanies)
 
-	service.StoreRelationshipSlice(database.Connection, database.TableGameFranchiseRelationships, database.PropertiesGameFranchiseRelationships, service.RelationshipSliceArgument{
+	repository.processReleaseFragmentSlice(gameId, game.ReleaseDates)
+
+	service.StoreRelationshipSlice(repository.connection, database.TableGameFranchiseRelationships, database.PropertiesGameFranchiseRelationships, service.RelationshipSliceArgument{
 		SourceName:          "game",
 		SourceArgument:      gameId,
 		DestinationName:     "franchise",
 		DestinationArgument: franchiseIdSlice,
 	})
 
-	service.StoreRelationshipSlice(database.Connection, database.TableGameGenreRelationships, database.PropertiesGameGenreRelationships, service.RelationshipSliceArgument{
+	service.StoreRelationshipSlice(repository.connection, database.TableGameGenreRelationships, database.PropertiesGameGenreRelationships, service.RelationshipSliceArgument{
 		SourceName:          "game",
 		SourceArgument:      gameId,
 		DestinationName:     "genre",
 		DestinationArgument: genreIdSlice,
 	})
 
-	service.StoreRelationshipSlice(database.Connection, database.TableGamePlatformRelationships, database.PropertiesGamePlatformRelationships, service.RelationshipSliceArgument{
+	service.StoreRelationshipSlice(repository.connection, database.TableGamePlatformRelationships, database.PropertiesGamePlatformRelationships, service.RelationshipSliceArgument{
 		SourceName:          "game",
 		SourceArgument:      gameId,
 		DestinationName:     "platform",
 		DestinationArgument: platformIdSlice,
 	})
 
-	service.StoreRelationshipSlice(database.Connection, database.TableGameStudioRelationships, database.PropertiesGameStudioRelationships, service.RelationshipSliceArgument{
+	service.StoreRelationshipSlice(repository.connection, database.TableGameStudioRelationships, database.PropertiesGameStudioRelationships, service.RelationshipSliceArgument{
 		SourceName:          "game",
 		SourceArgument:      gameId,
 		DestinationName:     "studio",
@@ -57,8 +57,8 @@ func ProcessGameStorage(game IGDBModel.IGDBGameResponse) (int, error) {
 	return gameId, nil
 }
 
-func storeGameFragment(game IGDBModel.IGDBGameResponse) (int, error) {
-	gameId, err := service.StoreFragment(database.Connection, database.TableGameFragments, database.PropertiesGameFragments, pgx.NamedArgs{
+func (repository *Repository) storeGameFragment(game IGDBModel.IGDBGameResponse) (int, error) {
+	gameId, err := service.StoreFragment(repository.connection, database.TableGameFragments, database.PropertiesGameFragments, pgx.NamedArgs{
 		"title":        game.Title,
 		"summary":      game.Summary,
 		"storyline":    game.Storyline,
@@ -68,7 +68,7 @@ func storeGameFragment(game IGDBModel.IGDBGameResponse) (int, error) {
 	})
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to store game '%d' fragment: %v\n", game.ID, err)
+		repository.logger.Printf("Unable to store game '%d' fragment: %v", game.ID, err)
 
 		return 0, err
 	}
@@ -76,14 +76,14 @@ func storeGameFragment(game IGDBModel.IGDBGameResponse) (int, error) {
 	return gameId, nil
 }
 
-func processFranchiseFragmentSlice(franchises []IGDBModel.IGDBNestedNamedResource) []int {
+func (repository *Repository) processFranchiseFragmentSlice(franchises []IGDBModel.IGDBNestedNamedResource) []int {
 	var franchiseIdSlice []int
 
 	for _, resource := range franchises {
-		existingFranchiseFragment, err := service.FetchFragment[model.GameFranchiseFragment](database.Connection, database.TableGameFranchiseFragments, fmt.Sprintf("reference=%d", resource.ID))
+		existingFranchiseFragment, err := service.FetchFragment[model.GameFranchiseFragment](repository.connection, database.TableGameFranchiseFragments, fmt.Sprintf("reference=%d", resource.ID))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch existing franchise '%d' fragment: %v\n", resource.ID, err)
+			repository.logger.Printf("Unable to fetch existing franchise '%d' fragment: %v", resource.ID, err)
 
 			continue
 		}
@@ -94,13 +94,13 @@ func processFranchiseFragmentSlice(franchises []IGDBModel.IGDBNestedNamedResourc
 			continue
 		}
 
-		franchiseId, err := service.StoreFragment(database.Connection, database.TableGameFranchiseFragments, database.PropertiesGameFranchiseFragments, pgx.NamedArgs{
+		franchiseId, err := service.StoreFragment(repository.connection, database.TableGameFranchiseFragments, database.PropertiesGameFranchiseFragments, pgx.NamedArgs{
 			"name":      resource.Name,
 			"reference": resource.ID,
 		})
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to store new franchise '%d' fragment: %v\n", resource.ID, err)
+			repository.logger.Printf("Unable to store new franchise '%d' fragment: %v", resource.ID, err)
 		}
 
 		if franchiseId != 0 {
@@ -111,14 +111,14 @@ func processFranchiseFragmentSlice(franchises []IGDBModel.IGDBNestedNamedResourc
 	return franchiseIdSlice
 }
 
-func processGenreFragmentSlice(genres []IGDBModel.IGDBNestedNamedResource) []int {
+func (repository *Repository) processGenreFragmentSlice(genres []IGDBModel.IGDBNestedNamedResource) []int {
 	var genreIdSlice []int
 
 	for _, resource := range genres {
-		existingGenreFragment, err := service.FetchFragment[model.GameGenreFragment](database.Connection, database.TableGameGenreFragments, fmt.Sprintf("reference=%d", resource.ID))
+		existingGenreFragment, err := service.FetchFragment[model.GameGenreFragment](repository.connection, database.TableGameGenreFragments, fmt.Sprintf("reference=%d", resource.ID))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch existing genre '%d' fragment: %v\n", resource.ID, err)
+			repository.logger.Printf("Unable to fetch existing genre '%d' fragment: %v", resource.ID, err)
 
 			continue
 		}
@@ -129,13 +129,13 @@ func processGenreFragmentSlice(genres []IGDBModel.IGDBNestedNamedResource) []int
 			continue
 		}
 
-		genreId, err := service.StoreFragment(database.Connection, database.TableGameGenreFragments, database.PropertiesGameGenreFragments, pgx.NamedArgs{
+		genreId, err := service.StoreFragment(repository.connection, database.TableGameGenreFragments, database.PropertiesGameGenreFragments, pgx.NamedArgs{
 			"name":      resource.Name,
 			"reference": resource.ID,
 		})
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to store new genre '%d' fragment: %v\n", resource.ID, err)
+			repository.logger.Printf("Unable to store new genre '%d' fragment: %v", resource.ID, err)
 		}
 
 		if genreId != 0 {
@@ -146,14 +146,14 @@ func processGenreFragmentSlice(genres []IGDBModel.IGDBNestedNamedResource) []int
 	return genreIdSlice
 }
 
-func processPlatformFragmentSlice(platforms []IGDBModel.IGDBNestedNamedResource) []int {
+func (repository *Repository) processPlatformFragmentSlice(platforms []IGDBModel.IGDBNestedNamedResource) []int {
 	var platformIdSlice []int
 
 	for _, resource := range platforms {
-		existingPlatformFragment, err := service.FetchFragment[model.GamePlatformFragment](database.Connection, database.TableGamePlatformFragments, fmt.Sprintf("reference=%d", resource.ID))
+		existingPlatformFragment, err := service.FetchFragment[model.GamePlatformFragment](repository.connection, database.TableGamePlatformFragments, fmt.Sprintf("reference=%d", resource.ID))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch existing platform '%d' fragment: %v\n", resource.ID, err)
+			repository.logger.Printf("Unable to fetch existing platform '%d' fragment: %v", resource.ID, err)
 
 			continue
 		}
@@ -164,13 +164,13 @@ func processPlatformFragmentSlice(platforms []IGDBModel.IGDBNestedNamedResource)
 			continue
 		}
 
-		platformId, err := service.StoreFragment(database.Connection, database.TableGamePlatformFragments, database.PropertiesGamePlatformFragments, pgx.NamedArgs{
+		platformId, err := service.StoreFragment(repository.connection, database.TableGamePlatformFragments, database.PropertiesGamePlatformFragments, pgx.NamedArgs{
 			"name":      resource.Name,
 			"reference": resource.ID,
 		})
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to store new platform '%d' fragment: %v\n", resource.ID, err)
+			repository.logger.Printf("Unable to store new platform '%d' fragment: %v", resource.ID, err)
 		}
 
 		if platformId != 0 {
@@ -181,7 +181,45 @@ func processPlatformFragmentSlice(platforms []IGDBModel.IGDBNestedNamedResource)
 	return platformIdSlice
 }
 
-func processStudioFragmentSlice(companies []IGDBModel.IGDBNestedInvolvedCompany) []int {
+// Store the releases of a game per platform and region (see ExtractReleaseSlice), where each platform is matched by
+// IGDB identifier to a platform stored with the game.
+func (repository *Repository) processReleaseFragmentSlice(gameId int, releaseDates []IGDBModel.IGDBNestedReleaseDate) {
+	platformIds := make(map[int]int)
+
+	for _, release := range ExtractReleaseSlice(releaseDates) {
+		platformId, exists := platformIds[release.Platform]
+
+		if !exists {
+			platformFragment, err := service.FetchFragment[model.GamePlatformFragment](repository.connection, database.TableGamePlatformFragments, fmt.Sprintf("reference=%d", release.Platform))
+
+			if err != nil {
+				repository.logger.Printf("Unable to fetch existing platform '%d' fragment: %v", release.Platform, err)
+
+				continue
+			}
+
+			platformId = platformFragment.ID
+			platformIds[release.Platform] = platformId
+		}
+
+		if platformId == 0 {
+			continue
+		}
+
+		_, err := service.StoreFragment(repository.connection, database.TableGameReleaseFragments, database.PropertiesGameReleaseFragments, pgx.NamedArgs{
+			"game":         gameId,
+			"platform":     platformId,
+			"region":       FormatRegion(release.Region),
+			"release_date": release.Date,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store release of game '%d' on platform '%d': %v", gameId, release.Platform, err)
+		}
+	}
+}
+
+func (repository *Repository) processStudioFragmentSlice(companies []IGDBModel.IGDBNestedInvolvedCompany) []int {
 	var studioIdSlice []int
 
 	for _, company := range companies {
@@ -189,10 +227,10 @@ func processStudioFragmentSlice(companies []IGDBModel.IGDBNestedInvolvedCompany)
 			continue
 		}
 
-		existingStudioFragment, err := service.FetchFragment[model.GameStudioFragment](database.Connection, database.TableGameStudioFragments, fmt.Sprintf("reference=%d", company.Company))
+		existingStudioFragment, err := service.FetchFragment[model.GameStudioFragment](repository.connection, database.TableGameStudioFragments, fmt.Sprintf("reference=%d", company.Company))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch existing studio '%d' fragment: %v\n", company.Company, err)
+			repository.logger.Printf("Unable to fetch existing studio '%d' fragment: %v", company.Company, err)
 
 			continue
 		}
@@ -203,22 +241,22 @@ func processStudioFragmentSlice(companies []IGDBModel.IGDBNestedInvolvedCompany)
 			continue
 		}
 
-		studio, err := IGDBAPI.IGDBGetResource[IGDBModel.IGDBCompanyResponse](IGDBAPI.IGDBEndpointCompany, fmt.Sprintf("fields id,name,description; where id=%d;", company.Company))
+		studio, err := repository.client.IGDBGetCompany(company.Company)
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch company '%d' IGDB record: %v\n", company.Company, err)
+			repository.logger.Printf("Unable to fetch company '%d' IGDB record: %v", company.Company, err)
 
 			continue
 		}
 
-		studioId, err := service.StoreFragment(database.Connection, database.TableGameStudioFragments, database.PropertiesGameStudioFragments, pgx.NamedArgs{
+		studioId, err := service.StoreFragment(repository.connection, database.TableGameStudioFragments, database.PropertiesGameStudioFragments, pgx.NamedArgs{
 			"name":        studio.Name,
 			"description": studio.Description,
 			"reference":   studio.ID,
 		})
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to store new studio '%d' fragment: %v\n", studio.ID, err)
+			repository.logger.Printf("Unable to store new studio '%d' fragment: %v", studio.ID, err)
 		}
 
 		if studioId != 0 {
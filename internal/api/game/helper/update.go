@@ -2,7 +2,6 @@ package helper
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/muzzarellimj/grace-material-api/internal/database"
@@ -10,8 +9,8 @@ import (
 	model "github.com/muzzarellimj/grace-material-api/internal/model/game"
 )
 
-func UpdateGameFragment(game model.GameFragment) (int, error) {
-	id, err := service.UpdateFragment(database.Connection, database.TableGameFragments, database.PropertiesGameFragments, fmt.Sprintf("id=%d", game.ID), pgx.NamedArgs{
+func (repository *Repository) UpdateGameFragment(game model.GameFragment) (int, error) {
+	id, err := service.UpdateFragment(repository.connection, database.TableGameFragments, database.PropertiesGameFragments, fmt.Sprintf("id=%d", game.ID), pgx.NamedArgs{
 		"title":        game.Title,
 		"summary":      game.Summary,
 		"storyline":    game.Storyline,
@@ -21,7 +20,7 @@ func UpdateGameFragment(game model.GameFragment) (int, error) {
 	})
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to update game '%d' fragment: %v\n", game.ID, err)
+		repository.logger.Printf("Unable to update game '%d' fragment: %v", game.ID, err)
 
 		return 0, err
 	}
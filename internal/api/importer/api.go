@@ -0,0 +1,146 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/importer"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+)
+
+// An import request handler, which holds the dependencies shared between import routes.
+type Handler struct {
+	importer *importer.Importer
+}
+
+// Create an import request handler with an importer.
+//
+// Return: configured handler.
+func NewHandler(importer *importer.Importer) *Handler {
+	return &Handler{
+		importer: importer,
+	}
+}
+
+// Handle an export file upload, either as the raw request body or as the 'file' field of a multipart form, and submit
+// its rows for import in the background.
+func (handler *Handler) HandlePostImport(context *gin.Context) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to import without an authenticated principal.",
+		})
+
+		return
+	}
+
+	source := context.Param("source")
+
+	if !handler.importer.Supports(source) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid import source argument '%s' provided in route parameter 'source'.", source),
+		})
+
+		return
+	}
+
+	reader, err := openUpload(context)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to read export file from request body or multipart form field 'file'.",
+		})
+
+		return
+	}
+
+	defer reader.Close()
+
+	rowSlice, err := handler.importer.Parse(source, reader)
+
+	if err != nil {
+		status := http.StatusBadRequest
+
+		if errors.Is(err, importer.ErrTooManyRows) {
+			status = http.StatusRequestEntityTooLarge
+		}
+
+		context.IndentedJSON(status, gin.H{
+			"status":  status,
+			"message": fmt.Sprintf("Unable to parse %s export file: %v.", source, err),
+		})
+
+		return
+	}
+
+	job := handler.importer.Submit(principal.Subject, source, rowSlice)
+
+	context.IndentedJSON(http.StatusAccepted, gin.H{
+		"status": http.StatusAccepted,
+		"data":   job,
+	})
+}
+
+func (handler *Handler) HandleGetImport(context *gin.Context) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to fetch import without an authenticated principal.",
+		})
+
+		return
+	}
+
+	id, err := strconv.Atoi(context.Param("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid import identifier argument '%s' provided in route parameter 'id'.", context.Param("id")),
+		})
+
+		return
+	}
+
+	job, exists := handler.importer.Job(principal.Subject, id)
+
+	if !exists {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find import with numeric identifier '%d'.", id),
+		})
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   job,
+	})
+}
+
+// Open an uploaded export file, from the 'file' field of a multipart form or, otherwise, the request body.
+func openUpload(context *gin.Context) (io.ReadCloser, error) {
+	if !strings.HasPrefix(context.ContentType(), "multipart/form-data") {
+		return context.Request.Body, nil
+	}
+
+	header, err := context.FormFile("file")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return header.Open()
+}
@@ -0,0 +1,382 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/list/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/list"
+)
+
+const errorMessage string = "Unable to fetch lists and map to supported data structure."
+
+// A list request handler, which holds the dependencies shared between list routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a list request handler with a list repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetListSlice(context *gin.Context) {
+	principal, ok := bindPrincipal(context)
+
+	if !ok {
+		return
+	}
+
+	listSlice, err := handler.repository.FetchListSlice(principal)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(listSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   listSlice,
+	})
+}
+
+func (handler *Handler) HandleGetList(context *gin.Context) {
+	principal, id, ok := bindListRequest(context)
+
+	if !ok {
+		return
+	}
+
+	list, err := handler.repository.FetchList(principal, id)
+
+	if err != nil {
+		respondWithError(context, err, errorMessage)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   list,
+	})
+}
+
+func (handler *Handler) HandlePostList(context *gin.Context) {
+	principal, ok := bindPrincipal(context)
+
+	if !ok {
+		return
+	}
+
+	update, ok := bindListUpdate(context)
+
+	if !ok {
+		return
+	}
+
+	list, err := handler.repository.StoreList(principal, update)
+
+	if err != nil {
+		respondWithError(context, err, "Unable to store list.")
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusCreated, gin.H{
+		"status": http.StatusCreated,
+		"data":   list,
+	})
+}
+
+func (handler *Handler) HandlePutList(context *gin.Context) {
+	principal, id, ok := bindListRequest(context)
+
+	if !ok {
+		return
+	}
+
+	update, ok := bindListUpdate(context)
+
+	if !ok {
+		return
+	}
+
+	list, err := handler.repository.UpdateList(principal, id, update)
+
+	if err != nil {
+		respondWithError(context, err, "Unable to update list.")
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   list,
+	})
+}
+
+func (handler *Handler) HandleDeleteList(context *gin.Context) {
+	principal, id, ok := bindListRequest(context)
+
+	if !ok {
+		return
+	}
+
+	deleted, err := handler.repository.DeleteList(principal, id)
+
+	if err != nil {
+		respondWithError(context, err, "Unable to delete list.")
+
+		return
+	}
+
+	if !deleted {
+		respondWithError(context, helper.ErrListNotFound, "")
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
+
+func (handler *Handler) HandlePostListItem(context *gin.Context) {
+	principal, id, ok := bindListRequest(context)
+
+	if !ok {
+		return
+	}
+
+	var update model.ListItemUpdate
+
+	err := context.BindJSON(&update)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to list item model.",
+		})
+
+		return
+	}
+
+	if !handler.repository.Supports(update.Type) || update.Material <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid list item with material type '%s' and numeric identifier '%d' provided.", update.Type, update.Material),
+		})
+
+		return
+	}
+
+	item, created, err := handler.repository.AddListItem(principal, id, update)
+
+	if err != nil {
+		respondWithError(context, err, "Unable to add material to list.")
+
+		return
+	}
+
+	status := http.StatusOK
+
+	if created {
+		status = http.StatusCreated
+	}
+
+	context.IndentedJSON(status, gin.H{
+		"status": status,
+		"data":   item,
+	})
+}
+
+func (handler *Handler) HandleDeleteListItem(context *gin.Context) {
+	principal, id, ok := bindListRequest(context)
+
+	if !ok {
+		return
+	}
+
+	item, err := strconv.Atoi(context.Query("item"))
+
+	if err != nil || item <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid list item identifier argument '%s' provided in query parameter 'item'.", context.Query("item")),
+		})
+
+		return
+	}
+
+	removed, err := handler.repository.RemoveListItem(principal, id, item)
+
+	if err != nil {
+		respondWithError(context, err, "Unable to remove material from list.")
+
+		return
+	}
+
+	if !removed {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find item with numeric identifier '%d' in list.", item),
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
+
+func (handler *Handler) HandlePutListOrder(context *gin.Context) {
+	principal, id, ok := bindListRequest(context)
+
+	if !ok {
+		return
+	}
+
+	var update model.ListOrderUpdate
+
+	err := context.BindJSON(&update)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to list order model.",
+		})
+
+		return
+	}
+
+	err = handler.repository.ReorderList(principal, id, update.Items)
+
+	if err != nil {
+		respondWithError(context, err, "Unable to reorder list.")
+
+		return
+	}
+
+	list, err := handler.repository.FetchList(principal, id)
+
+	if err != nil {
+		respondWithError(context, err, errorMessage)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   list,
+	})
+}
+
+// Bind the principal subject, responding with an error when the request is not authenticated.
+func bindPrincipal(context *gin.Context) (string, bool) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to access lists without an authenticated principal.",
+		})
+
+		return "", false
+	}
+
+	return principal.Subject, true
+}
+
+// Bind the principal subject and list numeric identifier route parameter, responding with an error when either is
+// missing or invalid.
+func bindListRequest(context *gin.Context) (string, int, bool) {
+	principal, ok := bindPrincipal(context)
+
+	if !ok {
+		return "", 0, false
+	}
+
+	id, err := strconv.Atoi(context.Param("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid list identifier argument '%s' provided in route parameter 'id'.", context.Param("id")),
+		})
+
+		return "", 0, false
+	}
+
+	return principal, id, true
+}
+
+// Bind a list name and description, responding with an error when the name is empty or either is too long.
+func bindListUpdate(context *gin.Context) (model.ListUpdate, bool) {
+	var update model.ListUpdate
+
+	err := context.BindJSON(&update)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to list model.",
+		})
+
+		return model.ListUpdate{}, false
+	}
+
+	update.Name = strings.TrimSpace(update.Name)
+
+	if update.Name == "" || len(update.Name) > helper.MaximumNameLength || len(update.Description) > helper.MaximumDescriptionLength {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid list provided; expected a name of 1 to %d characters and a description of at most %d characters.", helper.MaximumNameLength, helper.MaximumDescriptionLength),
+		})
+
+		return model.ListUpdate{}, false
+	}
+
+	return update, true
+}
+
+// Respond with the status matching a list repository error.
+func respondWithError(context *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, helper.ErrListNotFound):
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find list with numeric identifier '%s'.", context.Param("id")),
+		})
+	case errors.Is(err, helper.ErrMaterialNotFound):
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": "Unable to find material with provided type and numeric identifier.",
+		})
+	case errors.Is(err, helper.ErrInvalidOrder):
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid list order provided: %v.", err),
+		})
+	default:
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": message,
+		})
+	}
+}
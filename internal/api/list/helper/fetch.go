@@ -0,0 +1,122 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/list"
+)
+
+// Fetch the lists of the user with the provided reference, without items.
+//
+// Return: list fragment slice and nil with success, empty slice and error without.
+func (repository *Repository) FetchListSlice(reference string) ([]model.ListFragment, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return []model.ListFragment{}, err
+	}
+
+	listSlice, err := service.FetchFragmentSlice[model.ListFragment](repository.connection, database.TableListFragments, fmt.Sprintf("owner=%d", user.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch lists of user '%d': %v", user.ID, err)
+
+		return []model.ListFragment{}, err
+	}
+
+	return listSlice, nil
+}
+
+// Fetch a list of the user with the provided reference, with items of the enabled material types in order.
+//
+// Return: list and nil with success, empty list and ErrListNotFound when the user has no such list, or error without.
+func (repository *Repository) FetchList(reference string, id int) (model.List, error) {
+	fragment, err := repository.fetchOwnedList(reference, id)
+
+	if err != nil {
+		return model.List{}, err
+	}
+
+	list := model.List{
+		ID:          fragment.ID,
+		Name:        fragment.Name,
+		Description: fragment.Description,
+		Items:       []model.ListItem{},
+		DateCreated: fragment.DateCreated,
+	}
+
+	for _, materialType := range repository.materialTypes {
+		itemSlice, err := repository.fetchListItemSlice(fragment.ID, materialType)
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch %s items of list '%d': %v", materialType, fragment.ID, err)
+
+			return model.List{}, err
+		}
+
+		list.Items = append(list.Items, itemSlice...)
+	}
+
+	slices.SortStableFunc(list.Items, func(a model.ListItem, b model.ListItem) int {
+		return cmp.Compare(a.Position, b.Position)
+	})
+
+	return list, nil
+}
+
+// Fetch a list by numeric identifier, only if owned by the user with the provided reference.
+func (repository *Repository) fetchOwnedList(reference string, id int) (model.ListFragment, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return model.ListFragment{}, err
+	}
+
+	list, err := service.FetchFragment[model.ListFragment](repository.connection, database.TableListFragments, fmt.Sprintf("id=%d AND owner=%d", id, user.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch list '%d' of user '%d': %v", id, user.ID, err)
+
+		return model.ListFragment{}, err
+	}
+
+	if list.ID == 0 {
+		return model.ListFragment{}, ErrListNotFound
+	}
+
+	return list, nil
+}
+
+// Fetch the items of a list with a material type, joined with their material.
+func (repository *Repository) fetchListItemSlice(list int, materialType string) ([]model.ListItem, error) {
+	itemMaterial, exists := material.Lookup(materialType)
+
+	if !exists {
+		return []model.ListItem{}, nil
+	}
+
+	statement, err := database.CreateQuery(
+		"i.id, i.position, i.type, i.material, m.title, m.image",
+		fmt.Sprintf("%s i", database.TableListItemFragments),
+		fmt.Sprintf("i.list=%d AND i.type='%s'", list, itemMaterial.Type),
+		"",
+		fmt.Sprintf("JOIN %s m ON m.id = i.material", itemMaterial.Table),
+	)
+
+	if err != nil {
+		return []model.ListItem{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return []model.ListItem{}, err
+	}
+
+	return database.MapQueryResponse[model.ListItem](rows)
+}
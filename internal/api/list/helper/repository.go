@@ -0,0 +1,53 @@
+package helper
+
+import (
+	"errors"
+	"log"
+	"slices"
+
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+)
+
+var (
+	ErrListNotFound     = errors.New("list not found")
+	ErrMaterialNotFound = errors.New("material not found")
+	ErrInvalidOrder     = errors.New("order must contain every list item exactly once")
+)
+
+// Maximum list name and description lengths, in bytes.
+const (
+	MaximumNameLength        = 128
+	MaximumDescriptionLength = 1024
+)
+
+// A list repository, which stores and fetches per-user ordered lists mixing the enabled material types in the
+// provided database pool.
+type Repository struct {
+	connection    database.PgxPool
+	users         *userHelper.Repository
+	materialTypes []string
+	logger        *log.Logger
+}
+
+// Create a list repository with a database pool, user repository, enabled material types (e.g., "book"), and logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, users *userHelper.Repository, materialTypes []string, logger *log.Logger) *Repository {
+	return &Repository{
+		connection:    connection,
+		users:         users,
+		materialTypes: materialTypes,
+		logger:        logger,
+	}
+}
+
+// Determine whether a material type is known and enabled.
+//
+// Return: true if supported, false if not.
+func (repository *Repository) Supports(materialType string) bool {
+	_, exists := material.Lookup(materialType)
+
+	return exists && slices.Contains(repository.materialTypes, materialType)
+}
@@ -0,0 +1,263 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/list"
+)
+
+// Store a list for the user with the provided reference.
+//
+// Return: stored list fragment and nil with success, empty list fragment and error without.
+func (repository *Repository) StoreList(reference string, update model.ListUpdate) (model.ListFragment, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return model.ListFragment{}, err
+	}
+
+	list := model.ListFragment{
+		Owner:       user.ID,
+		Name:        update.Name,
+		Description: update.Description,
+		DateCreated: time.Now().Unix(),
+	}
+
+	list.ID, err = service.StoreFragment(repository.connection, database.TableListFragments, database.PropertiesListFragments, pgx.NamedArgs{
+		"owner":        list.Owner,
+		"name":         list.Name,
+		"description":  list.Description,
+		"date_created": list.DateCreated,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store list '%s' of user '%d': %v", list.Name, user.ID, err)
+
+		return model.ListFragment{}, err
+	}
+
+	return list, nil
+}
+
+// Update the name and description of a list of the user with the provided reference.
+//
+// Return: updated list fragment and nil with success, empty list fragment and ErrListNotFound when the user has no
+// such list, or error without.
+func (repository *Repository) UpdateList(reference string, id int, update model.ListUpdate) (model.ListFragment, error) {
+	list, err := repository.fetchOwnedList(reference, id)
+
+	if err != nil {
+		return model.ListFragment{}, err
+	}
+
+	list.Name = update.Name
+	list.Description = update.Description
+
+	_, err = service.UpdateFragment(repository.connection, database.TableListFragments, []string{"name", "description"}, fmt.Sprintf("id=%d", list.ID), pgx.NamedArgs{
+		"name":        list.Name,
+		"description": list.Description,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to update list '%d': %v", list.ID, err)
+
+		return model.ListFragment{}, err
+	}
+
+	return list, nil
+}
+
+// Delete a list of the user with the provided reference, including its items.
+//
+// Return: whether the list existed and nil with success, false and error without.
+func (repository *Repository) DeleteList(reference string, id int) (bool, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return false, err
+	}
+
+	count, err := service.DeleteRelationship(repository.connection, database.TableListFragments, fmt.Sprintf("id=%d AND owner=%d", id, user.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to delete list '%d' of user '%d': %v", id, user.ID, err)
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Append a material to the end of a list of the user with the provided reference, unless it is already listed.
+//
+// Return: list item fragment, whether it was newly added, and nil with success; empty list item fragment, false, and
+// ErrListNotFound or ErrMaterialNotFound when either does not exist, or error without.
+func (repository *Repository) AddListItem(reference string, id int, update model.ListItemUpdate) (model.ListItemFragment, bool, error) {
+	list, err := repository.fetchOwnedList(reference, id)
+
+	if err != nil {
+		return model.ListItemFragment{}, false, err
+	}
+
+	itemMaterial, _ := material.Lookup(update.Type)
+
+	materialSlice, err := service.FetchExistenceSlice(repository.connection, itemMaterial.Table, fmt.Sprintf("id=%d", update.Material))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence of %s '%d': %v", update.Type, update.Material, err)
+
+		return model.ListItemFragment{}, false, err
+	}
+
+	if len(materialSlice) == 0 {
+		return model.ListItemFragment{}, false, ErrMaterialNotFound
+	}
+
+	itemSlice, err := service.FetchFragmentSlice[model.ListItemFragment](repository.connection, database.TableListItemFragments, fmt.Sprintf("list=%d", list.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch items of list '%d': %v", list.ID, err)
+
+		return model.ListItemFragment{}, false, err
+	}
+
+	item := model.ListItemFragment{
+		List:     list.ID,
+		Position: 1,
+		Type:     itemMaterial.Type,
+		Material: update.Material,
+	}
+
+	for _, existingItem := range itemSlice {
+		if existingItem.Type == item.Type && existingItem.Material == item.Material {
+			return existingItem, false, nil
+		}
+
+		item.Position = max(item.Position, existingItem.Position+1)
+	}
+
+	item.ID, err = service.StoreFragment(repository.connection, database.TableListItemFragments, database.PropertiesListItemFragments, pgx.NamedArgs{
+		"list":     item.List,
+		"position": item.Position,
+		"type":     item.Type,
+		"material": item.Material,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store %s '%d' in list '%d': %v", item.Type, item.Material, list.ID, err)
+
+		return model.ListItemFragment{}, false, err
+	}
+
+	return item, true, nil
+}
+
+// Remove an item from a list of the user with the provided reference.
+//
+// Return: whether the item existed and nil with success, false and ErrListNotFound when the user has no such list, or
+// error without.
+func (repository *Repository) RemoveListItem(reference string, id int, item int) (bool, error) {
+	list, err := repository.fetchOwnedList(reference, id)
+
+	if err != nil {
+		return false, err
+	}
+
+	count, err := service.DeleteRelationship(repository.connection, database.TableListItemFragments, fmt.Sprintf("id=%d AND list=%d", item, list.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to delete item '%d' of list '%d': %v", item, list.ID, err)
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Reorder the items of a list of the user with the provided reference, where the order contains every list item
+// numeric identifier exactly once, first to last.
+//
+// Every position is written in a single transaction, so a failure leaves the previous order intact.
+//
+// Return: nil with success, ErrListNotFound when the user has no such list, ErrInvalidOrder when the order does not
+// match the list items, or error without.
+func (repository *Repository) ReorderList(reference string, id int, order []int) error {
+	list, err := repository.fetchOwnedList(reference, id)
+
+	if err != nil {
+		return err
+	}
+
+	itemSlice, err := service.FetchFragmentSlice[model.ListItemFragment](repository.connection, database.TableListItemFragments, fmt.Sprintf("list=%d", list.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch items of list '%d': %v", list.ID, err)
+
+		return err
+	}
+
+	var itemIdSlice []int
+
+	for _, item := range itemSlice {
+		itemIdSlice = append(itemIdSlice, item.ID)
+	}
+
+	sortedOrder := slices.Clone(order)
+
+	slices.Sort(itemIdSlice)
+	slices.Sort(sortedOrder)
+
+	if !slices.Equal(itemIdSlice, sortedOrder) {
+		return ErrInvalidOrder
+	}
+
+	tx, err := repository.connection.Begin(context.Background())
+
+	if err != nil {
+		repository.logger.Printf("Unable to begin transaction to reorder list '%d': %v", list.ID, err)
+
+		return err
+	}
+
+	defer func() {
+		err := tx.Rollback(context.Background())
+
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			repository.logger.Printf("Unable to rollback reorder transaction of list '%d': %v", list.ID, err)
+		}
+	}()
+
+	statement := fmt.Sprintf("UPDATE %s SET position=@position WHERE id=@id AND list=@list", database.TableListItemFragments)
+
+	for index, item := range order {
+		_, err = tx.Exec(context.Background(), statement, pgx.NamedArgs{
+			"position": index + 1,
+			"id":       item,
+			"list":     list.ID,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to update position of item '%d' in list '%d': %v", item, list.ID, err)
+
+			return err
+		}
+	}
+
+	err = tx.Commit(context.Background())
+
+	if err != nil {
+		repository.logger.Printf("Unable to commit reorder transaction of list '%d': %v", list.ID, err)
+
+		return err
+	}
+
+	return nil
+}
@@ -3,17 +3,35 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/muzzarellimj/grace-material-api/internal/api/movie/helper"
-	TMDBAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/themoviedb.org"
+	tagHelper "github.com/muzzarellimj/grace-material-api/internal/api/tag/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
 	model "github.com/muzzarellimj/grace-material-api/internal/model/movie"
 )
 
 const errorMessage string = "Unable to fetch movie metadata and map to supported data structure."
 
-func HandleGetMovie(context *gin.Context) {
+// A movie request handler, which holds the dependencies shared between movie routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a movie request handler with a movie repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetMovie(context *gin.Context) {
 	idArg := context.Query("id")
 
 	if len(idArg) == 0 {
@@ -42,7 +60,7 @@ func HandleGetMovie(context *gin.Context) {
 		constraintSlice = append(constraintSlice, fmt.Sprintf("id=%s", id))
 	}
 
-	movieSlice, errorSlice := helper.FetchMovieSlice(constraintSlice)
+	movieSlice, errorSlice := handler.repository.FetchMovieSlice(constraintSlice)
 
 	if len(errorSlice) != 0 {
 		context.IndentedJSON(http.StatusBadRequest, gin.H{
@@ -59,13 +77,30 @@ func HandleGetMovie(context *gin.Context) {
 		return
 	}
 
+	if slices.Contains(strings.Split(context.Query("include"), ","), "rating") {
+		for index := range movieSlice {
+			rating, err := handler.repository.FetchMovieRating(movieSlice[index].ID)
+
+			if err != nil {
+				context.IndentedJSON(http.StatusInternalServerError, gin.H{
+					"status":  http.StatusInternalServerError,
+					"message": errorMessage,
+				})
+
+				return
+			}
+
+			movieSlice[index].Rating = &rating
+		}
+	}
+
 	context.IndentedJSON(http.StatusOK, gin.H{
 		"status": http.StatusOK,
 		"data":   movieSlice,
 	})
 }
 
-func HandlePutMovie(context *gin.Context) {
+func (handler *Handler) HandlePutMovie(context *gin.Context) {
 	var movie model.MovieFragment
 
 	err := context.BindJSON(&movie)
@@ -79,7 +114,7 @@ func HandlePutMovie(context *gin.Context) {
 		return
 	}
 
-	id, err := helper.UpdateMovieFragment(movie)
+	id, err := handler.repository.UpdateMovieFragment(movie)
 
 	if err != nil {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -104,7 +139,7 @@ func HandlePutMovie(context *gin.Context) {
 	})
 }
 
-func HandlePostMovie(context *gin.Context) {
+func (handler *Handler) HandlePostMovie(context *gin.Context) {
 	idArg := context.Query("id")
 
 	if len(idArg) == 0 {
@@ -116,7 +151,7 @@ func HandlePostMovie(context *gin.Context) {
 		return
 	}
 
-	existingMovie, err := helper.FetchMovie(fmt.Sprintf("reference=%s", idArg))
+	storedMovieId, created, err := handler.repository.StoreMovie(idArg)
 
 	if err != nil {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -127,55 +162,69 @@ func HandlePostMovie(context *gin.Context) {
 		return
 	}
 
-	if existingMovie.ID != 0 {
+	if storedMovieId == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	if !created {
 		context.IndentedJSON(http.StatusOK, gin.H{
 			"status": http.StatusOK,
 			"data": map[string]any{
-				"id": existingMovie.ID,
+				"id": storedMovieId,
 			},
 		})
 
 		return
 	}
 
-	movie, err := TMDBAPI.TMDBGetMovie(idArg)
+	context.IndentedJSON(http.StatusCreated, gin.H{
+		"status": http.StatusCreated,
+		"data": map[string]any{
+			"id": storedMovieId,
+		},
+	})
+}
 
-	if err != nil {
-		context.IndentedJSON(http.StatusInternalServerError, gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": errorMessage,
-		})
+func (handler *Handler) HandleGetMovieExistenceSlice(context *gin.Context) {
+	var constraint string
 
-		return
-	}
+	if tag := context.Query("tag"); tag != "" {
+		principal, ok := middleware.Principal(context)
 
-	if movie.ID == 0 {
-		context.Status(http.StatusNoContent)
+		if !ok {
+			context.IndentedJSON(http.StatusUnauthorized, gin.H{
+				"status":  http.StatusUnauthorized,
+				"message": "Unable to filter by tag without an authenticated principal.",
+			})
 
-		return
+			return
+		}
+
+		constraint = tagHelper.Constraint("id", material.TypeMovie, principal.Subject, tag)
 	}
 
-	storedMovieId, err := helper.ProcessMovieStorage(movie)
+	if personArg := context.Query("person"); personArg != "" {
+		person, err := strconv.Atoi(personArg)
 
-	if err != nil {
-		context.IndentedJSON(http.StatusInternalServerError, gin.H{
-			"status":  http.StatusInternalServerError,
-			"message": errorMessage,
-		})
+		if err != nil || person <= 0 {
+			context.IndentedJSON(http.StatusBadRequest, gin.H{
+				"status":  http.StatusBadRequest,
+				"message": fmt.Sprintf("Invalid person identifier argument '%s' provided in query parameter 'person'.", personArg),
+			})
 
-		return
-	}
+			return
+		}
 
-	context.IndentedJSON(http.StatusCreated, gin.H{
-		"status": http.StatusCreated,
-		"data": map[string]any{
-			"id": storedMovieId,
-		},
-	})
-}
+		if constraint != "" {
+			constraint += " AND "
+		}
 
-func HandleGetMovieExistenceSlice(context *gin.Context) {
-	movieExistenceSlice, errSlice := helper.FetchMovieExistenceSlice()
+		constraint += helper.PersonConstraint("id", person)
+	}
+
+	movieExistenceSlice, errSlice := handler.repository.FetchMovieExistenceSlice(constraint)
 
 	if len(errSlice) != 0 {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -198,7 +247,7 @@ func HandleGetMovieExistenceSlice(context *gin.Context) {
 	})
 }
 
-func HandleGetMovieSearch(context *gin.Context) {
+func (handler *Handler) HandleGetMovieSearch(context *gin.Context) {
 	query := context.Query("query")
 
 	if query == "" {
@@ -210,7 +259,7 @@ func HandleGetMovieSearch(context *gin.Context) {
 		return
 	}
 
-	results, err := TMDBAPI.TMDBSearchMovie(query)
+	mappedResults, err := handler.repository.SearchMovies(query)
 
 	if err != nil {
 		context.IndentedJSON(http.StatusInternalServerError, gin.H{
@@ -221,9 +270,51 @@ func HandleGetMovieSearch(context *gin.Context) {
 		return
 	}
 
-	if len(results.Results) > 0 {
-		mappedResults := helper.MapSearchResultSlice(results.Results)
+	if len(mappedResults) > 0 {
+		context.IndentedJSON(http.StatusOK, gin.H{
+			"status": http.StatusOK,
+			"data":   mappedResults,
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
+
+func (handler *Handler) HandleGetMovieSimilar(context *gin.Context) {
+	id, err := strconv.Atoi(context.Query("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	mappedResults, exists, err := handler.repository.SimilarMovies(id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if !exists {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find movie with numeric identifier '%d'.", id),
+		})
+
+		return
+	}
 
+	if len(mappedResults) > 0 {
 		context.IndentedJSON(http.StatusOK, gin.H{
 			"status": http.StatusOK,
 			"data":   mappedResults,
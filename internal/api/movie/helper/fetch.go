@@ -2,50 +2,57 @@ package helper
 
 import (
 	"fmt"
-	"os"
+	"strings"
 
 	"github.com/muzzarellimj/grace-material-api/internal/database"
 	"github.com/muzzarellimj/grace-material-api/internal/database/service"
 	model "github.com/muzzarellimj/grace-material-api/internal/model/movie"
+	reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
 )
 
-func FetchMovie(constraint string) (model.Movie, error) {
+func (repository *Repository) FetchMovie(constraint string) (model.Movie, error) {
 	zero := model.Movie{}
 
-	movieFragment, err := service.FetchFragment[model.MovieFragment](database.Connection, database.TableMovieFragments, constraint)
+	movieFragment, err := service.FetchFragment[model.MovieFragment](repository.connection, database.TableMovieFragments, constraint)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch movie with constraint '%s': %v\n", constraint, err)
+		repository.logger.Printf("Unable to fetch movie with constraint '%s': %v", constraint, err)
 
 		return zero, err
 	}
 
-	genreFragmentSlice, err := fetchGenreFragmentSlice(movieFragment)
+	genreFragmentSlice, err := repository.fetchGenreFragmentSlice(movieFragment)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch genres related to movie '%d': %v\n", movieFragment.ID, err)
+		repository.logger.Printf("Unable to fetch genres related to movie '%d': %v", movieFragment.ID, err)
 	}
 
-	productionCompanyFragmentSlice, err := fetchProductionCompanyFragmentSlice(movieFragment)
+	productionCompanyFragmentSlice, err := repository.fetchProductionCompanyFragmentSlice(movieFragment)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch production companies related to movie '%d': %v\n", movieFragment.ID, err)
+		repository.logger.Printf("Unable to fetch production companies related to movie '%d': %v", movieFragment.ID, err)
 	}
 
-	movie := mapMovie(movieFragment, genreFragmentSlice, productionCompanyFragmentSlice)
+	castSlice, crewSlice, err := repository.fetchCreditSlice(movieFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch credits related to movie '%d': %v", movieFragment.ID, err)
+	}
+
+	movie := mapMovie(movieFragment, genreFragmentSlice, productionCompanyFragmentSlice, castSlice, crewSlice)
 
 	return movie, nil
 }
 
-func FetchMovieSlice(constraintSlice []string) ([]model.Movie, []error) {
+func (repository *Repository) FetchMovieSlice(constraintSlice []string) ([]model.Movie, []error) {
 	var movieSlice []model.Movie
 	var errorSlice []error
 
 	for _, constraint := range constraintSlice {
-		movie, err := FetchMovie(constraint)
+		movie, err := repository.FetchMovie(constraint)
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch and map movie with constraint '%s': %v\n", constraint, err)
+			repository.logger.Printf("Unable to fetch and map movie with constraint '%s': %v", constraint, err)
 
 			errorSlice = append(errorSlice, err)
 		}
@@ -58,17 +65,17 @@ func FetchMovieSlice(constraintSlice []string) ([]model.Movie, []error) {
 	return movieSlice, errorSlice
 }
 
-func FetchMovieExistenceSlice() ([]int, []error) {
-	idSlice, err := service.FetchExistenceSlice(database.Connection, database.TableMovieFragments)
+func (repository *Repository) FetchMovieExistenceSlice(constraint string) ([]int, []error) {
+	idSlice, err := service.FetchExistenceSlice(repository.connection, database.TableMovieFragments, constraint)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch existence slice: %v\n", err)
+		repository.logger.Printf("Unable to fetch existence slice: %v", err)
 
 		return []int{}, []error{err}
 	}
 
 	if len(idSlice) == 0 {
-		fmt.Fprint(os.Stderr, "Existence slice appears to be empty.")
+		repository.logger.Print("Existence slice appears to be empty.")
 
 		return []int{}, nil
 	}
@@ -76,13 +83,13 @@ func FetchMovieExistenceSlice() ([]int, []error) {
 	return idSlice, nil
 }
 
-func fetchGenreFragmentSlice(movieFragment model.MovieFragment) ([]model.MovieGenreFragment, error) {
+func (repository *Repository) fetchGenreFragmentSlice(movieFragment model.MovieFragment) ([]model.MovieGenreFragment, error) {
 	zero := []model.MovieGenreFragment{}
 
-	movieGenreRelationshipSlice, err := service.FetchRelationshipSlice[model.MovieGenreRelationship](database.Connection, database.TableMovieGenreRelationships, fmt.Sprintf("movie=%d", movieFragment.ID))
+	movieGenreRelationshipSlice, err := service.FetchRelationshipSlice[model.MovieGenreRelationship](repository.connection, database.TableMovieGenreRelationships, fmt.Sprintf("movie=%d", movieFragment.ID))
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch relationships between movie '%d' and genres: %v\n", movieFragment.ID, err)
+		repository.logger.Printf("Unable to fetch relationships between movie '%d' and genres: %v", movieFragment.ID, err)
 
 		return zero, err
 	}
@@ -90,10 +97,10 @@ func fetchGenreFragmentSlice(movieFragment model.MovieFragment) ([]model.MovieGe
 	var genreFragmentSlice []model.MovieGenreFragment
 
 	for _, relationship := range movieGenreRelationshipSlice {
-		genreFragment, err := service.FetchFragment[model.MovieGenreFragment](database.Connection, database.TableMovieGenreFragments, fmt.Sprintf("id=%d", relationship.Genre))
+		genreFragment, err := service.FetchFragment[model.MovieGenreFragment](repository.connection, database.TableMovieGenreFragments, fmt.Sprintf("id=%d", relationship.Genre))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch genre '%d': %v\n", relationship.Genre, err)
+			repository.logger.Printf("Unable to fetch genre '%d': %v", relationship.Genre, err)
 		}
 
 		if genreFragment.ID != 0 {
@@ -104,13 +111,13 @@ func fetchGenreFragmentSlice(movieFragment model.MovieFragment) ([]model.MovieGe
 	return genreFragmentSlice, nil
 }
 
-func fetchProductionCompanyFragmentSlice(movieFragment model.MovieFragment) ([]model.MovieProductionCompanyFragment, error) {
+func (repository *Repository) fetchProductionCompanyFragmentSlice(movieFragment model.MovieFragment) ([]model.MovieProductionCompanyFragment, error) {
 	zero := []model.MovieProductionCompanyFragment{}
 
-	movieProductionCompanyRelationshipSlice, err := service.FetchRelationshipSlice[model.MovieProductionCompanyRelationship](database.Connection, database.TableMovieProductionCompanyRelationships, fmt.Sprintf("movie=%d", movieFragment.ID))
+	movieProductionCompanyRelationshipSlice, err := service.FetchRelationshipSlice[model.MovieProductionCompanyRelationship](repository.connection, database.TableMovieProductionCompanyRelationships, fmt.Sprintf("movie=%d", movieFragment.ID))
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to fetch relationships between movie '%d' and production companies: %v\n", movieFragment.ID, err)
+		repository.logger.Printf("Unable to fetch relationships between movie '%d' and production companies: %v", movieFragment.ID, err)
 
 		return zero, err
 	}
@@ -118,10 +125,10 @@ func fetchProductionCompanyFragmentSlice(movieFragment model.MovieFragment) ([]m
 	var productionCompanyFragmentSlice []model.MovieProductionCompanyFragment
 
 	for _, relationship := range movieProductionCompanyRelationshipSlice {
-		productionCompanyFragment, err := service.FetchFragment[model.MovieProductionCompanyFragment](database.Connection, database.TableMovieProductionCompanyFragments, fmt.Sprintf("id=%d", relationship.ProductionCompany))
+		productionCompanyFragment, err := service.FetchFragment[model.MovieProductionCompanyFragment](repository.connection, database.TableMovieProductionCompanyFragments, fmt.Sprintf("id=%d", relationship.ProductionCompany))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch production company '%d': %v\n", relationship.ProductionCompany, err)
+			repository.logger.Printf("Unable to fetch production company '%d': %v", relationship.ProductionCompany, err)
 		}
 
 		if productionCompanyFragment.ID != 0 {
@@ -132,7 +139,7 @@ func fetchProductionCompanyFragmentSlice(movieFragment model.MovieFragment) ([]m
 	return productionCompanyFragmentSlice, nil
 }
 
-func mapMovie(movieFragment model.MovieFragment, genreFragmentSlice []model.MovieGenreFragment, productionCompanyFragmentSlice []model.MovieProductionCompanyFragment) model.Movie {
+func mapMovie(movieFragment model.MovieFragment, genreFragmentSlice []model.MovieGenreFragment, productionCompanyFragmentSlice []model.MovieProductionCompanyFragment, castSlice []model.MovieCredit, crewSlice []model.MovieCredit) model.Movie {
 	if genreFragmentSlice == nil {
 		genreFragmentSlice = make([]model.MovieGenreFragment, 0)
 	}
@@ -141,6 +148,14 @@ func mapMovie(movieFragment model.MovieFragment, genreFragmentSlice []model.Movi
 		productionCompanyFragmentSlice = make([]model.MovieProductionCompanyFragment, 0)
 	}
 
+	if castSlice == nil {
+		castSlice = make([]model.MovieCredit, 0)
+	}
+
+	if crewSlice == nil {
+		crewSlice = make([]model.MovieCredit, 0)
+	}
+
 	return model.Movie{
 		ID:                  movieFragment.ID,
 		Title:               movieFragment.Title,
@@ -148,9 +163,59 @@ func mapMovie(movieFragment model.MovieFragment, genreFragmentSlice []model.Movi
 		Description:         movieFragment.Description,
 		Genres:              genreFragmentSlice,
 		ProductionCompanies: productionCompanyFragmentSlice,
+		Cast:                castSlice,
+		Crew:                crewSlice,
 		ReleaseDate:         movieFragment.ReleaseDate,
 		Runtime:             movieFragment.Runtime,
 		Image:               movieFragment.Image,
 		Reference:           movieFragment.Reference,
 	}
 }
+
+// Fetch the rating aggregate (i.e., review count and average rating) of a movie.
+//
+// Return: rating aggregate and nil with success, empty rating aggregate and error without.
+func (repository *Repository) FetchMovieRating(id int) (reviewModel.RatingAggregate, error) {
+	statement, err := database.CreateQuery("COUNT(rating) AS count, COALESCE(AVG(rating), 0)::FLOAT8 AS average", database.TableMovieReviewFragments, fmt.Sprintf("movie=%d", id), "")
+
+	if err != nil {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch rating aggregate of movie '%d': %v", id, err)
+
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	response, err := database.MapQueryResponse[reviewModel.RatingAggregate](rows)
+
+	if err != nil || len(response) == 0 {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	return response[0], nil
+}
+
+// Fetch the references of stored movies among the provided TMDB numeric identifiers.
+func (repository *Repository) fetchStoredReferenceSlice(referenceSlice []string) ([]int, error) {
+	if len(referenceSlice) == 0 {
+		return []int{}, nil
+	}
+
+	statement, err := database.CreateQuery("reference", database.TableMovieFragments, fmt.Sprintf("reference IN (%s)", strings.Join(referenceSlice, ",")), "")
+
+	if err != nil {
+		return []int{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return []int{}, err
+	}
+
+	return database.MapQueryResponse[int](rows)
+}
@@ -28,3 +28,14 @@ func MapSearchResultSlice(input []TMDBModel.TMDBMovieSearchResult) []model.Movie
 func FormatImagePath(path string) string {
 	return fmt.Sprint("https://image.tmdb.org/t/p/original", path)
 }
+
+// Format the image path of a person, which may be absent.
+//
+// Return: formatted image path, or an empty string without an image.
+func formatPersonImagePath(path string) string {
+	if path == "" {
+		return ""
+	}
+
+	return FormatImagePath(path)
+}
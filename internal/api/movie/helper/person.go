@@ -0,0 +1,138 @@
+package helper
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/movie"
+)
+
+// Credit roles, which distinguish cast credits from crew credits.
+const (
+	RoleCast = "cast"
+	RoleCrew = "crew"
+)
+
+// The maximum billing order (exclusive) of cast members stored per movie, where 0 is top billed.
+const MaximumBilledCast = 10
+
+// The crew jobs stored per movie (i.e., directors, writers, and composers).
+var CreditedJobs = []string{"Director", "Screenplay", "Writer", "Story", "Novel", "Original Music Composer", "Music"}
+
+var ErrPersonNotFound = errors.New("person not found")
+
+// Create a constraint to filter movies by those crediting the person with the provided numeric identifier, where the
+// column holds the movie numeric identifier (e.g., "id").
+//
+// Return: constraint.
+func PersonConstraint(column string, person int) string {
+	return fmt.Sprintf("%s IN (SELECT movie FROM %s WHERE person=%d)", column, database.TableMoviePersonRelationships, person)
+}
+
+// Fetch a person with their credits in stored movies, most recently released first.
+//
+// Return: person and nil with success, empty person and ErrPersonNotFound or error without.
+func (repository *Repository) FetchPerson(id int) (model.MoviePerson, error) {
+	personFragment, err := service.FetchFragment[model.MoviePersonFragment](repository.connection, database.TableMoviePersonFragments, fmt.Sprintf("id=%d", id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch person '%d': %v", id, err)
+
+		return model.MoviePerson{}, err
+	}
+
+	if personFragment.ID == 0 {
+		return model.MoviePerson{}, ErrPersonNotFound
+	}
+
+	relationshipSlice, err := service.FetchRelationshipSlice[model.MoviePersonRelationship](repository.connection, database.TableMoviePersonRelationships, fmt.Sprintf("person=%d", id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between person '%d' and movies: %v", id, err)
+
+		return model.MoviePerson{}, err
+	}
+
+	person := model.MoviePerson{
+		ID:         personFragment.ID,
+		Name:       personFragment.Name,
+		Image:      personFragment.Image,
+		Department: personFragment.Department,
+		Reference:  personFragment.Reference,
+		Credits:    []model.PersonCredit{},
+	}
+
+	for _, relationship := range relationshipSlice {
+		movieFragment, err := service.FetchFragment[model.MovieFragment](repository.connection, database.TableMovieFragments, fmt.Sprintf("id=%d", relationship.Movie))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch movie '%d': %v", relationship.Movie, err)
+		}
+
+		if movieFragment.ID != 0 {
+			person.Credits = append(person.Credits, model.PersonCredit{
+				Movie:     movieFragment,
+				Role:      relationship.Role,
+				Job:       relationship.Job,
+				Character: relationship.Character,
+				Billing:   relationship.Billing,
+			})
+		}
+	}
+
+	slices.SortStableFunc(person.Credits, func(a model.PersonCredit, b model.PersonCredit) int {
+		return cmp.Compare(b.Movie.ReleaseDate, a.Movie.ReleaseDate)
+	})
+
+	return person, nil
+}
+
+// Fetch the cast (by billing order) and crew (by credited job order) of a movie.
+//
+// Return: cast and crew credit slices and nil with success, empty slices and error without.
+func (repository *Repository) fetchCreditSlice(movieFragment model.MovieFragment) ([]model.MovieCredit, []model.MovieCredit, error) {
+	relationshipSlice, err := service.FetchRelationshipSlice[model.MoviePersonRelationship](repository.connection, database.TableMoviePersonRelationships, fmt.Sprintf("movie=%d", movieFragment.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between movie '%d' and people: %v", movieFragment.ID, err)
+
+		return []model.MovieCredit{}, []model.MovieCredit{}, err
+	}
+
+	castSlice := []model.MovieCredit{}
+	crewSlice := []model.MovieCredit{}
+
+	for _, relationship := range relationshipSlice {
+		personFragment, err := service.FetchFragment[model.MoviePersonFragment](repository.connection, database.TableMoviePersonFragments, fmt.Sprintf("id=%d", relationship.Person))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch person '%d': %v", relationship.Person, err)
+		}
+
+		if personFragment.ID == 0 {
+			continue
+		}
+
+		credit := model.MovieCredit{Person: personFragment, Job: relationship.Job, Character: relationship.Character, Billing: relationship.Billing}
+
+		if relationship.Role == RoleCast {
+			castSlice = append(castSlice, credit)
+		} else {
+			crewSlice = append(crewSlice, credit)
+		}
+	}
+
+	slices.SortStableFunc(castSlice, func(a model.MovieCredit, b model.MovieCredit) int {
+		return cmp.Compare(a.Billing, b.Billing)
+	})
+
+	slices.SortStableFunc(crewSlice, func(a model.MovieCredit, b model.MovieCredit) int {
+		return cmp.Compare(slices.Index(CreditedJobs, a.Job), slices.Index(CreditedJobs, b.Job))
+	})
+
+	return castSlice, crewSlice, nil
+}
@@ -0,0 +1,151 @@
+package helper
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/movie"
+	TMDBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/themoviedb.org"
+)
+
+// The TMDB operations required by the movie repository, which are satisfied by a TMDB client or a fake.
+type Provider interface {
+	TMDBGetMovie(id string) (TMDBModel.TMDBMovieDetailResponse, error)
+	TMDBSearchMovie(title string) (TMDBModel.TMDBMovieSearchResponse, error)
+	TMDBGetMovieRecommendations(id string) (TMDBModel.TMDBMovieSearchResponse, error)
+}
+
+// A movie repository, which fetches, stores, and updates movies in the provided database pool with
+// metadata from the provided TMDB provider.
+type Repository struct {
+	connection database.PgxPool
+	client     Provider
+	logger     *log.Logger
+}
+
+// Create a movie repository with a database pool, TMDB provider, and logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, client Provider, logger *log.Logger) *Repository {
+	return &Repository{
+		connection: connection,
+		client:     client,
+		logger:     logger,
+	}
+}
+
+// Search TMDB movies by title, and map results to the supported search result model.
+//
+// Return: mapped search result slice and nil with success, empty slice and error without.
+func (repository *Repository) SearchMovies(query string) ([]model.MovieSearchResult, error) {
+	results, err := repository.client.TMDBSearchMovie(query)
+
+	if err != nil {
+		repository.logger.Printf("Unable to search TMDB movies with query '%s': %v", query, err)
+
+		return []model.MovieSearchResult{}, err
+	}
+
+	return MapSearchResultSlice(results.Results), nil
+}
+
+// Fetch candidates similar to a stored movie from TMDB, excluding movies already stored, and map them to the supported
+// search result model.
+//
+// Return: mapped search result slice, whether the movie exists, and nil with success; empty slice, false, and error
+// without.
+func (repository *Repository) SimilarMovies(id int) ([]model.MovieSearchResult, bool, error) {
+	movie, err := service.FetchFragment[model.MovieFragment](repository.connection, database.TableMovieFragments, fmt.Sprintf("id=%d", id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch movie '%d': %v", id, err)
+
+		return []model.MovieSearchResult{}, false, err
+	}
+
+	if movie.ID == 0 {
+		return []model.MovieSearchResult{}, false, nil
+	}
+
+	recommendations, err := repository.client.TMDBGetMovieRecommendations(strconv.Itoa(movie.Reference))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch TMDB movies similar to '%d': %v", movie.Reference, err)
+
+		return []model.MovieSearchResult{}, false, err
+	}
+
+	var referenceSlice []string
+
+	for _, result := range recommendations.Results {
+		referenceSlice = append(referenceSlice, strconv.Itoa(result.ID))
+	}
+
+	storedReferenceSlice, err := repository.fetchStoredReferenceSlice(referenceSlice)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch stored movies similar to '%d': %v", movie.Reference, err)
+
+		return []model.MovieSearchResult{}, false, err
+	}
+
+	candidateSlice := []model.MovieSearchResult{}
+
+	for _, result := range MapSearchResultSlice(recommendations.Results) {
+		if !slices.Contains(storedReferenceSlice, result.ID) {
+			candidateSlice = append(candidateSlice, result)
+		}
+	}
+
+	return candidateSlice, true, nil
+}
+
+// Store a movie with a provided TMDB numeric identifier, unless a movie with that reference already exists.
+//
+// Return: numeric identifier, whether the movie was newly stored, and nil with success; 0, false, and error without.
+// A 0 identifier without error indicates no TMDB movie matched the identifier.
+func (repository *Repository) StoreMovie(id string) (int, bool, error) {
+	reference, err := strconv.Atoi(id)
+
+	if err != nil {
+		repository.logger.Printf("Unable to parse TMDB movie identifier '%s': %v", id, err)
+
+		return 0, false, err
+	}
+
+	existingMovie, err := repository.FetchMovie(fmt.Sprintf("reference=%d", reference))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing movie '%d': %v", reference, err)
+
+		return 0, false, err
+	}
+
+	if existingMovie.ID != 0 {
+		return existingMovie.ID, false, nil
+	}
+
+	movie, err := repository.client.TMDBGetMovie(strconv.Itoa(reference))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch movie '%d' TMDB record: %v", reference, err)
+
+		return 0, false, err
+	}
+
+	if movie.ID == 0 {
+		return 0, false, nil
+	}
+
+	movieId, err := repository.ProcessMovieStorage(movie)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	return movieId, true, nil
+}
@@ -2,7 +2,7 @@ package helper
 
 import (
 	"fmt"
-	"os"
+	"slices"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/muzzarellimj/grace-material-api/internal/database"
@@ -12,37 +12,125 @@ import (
 	"github.com/muzzarellimj/grace-material-api/internal/util"
 )
 
-func ProcessMovieStorage(movie TMDBModel.TMDBMovieDetailResponse) (int, error) {
-	movieId, err := storeMovieFragment(movie)
+func (repository *Repository) ProcessMovieStorage(movie TMDBModel.TMDBMovieDetailResponse) (int, error) {
+	movieId, err := repository.storeMovieFragment(movie)
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to store movie '%d' : %v\n", movie.ID, err)
+		repository.logger.Printf("Unable to store movie '%d' : %v", movie.ID, err)
 
 		return 0, err
 	}
 
-	genreIdSlice := processGenreFragmentSlice(movie.Genres)
-	productionCompanyIdSlice := processProductionCompanyFragmentSlice(movie.ProductionCompanies)
+	genreIdSlice := repository.processGenreFragmentSlice(movie.Genres)
+	productionCompanyIdSlice := repository.processProductionCompanyFragmentSlice(movie.ProductionCompanies)
 
-	service.StoreRelationshipSlice(database.Connection, database.TableMovieGenreRelationships, database.PropertiesMovieGenreRelationships, service.RelationshipSliceArgument{
+	service.StoreRelationshipSlice(repository.connection, database.TableMovieGenreRelationships, database.PropertiesMovieGenreRelationships, service.RelationshipSliceArgument{
 		SourceName:          "movie",
 		SourceArgument:      movieId,
 		DestinationName:     "genre",
 		DestinationArgument: genreIdSlice,
 	})
 
-	service.StoreRelationshipSlice(database.Connection, database.TableMovieProductionCompanyRelationships, database.PropertiesMovieProductionCompanyRelationships, service.RelationshipSliceArgument{
+	service.StoreRelationshipSlice(repository.connection, database.TableMovieProductionCompanyRelationships, database.PropertiesMovieProductionCompanyRelationships, service.RelationshipSliceArgument{
 		SourceName:          "movie",
 		SourceArgument:      movieId,
 		DestinationName:     "production_company",
 		DestinationArgument: productionCompanyIdSlice,
 	})
 
+	repository.processCreditStorage(movieId, movie.Credits)
+
 	return movieId, nil
 }
 
-func storeMovieFragment(movie TMDBModel.TMDBMovieDetailResponse) (int, error) {
-	movieId, err := service.StoreFragment(database.Connection, database.TableMovieFragments, database.PropertiesMovieFragments, pgx.NamedArgs{
+// Store the top-billed cast (see MaximumBilledCast) and credited crew (see CreditedJobs) of a movie, with a person
+// fragment per person matched by TMDB numeric identifier.
+func (repository *Repository) processCreditStorage(movieId int, credits TMDBModel.TMDBCredits) {
+	for _, cast := range credits.Cast {
+		if cast.Order >= MaximumBilledCast {
+			continue
+		}
+
+		personId, err := repository.storePersonFragment(cast.ID, cast.Name, cast.Image, cast.Department)
+
+		if err != nil {
+			continue
+		}
+
+		repository.storeCredit(pgx.NamedArgs{
+			"movie":     movieId,
+			"person":    personId,
+			"role":      RoleCast,
+			"job":       "",
+			"character": cast.Character,
+			"billing":   cast.Order,
+		})
+	}
+
+	for _, crew := range credits.Crew {
+		if !slices.Contains(CreditedJobs, crew.Job) {
+			continue
+		}
+
+		personId, err := repository.storePersonFragment(crew.ID, crew.Name, crew.Image, crew.Department)
+
+		if err != nil {
+			continue
+		}
+
+		repository.storeCredit(pgx.NamedArgs{
+			"movie":     movieId,
+			"person":    personId,
+			"role":      RoleCrew,
+			"job":       crew.Job,
+			"character": "",
+			"billing":   0,
+		})
+	}
+}
+
+// Store a person fragment, unless a person with the same TMDB numeric identifier already exists.
+//
+// Return: numeric identifier and nil with success, 0 and error without.
+func (repository *Repository) storePersonFragment(reference int, name string, image string, department string) (int, error) {
+	existingPersonFragment, err := service.FetchFragment[model.MoviePersonFragment](repository.connection, database.TableMoviePersonFragments, fmt.Sprintf("reference=%d", reference))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing person '%d' fragment: %v", reference, err)
+
+		return 0, err
+	}
+
+	if existingPersonFragment.ID != 0 {
+		return existingPersonFragment.ID, nil
+	}
+
+	personId, err := service.StoreFragment(repository.connection, database.TableMoviePersonFragments, database.PropertiesMoviePersonFragments, pgx.NamedArgs{
+		"name":       name,
+		"image":      formatPersonImagePath(image),
+		"department": department,
+		"reference":  reference,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store new person '%d' fragment: %v", reference, err)
+
+		return 0, err
+	}
+
+	return personId, nil
+}
+
+func (repository *Repository) storeCredit(arguments pgx.NamedArgs) {
+	err := service.StoreRelationship(repository.connection, database.TableMoviePersonRelationships, database.PropertiesMoviePersonRelationships, arguments)
+
+	if err != nil {
+		repository.logger.Printf("Unable to store credit of person '%v' in movie '%v': %v", arguments["person"], arguments["movie"], err)
+	}
+}
+
+func (repository *Repository) storeMovieFragment(movie TMDBModel.TMDBMovieDetailResponse) (int, error) {
+	movieId, err := service.StoreFragment(repository.connection, database.TableMovieFragments, database.PropertiesMovieFragments, pgx.NamedArgs{
 		"title":        movie.Title,
 		"tagline":      movie.Tagline,
 		"description":  movie.Overview,
@@ -53,7 +141,7 @@ func storeMovieFragment(movie TMDBModel.TMDBMovieDetailResponse) (int, error) {
 	})
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to store movie '%d' fragment: %v\n", movie.ID, err)
+		repository.logger.Printf("Unable to store movie '%d' fragment: %v", movie.ID, err)
 
 		return 0, err
 	}
@@ -61,14 +149,14 @@ func storeMovieFragment(movie TMDBModel.TMDBMovieDetailResponse) (int, error) {
 	return movieId, nil
 }
 
-func processGenreFragmentSlice(genres []TMDBModel.TMDBGenre) []int {
+func (repository *Repository) processGenreFragmentSlice(genres []TMDBModel.TMDBGenre) []int {
 	var genreIdSlice []int
 
 	for _, genre := range genres {
-		existingGenreFragment, err := service.FetchFragment[model.MovieGenreFragment](database.Connection, database.TableMovieGenreFragments, fmt.Sprintf("reference=%d", genre.ID))
+		existingGenreFragment, err := service.FetchFragment[model.MovieGenreFragment](repository.connection, database.TableMovieGenreFragments, fmt.Sprintf("reference=%d", genre.ID))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch existing genre '%d' fragment: %v\n", genre.ID, err)
+			repository.logger.Printf("Unable to fetch existing genre '%d' fragment: %v", genre.ID, err)
 
 			continue
 		}
@@ -79,13 +167,13 @@ func processGenreFragmentSlice(genres []TMDBModel.TMDBGenre) []int {
 			continue
 		}
 
-		genreId, err := service.StoreFragment(database.Connection, database.TableMovieGenreFragments, database.PropertiesMovieGenreFragments, pgx.NamedArgs{
+		genreId, err := service.StoreFragment(repository.connection, database.TableMovieGenreFragments, database.PropertiesMovieGenreFragments, pgx.NamedArgs{
 			"name":      genre.Name,
 			"reference": genre.ID,
 		})
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to store new genre '%d' fragment: %v\n", genre.ID, err)
+			repository.logger.Printf("Unable to store new genre '%d' fragment: %v", genre.ID, err)
 		}
 
 		if genreId != 0 {
@@ -96,14 +184,14 @@ func processGenreFragmentSlice(genres []TMDBModel.TMDBGenre) []int {
 	return genreIdSlice
 }
 
-func processProductionCompanyFragmentSlice(productionCompanies []TMDBModel.TMDBProductionCompany) []int {
+func (repository *Repository) processProductionCompanyFragmentSlice(productionCompanies []TMDBModel.TMDBProductionCompany) []int {
 	var productionCompanyIdSlice []int
 
 	for _, productionCompany := range productionCompanies {
-		existingProductionCompanyFragment, err := service.FetchFragment[model.MovieProductionCompanyFragment](database.Connection, database.TableMovieProductionCompanyFragments, fmt.Sprintf("reference=%d", productionCompany.ID))
+		existingProductionCompanyFragment, err := service.FetchFragment[model.MovieProductionCompanyFragment](repository.connection, database.TableMovieProductionCompanyFragments, fmt.Sprintf("reference=%d", productionCompany.ID))
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to fetch existing production company '%d' fragment: %v\n", productionCompany.ID, err)
+			repository.logger.Printf("Unable to fetch existing production company '%d' fragment: %v", productionCompany.ID, err)
 
 			continue
 		}
@@ -114,14 +202,14 @@ func processProductionCompanyFragmentSlice(productionCompanies []TMDBModel.TMDBP
 			continue
 		}
 
-		productionCompanyId, err := service.StoreFragment(database.Connection, database.TableMovieProductionCompanyFragments, database.PropertiesMovieProductionCompanyFragments, pgx.NamedArgs{
+		productionCompanyId, err := service.StoreFragment(repository.connection, database.TableMovieProductionCompanyFragments, database.PropertiesMovieProductionCompanyFragments, pgx.NamedArgs{
 			"name":      productionCompany.Name,
 			"image":     productionCompany.Image,
 			"reference": productionCompany.ID,
 		})
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Unable to store new production company '%d' fragment: %v\n", productionCompany.ID, err)
+			repository.logger.Printf("Unable to store new production company '%d' fragment: %v", productionCompany.ID, err)
 		}
 
 		if productionCompanyId != 0 {
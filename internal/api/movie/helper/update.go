@@ -2,7 +2,6 @@ package helper
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/muzzarellimj/grace-material-api/internal/database"
@@ -10,8 +9,8 @@ import (
 	model "github.com/muzzarellimj/grace-material-api/internal/model/movie"
 )
 
-func UpdateMovieFragment(movie model.MovieFragment) (int, error) {
-	id, err := service.UpdateFragment(database.Connection, database.TableMovieFragments, database.PropertiesMovieFragments, fmt.Sprintf("id=%d", movie.ID), pgx.NamedArgs{
+func (repository *Repository) UpdateMovieFragment(movie model.MovieFragment) (int, error) {
+	id, err := service.UpdateFragment(repository.connection, database.TableMovieFragments, database.PropertiesMovieFragments, fmt.Sprintf("id=%d", movie.ID), pgx.NamedArgs{
 		"title":        movie.Title,
 		"tagline":      movie.Tagline,
 		"description":  movie.Description,
@@ -22,7 +21,7 @@ func UpdateMovieFragment(movie model.MovieFragment) (int, error) {
 	})
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to update movie '%d' fragment: %v\n", movie.ID, err)
+		repository.logger.Printf("Unable to update movie '%d' fragment: %v", movie.ID, err)
 
 		return 0, err
 	}
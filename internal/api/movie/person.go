@@ -0,0 +1,51 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/movie/helper"
+)
+
+// Handle a person request, by numeric identifier in query parameter 'id', responding with the person and their credits
+// in stored movies.
+func (handler *Handler) HandleGetPerson(context *gin.Context) {
+	id, err := strconv.Atoi(context.Query("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid person identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	person, err := handler.repository.FetchPerson(id)
+
+	if errors.Is(err, helper.ErrPersonNotFound) {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find person with numeric identifier '%d'.", id),
+		})
+
+		return
+	}
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to fetch person and map to supported data structure.",
+		})
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   person,
+	})
+}
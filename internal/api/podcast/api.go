@@ -0,0 +1,270 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/podcast/helper"
+	tagHelper "github.com/muzzarellimj/grace-material-api/internal/api/tag/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+)
+
+const errorMessage string = "Unable to fetch podcast metadata and map to supported data structure."
+
+// A podcast request handler, which holds the dependencies shared between podcast routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a podcast request handler with a podcast repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetPodcast(context *gin.Context) {
+	idArg := context.Query("id")
+
+	if len(idArg) == 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	idSlice := strings.Split(idArg, ",")
+
+	if len(idSlice) == 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	var constraintSlice []string
+
+	for _, id := range idSlice {
+		constraintSlice = append(constraintSlice, fmt.Sprintf("id=%s", id))
+	}
+
+	podcastSlice, errorSlice := handler.repository.FetchPodcastSlice(constraintSlice)
+
+	if len(errorSlice) != 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(podcastSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	if slices.Contains(strings.Split(context.Query("include"), ","), "rating") {
+		for index := range podcastSlice {
+			rating, err := handler.repository.FetchPodcastRating(podcastSlice[index].ID)
+
+			if err != nil {
+				context.IndentedJSON(http.StatusInternalServerError, gin.H{
+					"status":  http.StatusInternalServerError,
+					"message": errorMessage,
+				})
+
+				return
+			}
+
+			podcastSlice[index].Rating = &rating
+		}
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   podcastSlice,
+	})
+}
+
+func (handler *Handler) HandlePutPodcast(context *gin.Context) {
+	var podcast model.PodcastFragment
+
+	err := context.BindJSON(&podcast)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to podcast model.",
+		})
+
+		return
+	}
+
+	id, err := handler.repository.UpdatePodcastFragment(podcast)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to update podcast fragment.",
+		})
+
+		return
+	}
+
+	if id == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data": map[string]any{
+			"id": id,
+		},
+	})
+}
+
+// Handle a podcast storage (i.e., subscription) request, by RSS or Atom feed URL in query parameter 'feed'.
+func (handler *Handler) HandlePostPodcast(context *gin.Context) {
+	feedArg := context.Query("feed")
+
+	if len(feedArg) == 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid feed URL argument '%s' provided in query parameter 'feed'.", context.Query("feed")),
+		})
+
+		return
+	}
+
+	storedPodcastId, created, err := handler.repository.StorePodcast(feedArg)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if storedPodcastId == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	if !created {
+		context.IndentedJSON(http.StatusOK, gin.H{
+			"status": http.StatusOK,
+			"data": map[string]any{
+				"id": storedPodcastId,
+			},
+		})
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusCreated, gin.H{
+		"status": http.StatusCreated,
+		"data": map[string]any{
+			"id": storedPodcastId,
+		},
+	})
+}
+
+// Handle a podcast refresh request, by podcast numeric identifier in query parameter 'id', responding with the count
+// of new episodes stored from its feed.
+func (handler *Handler) HandlePostPodcastRefresh(context *gin.Context) {
+	id, err := strconv.Atoi(context.Query("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	count, found, err := handler.repository.RefreshPodcast(id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to refresh podcast from its feed.",
+		})
+
+		return
+	}
+
+	if !found {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data": map[string]any{
+			"id":       id,
+			"episodes": count,
+		},
+	})
+}
+
+func (handler *Handler) HandleGetPodcastExistenceSlice(context *gin.Context) {
+	var constraint string
+
+	if tag := context.Query("tag"); tag != "" {
+		principal, ok := middleware.Principal(context)
+
+		if !ok {
+			context.IndentedJSON(http.StatusUnauthorized, gin.H{
+				"status":  http.StatusUnauthorized,
+				"message": "Unable to filter by tag without an authenticated principal.",
+			})
+
+			return
+		}
+
+		constraint = tagHelper.Constraint("id", material.TypePodcast, principal.Subject, tag)
+	}
+
+	podcastExistenceSlice, errSlice := handler.repository.FetchPodcastExistenceSlice(constraint)
+
+	if len(errSlice) != 0 {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(podcastExistenceSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   podcastExistenceSlice,
+	})
+}
@@ -0,0 +1,186 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+	reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+)
+
+func (repository *Repository) FetchPodcast(constraint string) (model.Podcast, error) {
+	zero := model.Podcast{}
+
+	podcastFragment, err := service.FetchFragment[model.PodcastFragment](repository.connection, database.TablePodcastFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch podcast with constraint '%s': %v", constraint, err)
+
+		return zero, err
+	}
+
+	if podcastFragment.ID == 0 {
+		return zero, nil
+	}
+
+	categoryFragmentSlice, err := repository.fetchCategoryFragmentSlice(podcastFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch categories related to podcast '%d': %v", podcastFragment.ID, err)
+	}
+
+	episodeFragmentSlice, err := repository.FetchEpisodeFragmentSlice(podcastFragment.ID)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch episodes of podcast '%d': %v", podcastFragment.ID, err)
+	}
+
+	podcast := mapPodcast(podcastFragment, categoryFragmentSlice, episodeFragmentSlice)
+
+	return podcast, nil
+}
+
+func (repository *Repository) FetchPodcastSlice(constraintSlice []string) ([]model.Podcast, []error) {
+	var podcastSlice []model.Podcast
+	var errorSlice []error
+
+	for _, constraint := range constraintSlice {
+		podcast, err := repository.FetchPodcast(constraint)
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch and map podcast with constraint '%s': %v", constraint, err)
+
+			errorSlice = append(errorSlice, err)
+		}
+
+		if podcast.ID != 0 {
+			podcastSlice = append(podcastSlice, podcast)
+		}
+	}
+
+	return podcastSlice, errorSlice
+}
+
+func (repository *Repository) FetchPodcastExistenceSlice(constraint string) ([]int, []error) {
+	idSlice, err := service.FetchExistenceSlice(repository.connection, database.TablePodcastFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence slice: %v", err)
+
+		return []int{}, []error{err}
+	}
+
+	if len(idSlice) == 0 {
+		repository.logger.Print("Existence slice appears to be empty.")
+
+		return []int{}, nil
+	}
+
+	return idSlice, nil
+}
+
+// Fetch the episodes of a podcast, newest first.
+//
+// Return: episode fragment slice and nil with success, empty slice and error without.
+func (repository *Repository) FetchEpisodeFragmentSlice(podcast int) ([]model.PodcastEpisodeFragment, error) {
+	episodeFragmentSlice, err := service.FetchFragmentSlice[model.PodcastEpisodeFragment](repository.connection, database.TablePodcastEpisodeFragments, fmt.Sprintf("podcast=%d", podcast))
+
+	if err != nil {
+		return []model.PodcastEpisodeFragment{}, err
+	}
+
+	slices.SortStableFunc(episodeFragmentSlice, func(a model.PodcastEpisodeFragment, b model.PodcastEpisodeFragment) int {
+		if a.ReleaseDate != b.ReleaseDate {
+			return cmp.Compare(b.ReleaseDate, a.ReleaseDate)
+		}
+
+		return cmp.Compare(b.ID, a.ID)
+	})
+
+	return episodeFragmentSlice, nil
+}
+
+func (repository *Repository) fetchCategoryFragmentSlice(podcastFragment model.PodcastFragment) ([]model.PodcastCategoryFragment, error) {
+	zero := []model.PodcastCategoryFragment{}
+
+	podcastCategoryRelationshipSlice, err := service.FetchRelationshipSlice[model.PodcastCategoryRelationship](repository.connection, database.TablePodcastCategoryRelationships, fmt.Sprintf("podcast=%d", podcastFragment.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between podcast '%d' and categories: %v", podcastFragment.ID, err)
+
+		return zero, err
+	}
+
+	var categoryFragmentSlice []model.PodcastCategoryFragment
+
+	for _, relationship := range podcastCategoryRelationshipSlice {
+		categoryFragment, err := service.FetchFragment[model.PodcastCategoryFragment](repository.connection, database.TablePodcastCategoryFragments, fmt.Sprintf("id=%d", relationship.Category))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch category '%d': %v", relationship.Category, err)
+		}
+
+		if categoryFragment.ID != 0 {
+			categoryFragmentSlice = append(categoryFragmentSlice, categoryFragment)
+		}
+	}
+
+	return categoryFragmentSlice, nil
+}
+
+func mapPodcast(podcastFragment model.PodcastFragment, categoryFragmentSlice []model.PodcastCategoryFragment, episodeFragmentSlice []model.PodcastEpisodeFragment) model.Podcast {
+	if categoryFragmentSlice == nil {
+		categoryFragmentSlice = make([]model.PodcastCategoryFragment, 0)
+	}
+
+	if episodeFragmentSlice == nil {
+		episodeFragmentSlice = make([]model.PodcastEpisodeFragment, 0)
+	}
+
+	return model.Podcast{
+		ID:               podcastFragment.ID,
+		Title:            podcastFragment.Title,
+		Author:           podcastFragment.Author,
+		Description:      podcastFragment.Description,
+		Categories:       categoryFragmentSlice,
+		Language:         podcastFragment.Language,
+		Explicit:         podcastFragment.Explicit,
+		FirstReleaseDate: podcastFragment.FirstReleaseDate,
+		LastReleaseDate:  podcastFragment.LastReleaseDate,
+		Episodes:         episodeFragmentSlice,
+		Image:            podcastFragment.Image,
+		Link:             podcastFragment.Link,
+		Reference:        podcastFragment.Reference,
+		DateRefreshed:    podcastFragment.DateRefreshed,
+	}
+}
+
+// Fetch the rating aggregate (i.e., review count and average rating) of a podcast.
+//
+// Return: rating aggregate and nil with success, empty rating aggregate and error without.
+func (repository *Repository) FetchPodcastRating(id int) (reviewModel.RatingAggregate, error) {
+	statement, err := database.CreateQuery("COUNT(rating) AS count, COALESCE(AVG(rating), 0)::FLOAT8 AS average", database.TablePodcastReviewFragments, fmt.Sprintf("podcast=%d", id), "")
+
+	if err != nil {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch rating aggregate of podcast '%d': %v", id, err)
+
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	response, err := database.MapQueryResponse[reviewModel.RatingAggregate](rows)
+
+	if err != nil || len(response) == 0 {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	return response[0], nil
+}
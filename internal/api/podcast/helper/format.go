@@ -0,0 +1,296 @@
+package helper
+
+import (
+	"html"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	model "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+	FeedModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/feed"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+var (
+	markupPattern     = regexp.MustCompile(`<[^>]*>`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// Release date layouts of RSS ('pubDate', which is RFC 822 in principle but often varies in practice) and Atom
+// ('published' and 'updated', which are RFC 3339).
+var releaseDateLayoutSlice = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"Mon, 02 Jan 2006 15:04 -0700",
+	"02 Jan 2006 15:04:05 -0700",
+	"2 Jan 2006 15:04:05 -0700",
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+}
+
+// Map a decoded RSS or Atom feed document, fetched from the provided feed URL, to a podcast with its episodes and
+// category names, where the podcast's episode count and release dates are derived from its distinct episodes.
+//
+// Return: parsed podcast feed.
+func MapFeed(document FeedModel.FeedDocument, feedURL string) model.PodcastFeed {
+	var feed model.PodcastFeed
+
+	if document.XMLName.Local == "feed" {
+		feed = mapAtomFeed(document)
+	} else {
+		feed = mapRSSFeed(document.Channel)
+	}
+
+	feed.Podcast.Reference = feedURL
+
+	// Feeds occasionally repeat an item (e.g., a re-published episode with the same '<guid>'), which is kept once.
+	var episodeSlice []model.PodcastEpisodeFragment
+
+	for _, episode := range feed.Episodes {
+		duplicate := slices.ContainsFunc(episodeSlice, func(existing model.PodcastEpisodeFragment) bool {
+			return existing.Reference == episode.Reference
+		})
+
+		if duplicate {
+			continue
+		}
+
+		episodeSlice = append(episodeSlice, episode)
+		feed.Podcast = IncludeEpisode(feed.Podcast, episode)
+	}
+
+	feed.Episodes = episodeSlice
+
+	return feed
+}
+
+// Include an episode in the episode count and the first and last release dates of a podcast.
+//
+// Return: podcast fragment including the episode.
+func IncludeEpisode(podcast model.PodcastFragment, episode model.PodcastEpisodeFragment) model.PodcastFragment {
+	podcast.Episodes++
+
+	if episode.ReleaseDate == 0 {
+		return podcast
+	}
+
+	if podcast.FirstReleaseDate == 0 || episode.ReleaseDate < podcast.FirstReleaseDate {
+		podcast.FirstReleaseDate = episode.ReleaseDate
+	}
+
+	if episode.ReleaseDate > podcast.LastReleaseDate {
+		podcast.LastReleaseDate = episode.ReleaseDate
+	}
+
+	return podcast
+}
+
+func mapRSSFeed(channel FeedModel.RSSChannel) model.PodcastFeed {
+	feed := model.PodcastFeed{
+		Podcast: model.PodcastFragment{
+			Title:       strings.TrimSpace(channel.Title),
+			Author:      strings.TrimSpace(channel.ITunesAuthor),
+			Description: FormatDescription(firstNonEmpty(channel.Description, channel.ITunesSummary)),
+			Language:    strings.TrimSpace(channel.Language),
+			Explicit:    ParseExplicit(channel.ITunesExplicit),
+			Image:       firstNonEmpty(channel.ITunesImage.Href, channel.Image.URL),
+			Link:        strings.TrimSpace(channel.Link),
+		},
+	}
+
+	for _, item := range channel.Items {
+		reference := firstNonEmpty(item.GUID, item.Enclosure.URL, item.Link, item.Title)
+
+		if reference == "" {
+			continue
+		}
+
+		feed.Episodes = append(feed.Episodes, model.PodcastEpisodeFragment{
+			Title:       firstNonEmpty(item.ITunesTitle, item.Title),
+			Description: FormatDescription(firstNonEmpty(item.Description, item.ITunesSummary)),
+			ReleaseDate: ParseReleaseDate(item.PubDate),
+			Duration:    ParseDuration(item.ITunesDuration),
+			Season:      parseNumber(item.ITunesSeason),
+			Number:      parseNumber(item.ITunesEpisode),
+			Audio:       strings.TrimSpace(item.Enclosure.URL),
+			Image:       strings.TrimSpace(item.ITunesImage.Href),
+			Reference:   reference,
+		})
+	}
+
+	var categorySlice []string
+
+	for _, category := range channel.ITunesCategories {
+		categorySlice = append(categorySlice, category.Text)
+
+		for _, subcategory := range category.Subcategories {
+			categorySlice = append(categorySlice, subcategory.Text)
+		}
+	}
+
+	feed.Categories = FormatCategorySlice(append(categorySlice, channel.Categories...))
+
+	return feed
+}
+
+func mapAtomFeed(document FeedModel.FeedDocument) model.PodcastFeed {
+	feed := model.PodcastFeed{
+		Podcast: model.PodcastFragment{
+			Title:       strings.TrimSpace(document.Title),
+			Description: FormatDescription(document.Subtitle),
+			Image:       firstNonEmpty(document.Logo, document.Icon),
+			Link:        findAtomLink(document.Links, "alternate"),
+		},
+	}
+
+	if len(document.Authors) != 0 {
+		feed.Podcast.Author = strings.TrimSpace(document.Authors[0].Name)
+	}
+
+	for _, entry := range document.Entries {
+		audio := findAtomLink(entry.Links, "enclosure")
+		reference := firstNonEmpty(entry.ID, audio, entry.Title)
+
+		if reference == "" {
+			continue
+		}
+
+		feed.Episodes = append(feed.Episodes, model.PodcastEpisodeFragment{
+			Title:       strings.TrimSpace(entry.Title),
+			Description: FormatDescription(firstNonEmpty(entry.Summary, entry.Content)),
+			ReleaseDate: ParseReleaseDate(firstNonEmpty(entry.Published, entry.Updated)),
+			Audio:       audio,
+			Reference:   reference,
+		})
+	}
+
+	var categorySlice []string
+
+	for _, category := range document.Categories {
+		categorySlice = append(categorySlice, firstNonEmpty(category.Label, category.Term))
+	}
+
+	feed.Categories = FormatCategorySlice(categorySlice)
+
+	return feed
+}
+
+// Format the description of a podcast or episode, which is often HTML, with its markup removed.
+//
+// Return: formatted description, or an empty string without a description.
+func FormatDescription(description string) string {
+	description = markupPattern.ReplaceAllString(description, " ")
+	description = html.UnescapeString(description)
+
+	return strings.TrimSpace(whitespacePattern.ReplaceAllString(description, " "))
+}
+
+// Format category names, which are trimmed and deduplicated regardless of case, keeping the first spelling.
+//
+// Return: category name slice, which is empty without categories.
+func FormatCategorySlice(names []string) []string {
+	var categorySlice []string
+
+	for _, name := range names {
+		name = strings.TrimSpace(html.UnescapeString(name))
+
+		if name == "" {
+			continue
+		}
+
+		duplicate := slices.ContainsFunc(categorySlice, func(category string) bool {
+			return strings.EqualFold(category, name)
+		})
+
+		if !duplicate {
+			categorySlice = append(categorySlice, name)
+		}
+	}
+
+	return categorySlice
+}
+
+// Parse the duration of an episode, which iTunes allows as seconds (e.g., '3723') or as '[HH:]MM:SS' (e.g., '1:02:03').
+//
+// Return: duration in seconds, or 0 without a valid duration.
+func ParseDuration(value string) int {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return 0
+	}
+
+	var seconds float64
+
+	for _, part := range strings.Split(value, ":") {
+		parsed, err := strconv.ParseFloat(part, 64)
+
+		if err != nil || parsed < 0 {
+			return 0
+		}
+
+		seconds = seconds*60 + parsed
+	}
+
+	return int(seconds)
+}
+
+// Parse the release date of an episode in any of the RSS or Atom date layouts.
+//
+// Return: Unix timestamp, or 0 without a valid date.
+func ParseReleaseDate(value string) int64 {
+	value = strings.TrimSpace(value)
+
+	for _, layout := range releaseDateLayoutSlice {
+		parsed, err := time.Parse(layout, value)
+
+		if err == nil {
+			return parsed.Unix()
+		}
+	}
+
+	return util.ParseDateTime(value)
+}
+
+// Parse the explicit flag of an iTunes podcast, which may be 'true', 'yes', or 'explicit' (and 'false', 'no', or
+// 'clean' otherwise).
+//
+// Return: true if explicit, false if not.
+func ParseExplicit(value string) bool {
+	return slices.Contains([]string{"true", "yes", "explicit"}, strings.ToLower(strings.TrimSpace(value)))
+}
+
+func parseNumber(value string) int {
+	parsed, err := strconv.Atoi(strings.TrimSpace(value))
+
+	if err != nil || parsed < 0 {
+		return 0
+	}
+
+	return parsed
+}
+
+// Find the URL of the first Atom link with a relation, where a link without a relation is an 'alternate' link.
+func findAtomLink(links []FeedModel.AtomLink, rel string) string {
+	for _, link := range links {
+		if link.Rel == rel || (link.Rel == "" && rel == "alternate") {
+			return strings.TrimSpace(link.Href)
+		}
+	}
+
+	return ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
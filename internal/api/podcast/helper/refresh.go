@@ -0,0 +1,139 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+)
+
+// Refresh a stored podcast with a provided numeric identifier from its feed, storing episodes not yet stored, where
+// other podcast metadata (e.g., a corrected title) is left as stored.
+//
+// Return: count of new episodes, whether the podcast exists, and nil with success; 0, false, and error without.
+func (repository *Repository) RefreshPodcast(id int) (int, bool, error) {
+	podcastFragment, err := service.FetchFragment[model.PodcastFragment](repository.connection, database.TablePodcastFragments, fmt.Sprintf("id=%d", id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch podcast '%d': %v", id, err)
+
+		return 0, false, err
+	}
+
+	if podcastFragment.ID == 0 {
+		return 0, false, nil
+	}
+
+	count, err := repository.refreshPodcastFragment(podcastFragment)
+
+	if err != nil {
+		return 0, true, err
+	}
+
+	return count, true, nil
+}
+
+// Refresh every stored podcast from its feed, where a podcast that fails to refresh does not stop the others.
+//
+// Return: count of new episodes across podcasts and a slice of errors encountered, which is empty with success.
+func (repository *Repository) RefreshPodcastSlice() (int, []error) {
+	podcastFragmentSlice, err := service.FetchFragmentSlice[model.PodcastFragment](repository.connection, database.TablePodcastFragments, "")
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch podcasts to refresh: %v", err)
+
+		return 0, []error{err}
+	}
+
+	var total int
+	var errorSlice []error
+
+	for _, podcastFragment := range podcastFragmentSlice {
+		count, err := repository.refreshPodcastFragment(podcastFragment)
+
+		if err != nil {
+			errorSlice = append(errorSlice, err)
+
+			continue
+		}
+
+		total += count
+	}
+
+	return total, errorSlice
+}
+
+// Refresh every stored podcast at the provided interval until the context is cancelled, which blocks and so is
+// expected to run in its own goroutine.
+func (repository *Repository) ScheduleRefresh(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, errorSlice := repository.RefreshPodcastSlice()
+
+			repository.logger.Printf("Refreshed podcasts with %d new episodes and %d errors.", count, len(errorSlice))
+		}
+	}
+}
+
+func (repository *Repository) refreshPodcastFragment(podcast model.PodcastFragment) (int, error) {
+	document, err := repository.client.FeedGetDocument(podcast.Reference)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch podcast '%d' feed '%s': %v", podcast.ID, podcast.Reference, err)
+
+		return 0, err
+	}
+
+	feed := MapFeed(document, podcast.Reference)
+
+	existingEpisodeSlice, err := service.FetchFragmentSlice[model.PodcastEpisodeFragment](repository.connection, database.TablePodcastEpisodeFragments, fmt.Sprintf("podcast=%d", podcast.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing episodes of podcast '%d': %v", podcast.ID, err)
+
+		return 0, err
+	}
+
+	referenceSet := make(map[string]bool, len(existingEpisodeSlice))
+
+	for _, episode := range existingEpisodeSlice {
+		referenceSet[episode.Reference] = true
+	}
+
+	var count int
+
+	for _, episode := range feed.Episodes {
+		if referenceSet[episode.Reference] {
+			continue
+		}
+
+		err := repository.storeEpisodeFragment(podcast.ID, episode)
+
+		if err != nil {
+			continue
+		}
+
+		podcast = IncludeEpisode(podcast, episode)
+		count++
+	}
+
+	podcast.DateRefreshed = time.Now().Unix()
+
+	_, err = repository.UpdatePodcastFragment(podcast)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,80 @@
+package helper
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+	FeedModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/feed"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+// The feed operations required by the podcast repository, which are satisfied by a feed client or a fake.
+type Provider interface {
+	FeedGetDocument(feedURL string) (FeedModel.FeedDocument, error)
+}
+
+// A podcast repository, which fetches, stores, updates, and refreshes podcasts, with their episodes, in the provided
+// database pool with metadata from the provided feed provider.
+type Repository struct {
+	connection database.PgxPool
+	client     Provider
+	logger     *log.Logger
+}
+
+// Create a podcast repository with a database pool, feed provider, and logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, client Provider, logger *log.Logger) *Repository {
+	return &Repository{
+		connection: connection,
+		client:     client,
+		logger:     logger,
+	}
+}
+
+// Store a podcast (i.e., subscribe to it) with a provided RSS or Atom feed URL, with its episodes and categories,
+// unless a podcast with that feed URL already exists.
+//
+// Return: numeric identifier, whether the podcast was newly stored, and nil with success; 0, false, and error without.
+// A 0 identifier without error indicates no podcast feed was found at the URL.
+func (repository *Repository) StorePodcast(feedURL string) (int, bool, error) {
+	feedURL = strings.TrimSpace(feedURL)
+
+	existingPodcast, err := service.FetchFragment[model.PodcastFragment](repository.connection, database.TablePodcastFragments, fmt.Sprintf("reference='%s'", util.FormatPSQLString(feedURL)))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing podcast '%s': %v", feedURL, err)
+
+		return 0, false, err
+	}
+
+	if existingPodcast.ID != 0 {
+		return existingPodcast.ID, false, nil
+	}
+
+	document, err := repository.client.FeedGetDocument(feedURL)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch podcast feed '%s': %v", feedURL, err)
+
+		return 0, false, err
+	}
+
+	feed := MapFeed(document, feedURL)
+
+	if feed.Podcast.Title == "" {
+		return 0, false, nil
+	}
+
+	podcastId, err := repository.ProcessPodcastStorage(feed)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	return podcastId, true, nil
+}
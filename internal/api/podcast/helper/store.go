@@ -0,0 +1,115 @@
+package helper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+// Store a parsed podcast feed with its episodes and categories, where the podcast is marked as refreshed now.
+//
+// Return: podcast numeric identifier and nil with success, 0 and error without.
+func (repository *Repository) ProcessPodcastStorage(feed model.PodcastFeed) (int, error) {
+	podcast := feed.Podcast
+	podcast.DateRefreshed = time.Now().Unix()
+
+	podcastId, err := service.StoreFragment(repository.connection, database.TablePodcastFragments, database.PropertiesPodcastFragments, podcastArgs(podcast))
+
+	if err != nil {
+		repository.logger.Printf("Unable to store podcast '%s' fragment: %v", podcast.Reference, err)
+
+		return 0, err
+	}
+
+	for _, episode := range feed.Episodes {
+		repository.storeEpisodeFragment(podcastId, episode)
+	}
+
+	categoryIdSlice := repository.processCategoryFragmentSlice(feed.Categories)
+
+	service.StoreRelationshipSlice(repository.connection, database.TablePodcastCategoryRelationships, database.PropertiesPodcastCategoryRelationships, service.RelationshipSliceArgument{
+		SourceName:          "podcast",
+		SourceArgument:      podcastId,
+		DestinationName:     "category",
+		DestinationArgument: categoryIdSlice,
+	})
+
+	return podcastId, nil
+}
+
+func (repository *Repository) storeEpisodeFragment(podcastId int, episode model.PodcastEpisodeFragment) error {
+	_, err := service.StoreFragment(repository.connection, database.TablePodcastEpisodeFragments, database.PropertiesPodcastEpisodeFragments, pgx.NamedArgs{
+		"podcast":      podcastId,
+		"title":        episode.Title,
+		"description":  episode.Description,
+		"release_date": episode.ReleaseDate,
+		"duration":     episode.Duration,
+		"season":       episode.Season,
+		"number":       episode.Number,
+		"audio":        episode.Audio,
+		"image":        episode.Image,
+		"reference":    episode.Reference,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store episode '%s' of podcast '%d' fragment: %v", episode.Reference, podcastId, err)
+	}
+
+	return err
+}
+
+func (repository *Repository) processCategoryFragmentSlice(names []string) []int {
+	var categoryIdSlice []int
+
+	for _, name := range names {
+		existingCategoryFragment, err := service.FetchFragment[model.PodcastCategoryFragment](repository.connection, database.TablePodcastCategoryFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(name)))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch existing category '%s' fragment: %v", name, err)
+
+			continue
+		}
+
+		if existingCategoryFragment.ID != 0 {
+			categoryIdSlice = append(categoryIdSlice, existingCategoryFragment.ID)
+
+			continue
+		}
+
+		categoryId, err := service.StoreFragment(repository.connection, database.TablePodcastCategoryFragments, database.PropertiesPodcastCategoryFragments, pgx.NamedArgs{
+			"name": name,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store new category '%s' fragment: %v", name, err)
+		}
+
+		if categoryId != 0 {
+			categoryIdSlice = append(categoryIdSlice, categoryId)
+		}
+	}
+
+	return categoryIdSlice
+}
+
+func podcastArgs(podcast model.PodcastFragment) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"title":              podcast.Title,
+		"author":             podcast.Author,
+		"description":        podcast.Description,
+		"language":           podcast.Language,
+		"explicit":           podcast.Explicit,
+		"first_release_date": podcast.FirstReleaseDate,
+		"last_release_date":  podcast.LastReleaseDate,
+		"episodes":           podcast.Episodes,
+		"image":              podcast.Image,
+		"link":               podcast.Link,
+		"reference":          podcast.Reference,
+		"date_refreshed":     podcast.DateRefreshed,
+	}
+}
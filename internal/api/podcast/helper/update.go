@@ -0,0 +1,21 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+)
+
+func (repository *Repository) UpdatePodcastFragment(podcast model.PodcastFragment) (int, error) {
+	id, err := service.UpdateFragment(repository.connection, database.TablePodcastFragments, database.PropertiesPodcastFragments, fmt.Sprintf("id=%d", podcast.ID), podcastArgs(podcast))
+
+	if err != nil {
+		repository.logger.Printf("Unable to update podcast '%d' fragment: %v", podcast.ID, err)
+
+		return 0, err
+	}
+
+	return id, nil
+}
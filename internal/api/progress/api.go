@@ -0,0 +1,229 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/progress/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/progress"
+)
+
+const errorMessage string = "Unable to fetch progress and map to supported data structure."
+
+// A progress request handler, which holds the dependencies shared between progress routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a progress request handler with a progress repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetProgressFeed(context *gin.Context) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to fetch progress without an authenticated principal.",
+		})
+
+		return
+	}
+
+	feed, err := handler.repository.FetchProgressFeed(principal.Subject)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(feed) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   feed,
+	})
+}
+
+func (handler *Handler) HandleGetProgressHistory(context *gin.Context) {
+	principal, materialType, id, ok := handler.bindMaterialRequest(context, "id")
+
+	if !ok {
+		return
+	}
+
+	history, err := handler.repository.FetchProgressHistory(principal, materialType, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(history) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   history,
+	})
+}
+
+func (handler *Handler) HandlePostProgress(context *gin.Context) {
+	principal, materialType, id, ok := handler.bindMaterialRequest(context, "id")
+
+	if !ok {
+		return
+	}
+
+	var update model.ProgressUpdate
+
+	err := context.BindJSON(&update)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to progress model.",
+		})
+
+		return
+	}
+
+	if statuses := handler.repository.Statuses(materialType); !slices.Contains(statuses, update.Status) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid %s status '%s' provided; expected one of '%s'.", materialType, update.Status, strings.Join(statuses, "', '")),
+		})
+
+		return
+	}
+
+	entry, err := handler.repository.RecordProgress(principal, materialType, id, update)
+
+	if errors.Is(err, helper.ErrInvalidProgress) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid progress '%d' provided for %s '%d': %v.", update.Progress, materialType, id, err),
+		})
+
+		return
+	}
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to record progress.",
+		})
+
+		return
+	}
+
+	if entry.ID == 0 {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find %s with numeric identifier '%d'.", materialType, id),
+		})
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusCreated, gin.H{
+		"status": http.StatusCreated,
+		"data":   entry,
+	})
+}
+
+func (handler *Handler) HandleDeleteProgress(context *gin.Context) {
+	principal, materialType, entry, ok := handler.bindMaterialRequest(context, "entry")
+
+	if !ok {
+		return
+	}
+
+	deleted, err := handler.repository.DeleteProgressEntry(principal, materialType, entry)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to delete progress entry.",
+		})
+
+		return
+	}
+
+	if !deleted {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find %s progress entry with numeric identifier '%d'.", materialType, entry),
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
+
+// Bind the principal subject, material type route parameter, and a numeric identifier query parameter shared by
+// progress routes, responding with an error when any is missing or invalid.
+func (handler *Handler) bindMaterialRequest(context *gin.Context, param string) (string, string, int, bool) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to access progress without an authenticated principal.",
+		})
+
+		return "", "", 0, false
+	}
+
+	materialType := context.Param("type")
+
+	if !handler.repository.Supports(materialType) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material type argument '%s' provided in route parameter 'type'.", materialType),
+		})
+
+		return "", "", 0, false
+	}
+
+	id, err := strconv.Atoi(context.Query(param))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid numeric identifier argument '%s' provided in query parameter '%s'.", context.Query(param), param),
+		})
+
+		return "", "", 0, false
+	}
+
+	return principal.Subject, materialType, id, true
+}
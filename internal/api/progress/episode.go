@@ -0,0 +1,143 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/progress"
+)
+
+// Handle an episode progress request, by show numeric identifier in query parameter 'id', responding with the watched
+// episodes of the show in season and episode order.
+func (handler *Handler) HandleGetEpisodeProgress(context *gin.Context) {
+	principal, id, ok := handler.bindEpisodeRequest(context, "id")
+
+	if !ok {
+		return
+	}
+
+	entrySlice, err := handler.repository.FetchEpisodeProgress(principal, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(entrySlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   entrySlice,
+	})
+}
+
+// Handle an episode watch, by episode numeric identifier in query parameter 'id' and an optional body holding the date
+// watched, responding with the resulting progress entry of the show.
+func (handler *Handler) HandlePostEpisodeProgress(context *gin.Context) {
+	principal, id, ok := handler.bindEpisodeRequest(context, "id")
+
+	if !ok {
+		return
+	}
+
+	var update model.EpisodeProgressUpdate
+
+	if context.Request.ContentLength > 0 {
+		err := context.BindJSON(&update)
+
+		if err != nil {
+			context.IndentedJSON(http.StatusBadRequest, gin.H{
+				"status":  http.StatusBadRequest,
+				"message": "Unable to bind request JSON body to episode progress model.",
+			})
+
+			return
+		}
+	}
+
+	entry, err := handler.repository.RecordEpisodeProgress(principal, id, update)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to record episode progress.",
+		})
+
+		return
+	}
+
+	if entry.ID == 0 {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find episode with numeric identifier '%d'.", id),
+		})
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusCreated, gin.H{
+		"status": http.StatusCreated,
+		"data":   entry,
+	})
+}
+
+func (handler *Handler) HandleDeleteEpisodeProgress(context *gin.Context) {
+	principal, id, ok := handler.bindEpisodeRequest(context, "id")
+
+	if !ok {
+		return
+	}
+
+	deleted, err := handler.repository.DeleteEpisodeProgress(principal, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to delete episode progress.",
+		})
+
+		return
+	}
+
+	if !deleted {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find progress of episode with numeric identifier '%d'.", id),
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
+
+// Bind a material request (see bindMaterialRequest) of a material type with episodes (i.e., shows), responding with
+// an error for any other type.
+func (handler *Handler) bindEpisodeRequest(context *gin.Context, param string) (string, int, bool) {
+	principal, materialType, id, ok := handler.bindMaterialRequest(context, param)
+
+	if !ok {
+		return "", 0, false
+	}
+
+	if materialType != material.TypeShow {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material type argument '%s' provided in route parameter 'type'; only '%s' has episodes.", materialType, material.TypeShow),
+		})
+
+		return "", 0, false
+	}
+
+	return principal, id, true
+}
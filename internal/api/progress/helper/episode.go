@@ -0,0 +1,218 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/progress"
+)
+
+// Record an episode of a show as watched by the user with the provided reference, where the date defaults to now, and
+// record the progress of the show as the number of distinct episodes watched, which is finished (i.e., "watched") once
+// every episode of the show is watched.
+//
+// Return: stored show progress entry and nil with success, empty progress entry and error without. An empty progress
+// entry without error indicates no episode matched the identifier.
+func (repository *Repository) RecordEpisodeProgress(reference string, episode int, update model.EpisodeProgressUpdate) (model.ProgressEntry, error) {
+	trackable, _ := lookup(material.TypeShow)
+
+	show, err := repository.fetchEpisodeShow(episode)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch show of episode '%d' to record progress: %v", episode, err)
+
+		return model.ProgressEntry{}, err
+	}
+
+	if show == 0 {
+		return model.ProgressEntry{}, nil
+	}
+
+	entry, err := repository.fetchMaterial(trackable, show)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch show '%d' to record progress: %v", show, err)
+
+		return model.ProgressEntry{}, err
+	}
+
+	if update.DateWatched == 0 {
+		update.DateWatched = time.Now().Unix()
+	}
+
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return model.ProgressEntry{}, err
+	}
+
+	_, err = service.StoreFragment(repository.connection, database.TableEpisodeProgressFragments, database.PropertiesEpisodeProgressFragments, pgx.NamedArgs{
+		"owner":        user.ID,
+		"episode":      episode,
+		"date_watched": update.DateWatched,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store progress of episode '%d' for user '%d': %v", episode, user.ID, err)
+
+		return model.ProgressEntry{}, err
+	}
+
+	watched, err := repository.countWatchedEpisodes(user.ID, show)
+
+	if err != nil {
+		repository.logger.Printf("Unable to count watched episodes of show '%d' for user '%d': %v", show, user.ID, err)
+
+		return model.ProgressEntry{}, err
+	}
+
+	status := trackable.active
+
+	if entry.Total > 0 && watched >= entry.Total {
+		status = trackable.finished
+		watched = entry.Total
+	}
+
+	return repository.storeProgressEntry(trackable, user.ID, entry, model.ProgressUpdate{
+		Status:       status,
+		Progress:     watched,
+		DateRecorded: update.DateWatched,
+	})
+}
+
+// Fetch the episodes of a show watched by the user with the provided reference, in season and episode order, where a
+// rewatched episode appears once per watch.
+//
+// Return: episode progress entry slice and nil with success, empty slice and error without.
+func (repository *Repository) FetchEpisodeProgress(reference string, show int) ([]model.EpisodeProgressEntry, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return []model.EpisodeProgressEntry{}, err
+	}
+
+	statement, err := database.CreateQuery(
+		"w.id, w.episode, s.number AS season, e.number, e.title, w.date_watched",
+		fmt.Sprintf("%s w", database.TableEpisodeProgressFragments),
+		fmt.Sprintf("w.owner=%d AND e.show=%d", user.ID, show),
+		"",
+		fmt.Sprintf("JOIN %s e ON e.id = w.episode", database.TableShowEpisodeFragments),
+		fmt.Sprintf("JOIN %s s ON s.id = e.season", database.TableShowSeasonFragments),
+	)
+
+	if err != nil {
+		return []model.EpisodeProgressEntry{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch episode progress of show '%d' for user '%d': %v", show, user.ID, err)
+
+		return []model.EpisodeProgressEntry{}, err
+	}
+
+	entrySlice, err := database.MapQueryResponse[model.EpisodeProgressEntry](rows)
+
+	if err != nil {
+		return []model.EpisodeProgressEntry{}, err
+	}
+
+	slices.SortStableFunc(entrySlice, func(a model.EpisodeProgressEntry, b model.EpisodeProgressEntry) int {
+		if a.Season != b.Season {
+			return cmp.Compare(a.Season, b.Season)
+		}
+
+		if a.Number != b.Number {
+			return cmp.Compare(a.Number, b.Number)
+		}
+
+		return cmp.Compare(a.DateWatched, b.DateWatched)
+	})
+
+	if entrySlice == nil {
+		entrySlice = []model.EpisodeProgressEntry{}
+	}
+
+	return entrySlice, nil
+}
+
+// Delete every watch of an episode by the user with the provided reference (e.g., an episode marked by mistake), where
+// the progress history of the show is left unchanged.
+//
+// Return: whether the episode was watched and nil with success, false and error without.
+func (repository *Repository) DeleteEpisodeProgress(reference string, episode int) (bool, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return false, err
+	}
+
+	count, err := service.DeleteRelationship(repository.connection, database.TableEpisodeProgressFragments, fmt.Sprintf("owner=%d AND episode=%d", user.ID, episode))
+
+	if err != nil {
+		repository.logger.Printf("Unable to delete progress of episode '%d' for user '%d': %v", episode, user.ID, err)
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Fetch the numeric identifier of the show of an episode.
+func (repository *Repository) fetchEpisodeShow(episode int) (int, error) {
+	statement, err := database.CreateQuery("show", database.TableShowEpisodeFragments, fmt.Sprintf("id=%d", episode), "")
+
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := database.MapQueryResponse[int](rows)
+
+	if err != nil || len(response) == 0 {
+		return 0, err
+	}
+
+	return response[0], nil
+}
+
+// Count the distinct episodes of a show watched by the user with the provided numeric identifier.
+func (repository *Repository) countWatchedEpisodes(owner int, show int) (int, error) {
+	statement, err := database.CreateQuery(
+		"COUNT(DISTINCT w.episode)",
+		fmt.Sprintf("%s w", database.TableEpisodeProgressFragments),
+		fmt.Sprintf("w.owner=%d AND e.show=%d", owner, show),
+		"",
+		fmt.Sprintf("JOIN %s e ON e.id = w.episode", database.TableShowEpisodeFragments),
+	)
+
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := database.MapQueryResponse[int](rows)
+
+	if err != nil || len(response) == 0 {
+		return 0, err
+	}
+
+	return response[0], nil
+}
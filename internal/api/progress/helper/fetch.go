@@ -0,0 +1,159 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/progress"
+)
+
+// Fetch the progress history of a material for the user with the provided reference, most recent first.
+//
+// Return: progress entry slice and nil with success, empty slice and error without.
+func (repository *Repository) FetchProgressHistory(reference string, materialType string, id int) ([]model.ProgressEntry, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return []model.ProgressEntry{}, err
+	}
+
+	trackable, _ := lookup(materialType)
+
+	entrySlice, err := repository.fetchProgressEntrySlice(trackable, fmt.Sprintf("p.owner=%d AND p.%s=%d", user.ID, trackable.Column, id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch progress history of %s '%d' for user '%d': %v", materialType, id, user.ID, err)
+
+		return []model.ProgressEntry{}, err
+	}
+
+	return entrySlice, nil
+}
+
+// Fetch the materials currently in progress (e.g., books being read) for the user with the provided reference, based
+// on the latest progress entry of each material, most recently updated first.
+//
+// Return: progress entry slice and nil with success, empty slice and error without.
+func (repository *Repository) FetchProgressFeed(reference string) ([]model.ProgressEntry, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return []model.ProgressEntry{}, err
+	}
+
+	feed := []model.ProgressEntry{}
+
+	for _, materialType := range repository.materialTypes {
+		trackable, exists := lookup(materialType)
+
+		if !exists {
+			continue
+		}
+
+		entrySlice, err := repository.fetchProgressEntrySlice(trackable, fmt.Sprintf("p.owner=%d", user.ID))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch %s progress for user '%d': %v", materialType, user.ID, err)
+
+			return []model.ProgressEntry{}, err
+		}
+
+		seen := make(map[int]bool)
+
+		for _, entry := range entrySlice {
+			if seen[entry.Material] {
+				continue
+			}
+
+			seen[entry.Material] = true
+
+			if entry.Status == trackable.active {
+				feed = append(feed, entry)
+			}
+		}
+	}
+
+	sortProgressEntrySlice(feed)
+
+	return feed, nil
+}
+
+// Fetch progress entries joined with their material, most recent first.
+func (repository *Repository) fetchProgressEntrySlice(trackable trackable, constraint string) ([]model.ProgressEntry, error) {
+	statement, err := database.CreateQuery(
+		fmt.Sprintf("p.id, p.%s AS material, m.title, m.image, p.status, p.progress, %s AS total, p.date_recorded%s", trackable.Column, trackable.total, trackable.selectUnit()),
+		fmt.Sprintf("%s p", trackable.table),
+		constraint,
+		"",
+		fmt.Sprintf("JOIN %s m ON m.id = p.%s", trackable.Table, trackable.Column),
+	)
+
+	if err != nil {
+		return []model.ProgressEntry{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return []model.ProgressEntry{}, err
+	}
+
+	entrySlice, err := database.MapQueryResponse[model.ProgressEntry](rows)
+
+	if err != nil {
+		return []model.ProgressEntry{}, err
+	}
+
+	for index := range entrySlice {
+		entrySlice[index].Type = trackable.Type
+		trackable.fillUnit(&entrySlice[index])
+	}
+
+	sortProgressEntrySlice(entrySlice)
+
+	if entrySlice == nil {
+		entrySlice = []model.ProgressEntry{}
+	}
+
+	return entrySlice, nil
+}
+
+// Fetch the title, image, and progress total of a material.
+func (repository *Repository) fetchMaterial(trackable trackable, id int) (model.ProgressEntry, error) {
+	statement, err := database.CreateQuery(
+		fmt.Sprintf("m.id AS material, m.title, m.image, %s AS total%s", trackable.total, trackable.selectUnit()),
+		fmt.Sprintf("%s m", trackable.Table),
+		fmt.Sprintf("m.id=%d", id),
+		"",
+	)
+
+	if err != nil {
+		return model.ProgressEntry{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return model.ProgressEntry{}, err
+	}
+
+	response, err := database.MapQueryResponse[model.ProgressEntry](rows)
+
+	if err != nil || len(response) == 0 {
+		return model.ProgressEntry{}, err
+	}
+
+	return response[0], nil
+}
+
+func sortProgressEntrySlice(entrySlice []model.ProgressEntry) {
+	slices.SortStableFunc(entrySlice, func(a model.ProgressEntry, b model.ProgressEntry) int {
+		if a.DateRecorded != b.DateRecorded {
+			return cmp.Compare(b.DateRecorded, a.DateRecorded)
+		}
+
+		return cmp.Compare(b.ID, a.ID)
+	})
+}
@@ -0,0 +1,223 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	comicHelper "github.com/muzzarellimj/grace-material-api/internal/api/comic/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/progress"
+)
+
+// Record an issue of a comic as read by the user with the provided reference, where the date defaults to now, and
+// record the progress of the comic as the number of distinct issues read, which is finished (i.e., "read") once every
+// issue of the comic is read.
+//
+// Return: stored comic progress entry and nil with success, empty progress entry and error without. An empty progress
+// entry without error indicates no issue matched the identifier.
+func (repository *Repository) RecordIssueProgress(reference string, issue int, update model.IssueProgressUpdate) (model.ProgressEntry, error) {
+	trackable, _ := lookup(material.TypeComic)
+
+	comic, err := repository.fetchIssueComic(issue)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch comic of issue '%d' to record progress: %v", issue, err)
+
+		return model.ProgressEntry{}, err
+	}
+
+	if comic == 0 {
+		return model.ProgressEntry{}, nil
+	}
+
+	entry, err := repository.fetchMaterial(trackable, comic)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch comic '%d' to record progress: %v", comic, err)
+
+		return model.ProgressEntry{}, err
+	}
+
+	if update.DateRead == 0 {
+		update.DateRead = time.Now().Unix()
+	}
+
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return model.ProgressEntry{}, err
+	}
+
+	_, err = service.StoreFragment(repository.connection, database.TableIssueProgressFragments, database.PropertiesIssueProgressFragments, pgx.NamedArgs{
+		"owner":     user.ID,
+		"issue":     issue,
+		"date_read": update.DateRead,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store progress of issue '%d' for user '%d': %v", issue, user.ID, err)
+
+		return model.ProgressEntry{}, err
+	}
+
+	read, err := repository.countReadIssues(user.ID, comic)
+
+	if err != nil {
+		repository.logger.Printf("Unable to count read issues of comic '%d' for user '%d': %v", comic, user.ID, err)
+
+		return model.ProgressEntry{}, err
+	}
+
+	status := trackable.active
+
+	if entry.Total > 0 && read >= entry.Total {
+		status = trackable.finished
+		read = entry.Total
+	}
+
+	return repository.storeProgressEntry(trackable, user.ID, entry, model.ProgressUpdate{
+		Status:       status,
+		Progress:     read,
+		DateRecorded: update.DateRead,
+	})
+}
+
+// Fetch the issues of a comic read by the user with the provided reference, in volume and issue order, where a reread
+// issue appears once per read.
+//
+// Return: issue progress entry slice and nil with success, empty slice and error without.
+func (repository *Repository) FetchIssueProgress(reference string, comic int) ([]model.IssueProgressEntry, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return []model.IssueProgressEntry{}, err
+	}
+
+	statement, err := database.CreateQuery(
+		"w.id, w.issue, i.volume, v.title AS volume_title, v.start_date AS volume_start_date, i.number, i.title, w.date_read",
+		fmt.Sprintf("%s w", database.TableIssueProgressFragments),
+		fmt.Sprintf("w.owner=%d AND i.comic=%d", user.ID, comic),
+		"",
+		fmt.Sprintf("JOIN %s i ON i.id = w.issue", database.TableComicIssueFragments),
+		fmt.Sprintf("JOIN %s v ON v.id = i.volume", database.TableComicVolumeFragments),
+	)
+
+	if err != nil {
+		return []model.IssueProgressEntry{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch issue progress of comic '%d' for user '%d': %v", comic, user.ID, err)
+
+		return []model.IssueProgressEntry{}, err
+	}
+
+	entrySlice, err := database.MapQueryResponse[model.IssueProgressEntry](rows)
+
+	if err != nil {
+		return []model.IssueProgressEntry{}, err
+	}
+
+	slices.SortStableFunc(entrySlice, func(a model.IssueProgressEntry, b model.IssueProgressEntry) int {
+		if a.VolumeStartDate != b.VolumeStartDate {
+			return cmp.Compare(a.VolumeStartDate, b.VolumeStartDate)
+		}
+
+		if a.Volume != b.Volume {
+			return cmp.Compare(a.Volume, b.Volume)
+		}
+
+		if order := comicHelper.CompareIssueNumber(a.Number, b.Number); order != 0 {
+			return order
+		}
+
+		return cmp.Compare(a.DateRead, b.DateRead)
+	})
+
+	if entrySlice == nil {
+		entrySlice = []model.IssueProgressEntry{}
+	}
+
+	return entrySlice, nil
+}
+
+// Delete every read of an issue by the user with the provided reference (e.g., an issue marked by mistake), where the
+// progress history of the comic is left unchanged.
+//
+// Return: whether the issue was read and nil with success, false and error without.
+func (repository *Repository) DeleteIssueProgress(reference string, issue int) (bool, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return false, err
+	}
+
+	count, err := service.DeleteRelationship(repository.connection, database.TableIssueProgressFragments, fmt.Sprintf("owner=%d AND issue=%d", user.ID, issue))
+
+	if err != nil {
+		repository.logger.Printf("Unable to delete progress of issue '%d' for user '%d': %v", issue, user.ID, err)
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Fetch the numeric identifier of the comic of an issue.
+func (repository *Repository) fetchIssueComic(issue int) (int, error) {
+	statement, err := database.CreateQuery("comic", database.TableComicIssueFragments, fmt.Sprintf("id=%d", issue), "")
+
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := database.MapQueryResponse[int](rows)
+
+	if err != nil || len(response) == 0 {
+		return 0, err
+	}
+
+	return response[0], nil
+}
+
+// Count the distinct issues of a comic read by the user with the provided numeric identifier.
+func (repository *Repository) countReadIssues(owner int, comic int) (int, error) {
+	statement, err := database.CreateQuery(
+		"COUNT(DISTINCT w.issue)",
+		fmt.Sprintf("%s w", database.TableIssueProgressFragments),
+		fmt.Sprintf("w.owner=%d AND i.comic=%d", owner, comic),
+		"",
+		fmt.Sprintf("JOIN %s i ON i.id = w.issue", database.TableComicIssueFragments),
+	)
+
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := database.MapQueryResponse[int](rows)
+
+	if err != nil || len(response) == 0 {
+		return 0, err
+	}
+
+	return response[0], nil
+}
@@ -0,0 +1,195 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	bookHelper "github.com/muzzarellimj/grace-material-api/internal/api/book/helper"
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/progress"
+)
+
+var ErrInvalidProgress = errors.New("progress must be between zero and the material total")
+
+// A material type which may be tracked, described by its progress table, properties, and status vocabulary, where
+// the active status marks a material as currently in progress and the finished status marks it complete. Progress is
+// measured in a unit (e.g., pages) up to a total selected from the material table (e.g., 'm.pages'), where a material
+// type measured in more than one unit selects the unit from the material table too (e.g., minutes for audiobooks).
+type trackable struct {
+	material.Material
+
+	table      string
+	properties []string
+	statuses   []string
+	active     string
+	finished   string
+	unit       string
+	total      string
+	measure    string
+}
+
+var trackables = map[string]trackable{
+	material.TypeBook: {
+		table:      database.TableBookProgressFragments,
+		properties: database.PropertiesBookProgressFragments,
+		statuses:   []string{"planned", "reading", "paused", "read", "abandoned"},
+		active:     "reading",
+		finished:   "read",
+		unit:       "page",
+		total:      fmt.Sprintf("CASE WHEN m.format = '%s' AND m.duration > 0 THEN m.duration ELSE m.pages END", bookHelper.FormatAudiobook),
+		measure:    fmt.Sprintf("CASE WHEN m.format = '%s' AND m.duration > 0 THEN 'minute' ELSE 'page' END", bookHelper.FormatAudiobook),
+	},
+	material.TypeGame: {
+		table:      database.TableGameProgressFragments,
+		properties: database.PropertiesGameProgressFragments,
+		statuses:   []string{"planned", "playing", "paused", "completed", "abandoned"},
+		active:     "playing",
+		finished:   "completed",
+		unit:       "percent",
+		total:      "100",
+	},
+	material.TypeMovie: {
+		table:      database.TableMovieProgressFragments,
+		properties: database.PropertiesMovieProgressFragments,
+		statuses:   []string{"planned", "watching", "paused", "watched", "abandoned"},
+		active:     "watching",
+		finished:   "watched",
+		unit:       "minute",
+		total:      "m.runtime",
+	},
+	material.TypeShow: {
+		table:      database.TableShowProgressFragments,
+		properties: database.PropertiesShowProgressFragments,
+		statuses:   []string{"planned", "watching", "paused", "watched", "abandoned"},
+		active:     "watching",
+		finished:   "watched",
+		unit:       "episode",
+		total:      "m.episodes",
+	},
+	material.TypeAlbum: {
+		table:      database.TableAlbumProgressFragments,
+		properties: database.PropertiesAlbumProgressFragments,
+		statuses:   []string{"planned", "listening", "paused", "listened", "abandoned"},
+		active:     "listening",
+		finished:   "listened",
+		unit:       "track",
+		total:      "m.tracks",
+	},
+	material.TypeBoardGame: {
+		table:      database.TableBoardGameProgressFragments,
+		properties: database.PropertiesBoardGameProgressFragments,
+		statuses:   []string{"planned", "playing", "paused", "played", "abandoned"},
+		active:     "playing",
+		finished:   "played",
+		unit:       "minute",
+		total:      "m.playing_time",
+	},
+	material.TypeComic: {
+		table:      database.TableComicProgressFragments,
+		properties: database.PropertiesComicProgressFragments,
+		statuses:   []string{"planned", "reading", "paused", "read", "abandoned"},
+		active:     "reading",
+		finished:   "read",
+		unit:       "issue",
+		total:      "m.issues",
+	},
+	material.TypePodcast: {
+		table:      database.TablePodcastProgressFragments,
+		properties: database.PropertiesPodcastProgressFragments,
+		statuses:   []string{"planned", "listening", "paused", "listened", "abandoned"},
+		active:     "listening",
+		finished:   "listened",
+		unit:       "episode",
+		total:      "m.episodes",
+	},
+}
+
+func lookup(materialType string) (trackable, bool) {
+	trackable, exists := trackables[materialType]
+
+	if !exists {
+		return trackable, false
+	}
+
+	trackable.Material, exists = material.Lookup(materialType)
+
+	return trackable, exists
+}
+
+// Create the selection of the unit of each material, empty where the material type is measured in a single unit.
+func (trackable trackable) selectUnit() string {
+	if trackable.measure == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(", %s AS unit", trackable.measure)
+}
+
+// Fill the unit of a progress entry where it was not selected with the material.
+func (trackable trackable) fillUnit(entry *model.ProgressEntry) {
+	if entry.Unit == "" {
+		entry.Unit = trackable.unit
+	}
+}
+
+// Create a constraint to filter materials of a type by those finished (e.g., "read" for books) by the user with the
+// provided numeric identifier between two Unix timestamps, the latter exclusive, where the column holds the material
+// numeric identifier (e.g., "m.id").
+//
+// Return: constraint, which matches nothing for an unknown material type.
+func FinishedConstraint(column string, materialType string, owner int, from int64, to int64) string {
+	trackable, exists := lookup(materialType)
+
+	if !exists {
+		return "FALSE"
+	}
+
+	return fmt.Sprintf(
+		"%s IN (SELECT p.%s FROM %s p WHERE p.owner=%d AND p.status='%s' AND p.date_recorded >= %d AND p.date_recorded < %d)",
+		column, trackable.Column, trackable.table, owner, trackable.finished, from, to,
+	)
+}
+
+// A progress repository, which records and fetches per-user status and progress history of the enabled material
+// types in the provided database pool.
+type Repository struct {
+	connection    database.PgxPool
+	users         *userHelper.Repository
+	materialTypes []string
+	logger        *log.Logger
+}
+
+// Create a progress repository with a database pool, user repository, enabled material types (e.g., "book"), and
+// logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, users *userHelper.Repository, materialTypes []string, logger *log.Logger) *Repository {
+	return &Repository{
+		connection:    connection,
+		users:         users,
+		materialTypes: materialTypes,
+		logger:        logger,
+	}
+}
+
+// Determine whether a material type is known and enabled.
+//
+// Return: true if supported, false if not.
+func (repository *Repository) Supports(materialType string) bool {
+	_, exists := lookup(materialType)
+
+	return exists && slices.Contains(repository.materialTypes, materialType)
+}
+
+// Retrieve the status vocabulary of a material type (e.g., "reading" for books, "playing" for games).
+//
+// Return: status slice, which is empty for an unknown type.
+func (repository *Repository) Statuses(materialType string) []string {
+	trackable, _ := lookup(materialType)
+
+	return trackable.statuses
+}
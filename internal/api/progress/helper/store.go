@@ -0,0 +1,103 @@
+package helper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/progress"
+)
+
+// Record a progress entry for a material and the user with the provided reference, where the date defaults to now
+// and a finished status without progress (e.g., "watched") records the material total.
+//
+// Return: stored progress entry and nil with success, empty progress entry and ErrInvalidProgress with progress
+// beyond the material total, or error without. An empty progress entry without error indicates no material matched
+// the identifier.
+func (repository *Repository) RecordProgress(reference string, materialType string, id int, update model.ProgressUpdate) (model.ProgressEntry, error) {
+	trackable, _ := lookup(materialType)
+
+	entry, err := repository.fetchMaterial(trackable, id)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch %s '%d' to record progress: %v", materialType, id, err)
+
+		return model.ProgressEntry{}, err
+	}
+
+	if entry.Material == 0 {
+		return model.ProgressEntry{}, nil
+	}
+
+	if update.Status == trackable.finished && update.Progress == 0 {
+		update.Progress = entry.Total
+	}
+
+	if update.Progress < 0 || (entry.Total > 0 && update.Progress > entry.Total) {
+		return model.ProgressEntry{}, ErrInvalidProgress
+	}
+
+	if update.DateRecorded == 0 {
+		update.DateRecorded = time.Now().Unix()
+	}
+
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return model.ProgressEntry{}, err
+	}
+
+	return repository.storeProgressEntry(trackable, user.ID, entry, update)
+}
+
+// Store a progress entry of a material (i.e., an entry holding the material title, image, and total) for the user
+// with the provided numeric identifier.
+func (repository *Repository) storeProgressEntry(trackable trackable, owner int, entry model.ProgressEntry, update model.ProgressUpdate) (model.ProgressEntry, error) {
+	var err error
+
+	entry.ID, err = service.StoreFragment(repository.connection, trackable.table, trackable.properties, pgx.NamedArgs{
+		"owner":          owner,
+		trackable.Column: entry.Material,
+		"status":         update.Status,
+		"progress":       update.Progress,
+		"date_recorded":  update.DateRecorded,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store progress of %s '%d' for user '%d': %v", trackable.Type, entry.Material, owner, err)
+
+		return model.ProgressEntry{}, err
+	}
+
+	entry.Type = trackable.Type
+	entry.Status = update.Status
+	entry.Progress = update.Progress
+	trackable.fillUnit(&entry)
+	entry.DateRecorded = update.DateRecorded
+
+	return entry, nil
+}
+
+// Delete a progress entry owned by the user with the provided reference (e.g., a mistaken watch date).
+//
+// Return: whether the entry existed and nil with success, false and error without.
+func (repository *Repository) DeleteProgressEntry(reference string, materialType string, entry int) (bool, error) {
+	trackable, _ := lookup(materialType)
+
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return false, err
+	}
+
+	count, err := service.DeleteRelationship(repository.connection, trackable.table, fmt.Sprintf("id=%d AND owner=%d", entry, user.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to delete %s progress entry '%d' for user '%d': %v", materialType, entry, user.ID, err)
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
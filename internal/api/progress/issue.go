@@ -0,0 +1,143 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/progress"
+)
+
+// Handle an issue progress request, by comic numeric identifier in query parameter 'id', responding with the read
+// issues of the comic in volume and issue order.
+func (handler *Handler) HandleGetIssueProgress(context *gin.Context) {
+	principal, id, ok := handler.bindIssueRequest(context, "id")
+
+	if !ok {
+		return
+	}
+
+	entrySlice, err := handler.repository.FetchIssueProgress(principal, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(entrySlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   entrySlice,
+	})
+}
+
+// Handle an issue read, by issue numeric identifier in query parameter 'id' and an optional body holding the date read,
+// responding with the resulting progress entry of the comic.
+func (handler *Handler) HandlePostIssueProgress(context *gin.Context) {
+	principal, id, ok := handler.bindIssueRequest(context, "id")
+
+	if !ok {
+		return
+	}
+
+	var update model.IssueProgressUpdate
+
+	if context.Request.ContentLength > 0 {
+		err := context.BindJSON(&update)
+
+		if err != nil {
+			context.IndentedJSON(http.StatusBadRequest, gin.H{
+				"status":  http.StatusBadRequest,
+				"message": "Unable to bind request JSON body to issue progress model.",
+			})
+
+			return
+		}
+	}
+
+	entry, err := handler.repository.RecordIssueProgress(principal, id, update)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to record issue progress.",
+		})
+
+		return
+	}
+
+	if entry.ID == 0 {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find issue with numeric identifier '%d'.", id),
+		})
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusCreated, gin.H{
+		"status": http.StatusCreated,
+		"data":   entry,
+	})
+}
+
+func (handler *Handler) HandleDeleteIssueProgress(context *gin.Context) {
+	principal, id, ok := handler.bindIssueRequest(context, "id")
+
+	if !ok {
+		return
+	}
+
+	deleted, err := handler.repository.DeleteIssueProgress(principal, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to delete issue progress.",
+		})
+
+		return
+	}
+
+	if !deleted {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find progress of issue with numeric identifier '%d'.", id),
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
+
+// Bind a material request (see bindMaterialRequest) of a material type with issues (i.e., comics), responding with
+// an error for any other type.
+func (handler *Handler) bindIssueRequest(context *gin.Context, param string) (string, int, bool) {
+	principal, materialType, id, ok := handler.bindMaterialRequest(context, param)
+
+	if !ok {
+		return "", 0, false
+	}
+
+	if materialType != material.TypeComic {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material type argument '%s' provided in route parameter 'type'; only '%s' has issues.", materialType, material.TypeComic),
+		})
+
+		return "", 0, false
+	}
+
+	return principal, id, true
+}
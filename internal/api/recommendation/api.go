@@ -0,0 +1,101 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/recommendation/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+)
+
+// A recommendation request handler, which holds the dependencies shared between recommendation routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a recommendation request handler with a recommendation repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetRecommendationSlice(context *gin.Context) {
+	materialType := context.Param("type")
+
+	if !handler.repository.Supports(materialType) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material type argument '%s' provided in route parameter 'type'.", materialType),
+		})
+
+		return
+	}
+
+	id, err := strconv.Atoi(context.Query("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	limit := helper.DefaultRecommendationLimit
+
+	if limitArg := context.Query("limit"); limitArg != "" {
+		limit, err = strconv.Atoi(limitArg)
+
+		if err != nil || limit < 1 || limit > helper.MaximumRecommendationLimit {
+			context.IndentedJSON(http.StatusBadRequest, gin.H{
+				"status":  http.StatusBadRequest,
+				"message": fmt.Sprintf("Invalid limit argument '%s' provided in query parameter 'limit'; expected 1 to %d.", limitArg, helper.MaximumRecommendationLimit),
+			})
+
+			return
+		}
+	}
+
+	var reference string
+
+	if principal, ok := middleware.Principal(context); ok {
+		reference = principal.Subject
+	}
+
+	recommendationSlice, exists, err := handler.repository.FetchRecommendationSlice(materialType, id, reference, limit)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to fetch recommendations and map to supported data structure.",
+		})
+
+		return
+	}
+
+	if !exists {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find %s with numeric identifier '%d'.", materialType, id),
+		})
+
+		return
+	}
+
+	if len(recommendationSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   recommendationSlice,
+	})
+}
@@ -0,0 +1,175 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	collectionHelper "github.com/muzzarellimj/grace-material-api/internal/api/collection/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/recommendation"
+)
+
+// Fetch stored materials similar to a material, ranked by a weighted similarity score between zero and one: the
+// weighted share of the material's relationships (e.g., genres) each candidate has in common. Materials in the
+// collection of the user with the provided reference are excluded, unless the reference is empty.
+//
+// Return: recommendation slice, whether the material exists, and nil with success; empty slice, false, and error
+// without.
+func (repository *Repository) FetchRecommendationSlice(materialType string, id int, reference string, limit int) ([]model.Recommendation, bool, error) {
+	recommendable, _ := lookup(materialType)
+
+	materialSlice, err := service.FetchExistenceSlice(repository.connection, recommendable.Table, fmt.Sprintf("id=%d", id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence of %s '%d': %v", materialType, id, err)
+
+		return []model.Recommendation{}, false, err
+	}
+
+	if len(materialSlice) == 0 {
+		return []model.Recommendation{}, false, nil
+	}
+
+	scores := make(map[int]float64)
+	total := 0.0
+
+	for _, dimension := range recommendable.dimensions {
+		count, candidateSlice, err := repository.fetchCandidateSlice(recommendable, dimension, id)
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch %s candidates sharing '%s' with '%d': %v", materialType, dimension.column, id, err)
+
+			return []model.Recommendation{}, false, err
+		}
+
+		total += dimension.weight * float64(count)
+
+		for _, candidate := range candidateSlice {
+			scores[candidate.ID] += dimension.weight * float64(candidate.Count)
+		}
+	}
+
+	if len(scores) == 0 {
+		return []model.Recommendation{}, true, nil
+	}
+
+	var idSlice []string
+
+	for candidate := range scores {
+		idSlice = append(idSlice, strconv.Itoa(candidate))
+	}
+
+	slices.Sort(idSlice)
+
+	constraint := fmt.Sprintf("m.id IN (%s)", strings.Join(idSlice, ","))
+
+	if reference != "" {
+		constraint = fmt.Sprintf("%s AND NOT (%s)", constraint, collectionHelper.Constraint("m.id", materialType, reference))
+	}
+
+	recommendationSlice, err := repository.fetchRecommendationSlice(recommendable, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch %s recommendations for '%d': %v", materialType, id, err)
+
+		return []model.Recommendation{}, false, err
+	}
+
+	for index := range recommendationSlice {
+		recommendationSlice[index].Type = recommendable.Type
+		recommendationSlice[index].Score = math.Round(scores[recommendationSlice[index].Material]/total*1000) / 1000
+	}
+
+	slices.SortStableFunc(recommendationSlice, func(a model.Recommendation, b model.Recommendation) int {
+		if a.Score != b.Score {
+			return cmp.Compare(b.Score, a.Score)
+		}
+
+		return cmp.Compare(a.Title, b.Title)
+	})
+
+	if len(recommendationSlice) > limit {
+		recommendationSlice = recommendationSlice[:limit]
+	}
+
+	return recommendationSlice, true, nil
+}
+
+// Fetch the number of fragments of a relationship of a material and, per other material, the number of those
+// fragments it shares.
+func (repository *Repository) fetchCandidateSlice(recommendable recommendable, dimension dimension, id int) (int, []model.CandidateFragment, error) {
+	statement, err := database.CreateQuery("COUNT(*)", dimension.bridge, fmt.Sprintf("%s=%d", recommendable.Column, id), "")
+
+	if err != nil {
+		return 0, []model.CandidateFragment{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return 0, []model.CandidateFragment{}, err
+	}
+
+	countSlice, err := database.MapQueryResponse[int](rows)
+
+	if err != nil || len(countSlice) == 0 || countSlice[0] == 0 {
+		return 0, []model.CandidateFragment{}, err
+	}
+
+	statement, err = database.CreateQuery(
+		fmt.Sprintf("b.%s AS id, COUNT(*) AS count", recommendable.Column),
+		fmt.Sprintf("%s b", dimension.bridge),
+		fmt.Sprintf("b.%s IN (SELECT s.%s FROM %s s WHERE s.%s=%d) AND b.%s <> %d", dimension.column, dimension.column, dimension.bridge, recommendable.Column, id, recommendable.Column, id),
+		fmt.Sprintf("b.%s", recommendable.Column),
+	)
+
+	if err != nil {
+		return 0, []model.CandidateFragment{}, err
+	}
+
+	rows, err = database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return 0, []model.CandidateFragment{}, err
+	}
+
+	candidateSlice, err := database.MapQueryResponse[model.CandidateFragment](rows)
+
+	if err != nil {
+		return 0, []model.CandidateFragment{}, err
+	}
+
+	return countSlice[0], candidateSlice, nil
+}
+
+// Fetch recommendations of materials matching the provided constraint, without type or score.
+func (repository *Repository) fetchRecommendationSlice(recommendable recommendable, constraint string) ([]model.Recommendation, error) {
+	statement, err := database.CreateQuery("m.id AS material, m.title, m.image", fmt.Sprintf("%s m", recommendable.Table), constraint, "")
+
+	if err != nil {
+		return []model.Recommendation{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return []model.Recommendation{}, err
+	}
+
+	recommendationSlice, err := database.MapQueryResponse[model.Recommendation](rows)
+
+	if err != nil {
+		return []model.Recommendation{}, err
+	}
+
+	if recommendationSlice == nil {
+		recommendationSlice = []model.Recommendation{}
+	}
+
+	return recommendationSlice, nil
+}
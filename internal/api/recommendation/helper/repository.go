@@ -0,0 +1,125 @@
+package helper
+
+import (
+	"log"
+	"slices"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+)
+
+// The default and maximum number of recommendations per request.
+const (
+	DefaultRecommendationLimit = 10
+	MaximumRecommendationLimit = 50
+)
+
+// A relationship of a material type to compare, described by its relationship table and column, and the weight of
+// each shared fragment (e.g., a shared author weighs more than a shared topic).
+type dimension struct {
+	bridge string
+	column string
+	weight float64
+}
+
+// A material type which may be recommended, described by its relationships to compare.
+type recommendable struct {
+	material.Material
+
+	dimensions []dimension
+}
+
+var recommendables = map[string]recommendable{
+	material.TypeBook: {
+		dimensions: []dimension{
+			{bridge: database.TableBookAuthorRelationships, column: "author", weight: 3},
+			{bridge: database.TableBookTopicRelationships, column: "topic", weight: 1},
+		},
+	},
+	material.TypeGame: {
+		dimensions: []dimension{
+			{bridge: database.TableGameFranchiseRelationships, column: "franchise", weight: 3},
+			{bridge: database.TableGameStudioRelationships, column: "studio", weight: 2},
+			{bridge: database.TableGameGenreRelationships, column: "genre", weight: 1},
+			{bridge: database.TableGamePlatformRelationships, column: "platform", weight: 0.5},
+		},
+	},
+	material.TypeMovie: {
+		dimensions: []dimension{
+			{bridge: database.TableMovieProductionCompanyRelationships, column: "production_company", weight: 2},
+			{bridge: database.TableMovieGenreRelationships, column: "genre", weight: 1},
+		},
+	},
+	material.TypeShow: {
+		dimensions: []dimension{
+			{bridge: database.TableShowNetworkRelationships, column: "network", weight: 2},
+			{bridge: database.TableShowGenreRelationships, column: "genre", weight: 1},
+		},
+	},
+	material.TypeAlbum: {
+		dimensions: []dimension{
+			{bridge: database.TableAlbumArtistRelationships, column: "artist", weight: 3},
+			{bridge: database.TableAlbumLabelRelationships, column: "label", weight: 1},
+			{bridge: database.TableAlbumGenreRelationships, column: "genre", weight: 1},
+		},
+	},
+	material.TypeBoardGame: {
+		dimensions: []dimension{
+			{bridge: database.TableBoardGameDesignerRelationships, column: "designer", weight: 3},
+			{bridge: database.TableBoardGameMechanicRelationships, column: "mechanic", weight: 2},
+			{bridge: database.TableBoardGameCategoryRelationships, column: "category", weight: 1},
+			{bridge: database.TableBoardGamePublisherRelationships, column: "publisher", weight: 0.5},
+		},
+	},
+	material.TypeComic: {
+		dimensions: []dimension{
+			{bridge: database.TableComicCreatorRelationships, column: "creator", weight: 3},
+			{bridge: database.TableComicPublisherRelationships, column: "publisher", weight: 1},
+		},
+	},
+	material.TypePodcast: {
+		dimensions: []dimension{
+			{bridge: database.TablePodcastCategoryRelationships, column: "category", weight: 1},
+		},
+	},
+}
+
+func lookup(materialType string) (recommendable, bool) {
+	recommendable, exists := recommendables[materialType]
+
+	if !exists {
+		return recommendable, false
+	}
+
+	recommendable.Material, exists = material.Lookup(materialType)
+
+	return recommendable, exists
+}
+
+// A recommendation repository, which compares stored materials of the enabled material types by their shared
+// relationships in the provided database pool.
+type Repository struct {
+	connection    database.PgxPool
+	materialTypes []string
+	logger        *log.Logger
+}
+
+// Create a recommendation repository with a database pool, enabled material types (e.g., "book"), and logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, materialTypes []string, logger *log.Logger) *Repository {
+	return &Repository{
+		connection:    connection,
+		materialTypes: materialTypes,
+		logger:        logger,
+	}
+}
+
+// Determine whether a material type is known and enabled.
+//
+// Return: true if supported, false if not.
+func (repository *Repository) Supports(materialType string) bool {
+	_, exists := lookup(materialType)
+
+	return exists && slices.Contains(repository.materialTypes, materialType)
+}
@@ -0,0 +1,235 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/review/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/review"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+const errorMessage string = "Unable to fetch reviews and map to supported data structure."
+
+// A review request handler, which holds the dependencies shared between review routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a review request handler with a review repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetReviewSlice(context *gin.Context) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to fetch reviews without an authenticated principal.",
+		})
+
+		return
+	}
+
+	pagination, err := util.ParsePagination(context.Query("page"), context.Query("limit"))
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid pagination arguments provided in query parameters 'page' and 'limit': %v.", err),
+		})
+
+		return
+	}
+
+	var materialTypes []string
+
+	if typeArg := context.Query("type"); typeArg != "" {
+		materialTypes = strings.Split(typeArg, ",")
+
+		for _, materialType := range materialTypes {
+			if !handler.repository.Supports(materialType) {
+				context.IndentedJSON(http.StatusBadRequest, gin.H{
+					"status":  http.StatusBadRequest,
+					"message": fmt.Sprintf("Invalid material type argument '%s' provided in query parameter 'type'.", materialType),
+				})
+
+				return
+			}
+		}
+	}
+
+	reviewSlice, err := handler.repository.FetchReviewSlice(principal.Subject, materialTypes)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	page, pagination := util.Paginate(reviewSlice, pagination)
+
+	if len(page) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status":     http.StatusOK,
+		"data":       page,
+		"pagination": pagination,
+	})
+}
+
+func (handler *Handler) HandlePostReview(context *gin.Context) {
+	principal, materialType, id, ok := handler.bindMaterialRequest(context)
+
+	if !ok {
+		return
+	}
+
+	var update model.ReviewUpdate
+
+	err := context.BindJSON(&update)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to review model.",
+		})
+
+		return
+	}
+
+	if update.Rating < helper.MinimumRating || update.Rating > helper.MaximumRating {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid rating '%d' provided; expected a rating between %d and %d.", update.Rating, helper.MinimumRating, helper.MaximumRating),
+		})
+
+		return
+	}
+
+	if len(update.Review) > helper.MaximumReviewLength {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid review provided; expected at most %d characters.", helper.MaximumReviewLength),
+		})
+
+		return
+	}
+
+	review, created, err := handler.repository.StoreReview(principal, materialType, id, update)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to store review.",
+		})
+
+		return
+	}
+
+	if review.Material == 0 {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find %s with numeric identifier '%d'.", materialType, id),
+		})
+
+		return
+	}
+
+	status := http.StatusOK
+
+	if created {
+		status = http.StatusCreated
+	}
+
+	context.IndentedJSON(status, gin.H{
+		"status": status,
+		"data":   review,
+	})
+}
+
+func (handler *Handler) HandleDeleteReview(context *gin.Context) {
+	principal, materialType, id, ok := handler.bindMaterialRequest(context)
+
+	if !ok {
+		return
+	}
+
+	deleted, err := handler.repository.DeleteReview(principal, materialType, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to delete review.",
+		})
+
+		return
+	}
+
+	if !deleted {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find review of %s with numeric identifier '%d'.", materialType, id),
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
+
+// Bind the principal subject, material type route parameter, and numeric identifier query parameter shared by
+// review routes, responding with an error when any is missing or invalid.
+func (handler *Handler) bindMaterialRequest(context *gin.Context) (string, string, int, bool) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to modify reviews without an authenticated principal.",
+		})
+
+		return "", "", 0, false
+	}
+
+	materialType := context.Param("type")
+
+	if !handler.repository.Supports(materialType) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material type argument '%s' provided in route parameter 'type'.", materialType),
+		})
+
+		return "", "", 0, false
+	}
+
+	id, err := strconv.Atoi(context.Query("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return "", "", 0, false
+	}
+
+	return principal.Subject, materialType, id, true
+}
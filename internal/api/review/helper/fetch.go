@@ -0,0 +1,108 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/review"
+)
+
+// Fetch the reviews written by the user with the provided reference, of the provided material types (or every enabled
+// material type when none are provided), most recently updated first.
+//
+// Return: review slice and nil with success, empty slice and error without.
+func (repository *Repository) FetchReviewSlice(reference string, materialTypes []string) ([]model.Review, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return []model.Review{}, err
+	}
+
+	if len(materialTypes) == 0 {
+		materialTypes = repository.materialTypes
+	}
+
+	reviewSlice := []model.Review{}
+
+	for _, materialType := range materialTypes {
+		if !repository.Supports(materialType) {
+			continue
+		}
+
+		reviewable, _ := lookup(materialType)
+
+		typeReviewSlice, err := repository.fetchReviewSlice(reviewable, fmt.Sprintf("r.owner=%d", user.ID))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch %s reviews for user '%d': %v", materialType, user.ID, err)
+
+			return []model.Review{}, err
+		}
+
+		reviewSlice = append(reviewSlice, typeReviewSlice...)
+	}
+
+	slices.SortStableFunc(reviewSlice, func(a model.Review, b model.Review) int {
+		return cmp.Compare(b.DateUpdated, a.DateUpdated)
+	})
+
+	return reviewSlice, nil
+}
+
+// Fetch reviews joined with their material.
+func (repository *Repository) fetchReviewSlice(reviewable reviewable, constraint string) ([]model.Review, error) {
+	statement, err := database.CreateQuery(
+		fmt.Sprintf("r.id, r.%s AS material, m.title, m.image, r.rating, r.review, r.date_created, r.date_updated", reviewable.Column),
+		fmt.Sprintf("%s r", reviewable.table),
+		constraint,
+		"",
+		fmt.Sprintf("JOIN %s m ON m.id = r.%s", reviewable.Table, reviewable.Column),
+	)
+
+	if err != nil {
+		return []model.Review{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return []model.Review{}, err
+	}
+
+	reviewSlice, err := database.MapQueryResponse[model.Review](rows)
+
+	if err != nil {
+		return []model.Review{}, err
+	}
+
+	for index := range reviewSlice {
+		reviewSlice[index].Type = reviewable.Type
+	}
+
+	return reviewSlice, nil
+}
+
+// Fetch the title and image of a material as an otherwise empty review.
+func (repository *Repository) fetchMaterial(reviewable reviewable, id int) (model.Review, error) {
+	statement, err := database.CreateQuery("m.id AS material, m.title, m.image", fmt.Sprintf("%s m", reviewable.Table), fmt.Sprintf("m.id=%d", id), "")
+
+	if err != nil {
+		return model.Review{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return model.Review{}, err
+	}
+
+	response, err := database.MapQueryResponse[model.Review](rows)
+
+	if err != nil || len(response) == 0 {
+		return model.Review{}, err
+	}
+
+	return response[0], nil
+}
@@ -0,0 +1,81 @@
+package helper
+
+import (
+	"log"
+	"slices"
+
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+)
+
+// Bounds of a rating, which is out of 10 to allow half-star display.
+const (
+	MinimumRating = 1
+	MaximumRating = 10
+)
+
+// The maximum review text length, in bytes.
+const MaximumReviewLength = 4096
+
+// A material type which may be reviewed, described by its review table and properties.
+type reviewable struct {
+	material.Material
+
+	table      string
+	properties []string
+}
+
+var reviewables = map[string]reviewable{
+	material.TypeBook:      {table: database.TableBookReviewFragments, properties: database.PropertiesBookReviewFragments},
+	material.TypeGame:      {table: database.TableGameReviewFragments, properties: database.PropertiesGameReviewFragments},
+	material.TypeMovie:     {table: database.TableMovieReviewFragments, properties: database.PropertiesMovieReviewFragments},
+	material.TypeShow:      {table: database.TableShowReviewFragments, properties: database.PropertiesShowReviewFragments},
+	material.TypeAlbum:     {table: database.TableAlbumReviewFragments, properties: database.PropertiesAlbumReviewFragments},
+	material.TypeBoardGame: {table: database.TableBoardGameReviewFragments, properties: database.PropertiesBoardGameReviewFragments},
+	material.TypeComic:     {table: database.TableComicReviewFragments, properties: database.PropertiesComicReviewFragments},
+	material.TypePodcast:   {table: database.TablePodcastReviewFragments, properties: database.PropertiesPodcastReviewFragments},
+}
+
+func lookup(materialType string) (reviewable, bool) {
+	reviewable, exists := reviewables[materialType]
+
+	if !exists {
+		return reviewable, false
+	}
+
+	reviewable.Material, exists = material.Lookup(materialType)
+
+	return reviewable, exists
+}
+
+// A review repository, which stores and fetches per-user ratings and reviews of the enabled material types in the
+// provided database pool.
+type Repository struct {
+	connection    database.PgxPool
+	users         *userHelper.Repository
+	materialTypes []string
+	logger        *log.Logger
+}
+
+// Create a review repository with a database pool, user repository, enabled material types (e.g., "book"), and
+// logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, users *userHelper.Repository, materialTypes []string, logger *log.Logger) *Repository {
+	return &Repository{
+		connection:    connection,
+		users:         users,
+		materialTypes: materialTypes,
+		logger:        logger,
+	}
+}
+
+// Determine whether a material type is known and enabled.
+//
+// Return: true if supported, false if not.
+func (repository *Repository) Supports(materialType string) bool {
+	_, exists := lookup(materialType)
+
+	return exists && slices.Contains(repository.materialTypes, materialType)
+}
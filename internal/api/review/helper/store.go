@@ -0,0 +1,112 @@
+package helper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/review"
+)
+
+// Store or replace the review of a material by the user with the provided reference, since each user may review each
+// material once.
+//
+// Return: stored review, whether it was newly created, and nil with success; empty review, false, and error without.
+// An empty review without error indicates no material matched the identifier.
+func (repository *Repository) StoreReview(reference string, materialType string, id int, update model.ReviewUpdate) (model.Review, bool, error) {
+	reviewable, _ := lookup(materialType)
+
+	review, err := repository.fetchMaterial(reviewable, id)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch %s '%d' to review: %v", materialType, id, err)
+
+		return model.Review{}, false, err
+	}
+
+	if review.Material == 0 {
+		return model.Review{}, false, nil
+	}
+
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return model.Review{}, false, err
+	}
+
+	existingReviewSlice, err := repository.fetchReviewSlice(reviewable, fmt.Sprintf("r.owner=%d AND r.%s=%d", user.ID, reviewable.Column, id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing review of %s '%d' by user '%d': %v", materialType, id, user.ID, err)
+
+		return model.Review{}, false, err
+	}
+
+	now := time.Now().Unix()
+
+	review.Type = reviewable.Type
+	review.Rating = update.Rating
+	review.Review = update.Review
+	review.DateCreated = now
+	review.DateUpdated = now
+
+	if len(existingReviewSlice) > 0 {
+		review.ID = existingReviewSlice[0].ID
+		review.DateCreated = existingReviewSlice[0].DateCreated
+
+		_, err = service.UpdateFragment(repository.connection, reviewable.table, []string{"rating", "review", "date_updated"}, fmt.Sprintf("id=%d", review.ID), pgx.NamedArgs{
+			"rating":       review.Rating,
+			"review":       review.Review,
+			"date_updated": review.DateUpdated,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to update review '%d' of %s '%d': %v", review.ID, materialType, id, err)
+
+			return model.Review{}, false, err
+		}
+
+		return review, false, nil
+	}
+
+	review.ID, err = service.StoreFragment(repository.connection, reviewable.table, reviewable.properties, pgx.NamedArgs{
+		"owner":           user.ID,
+		reviewable.Column: id,
+		"rating":          review.Rating,
+		"review":          review.Review,
+		"date_created":    review.DateCreated,
+		"date_updated":    review.DateUpdated,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store review of %s '%d' by user '%d': %v", materialType, id, user.ID, err)
+
+		return model.Review{}, false, err
+	}
+
+	return review, true, nil
+}
+
+// Delete the review of a material by the user with the provided reference.
+//
+// Return: whether the review existed and nil with success, false and error without.
+func (repository *Repository) DeleteReview(reference string, materialType string, id int) (bool, error) {
+	reviewable, _ := lookup(materialType)
+
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return false, err
+	}
+
+	count, err := service.DeleteRelationship(repository.connection, reviewable.table, fmt.Sprintf("owner=%d AND %s=%d", user.ID, reviewable.Column, id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to delete review of %s '%d' by user '%d': %v", materialType, id, user.ID, err)
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,251 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/show"
+)
+
+func (repository *Repository) FetchShow(constraint string) (model.Show, error) {
+	zero := model.Show{}
+
+	showFragment, err := service.FetchFragment[model.ShowFragment](repository.connection, database.TableShowFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch show with constraint '%s': %v", constraint, err)
+
+		return zero, err
+	}
+
+	if showFragment.ID == 0 {
+		return zero, nil
+	}
+
+	genreFragmentSlice, err := repository.fetchGenreFragmentSlice(showFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch genres related to show '%d': %v", showFragment.ID, err)
+	}
+
+	networkFragmentSlice, err := repository.fetchNetworkFragmentSlice(showFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch networks related to show '%d': %v", showFragment.ID, err)
+	}
+
+	seasonSlice, err := repository.fetchSeasonSlice(showFragment)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch seasons of show '%d': %v", showFragment.ID, err)
+	}
+
+	show := mapShow(showFragment, genreFragmentSlice, networkFragmentSlice, seasonSlice)
+
+	return show, nil
+}
+
+func (repository *Repository) FetchShowSlice(constraintSlice []string) ([]model.Show, []error) {
+	var showSlice []model.Show
+	var errorSlice []error
+
+	for _, constraint := range constraintSlice {
+		show, err := repository.FetchShow(constraint)
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch and map show with constraint '%s': %v", constraint, err)
+
+			errorSlice = append(errorSlice, err)
+		}
+
+		if show.ID != 0 {
+			showSlice = append(showSlice, show)
+		}
+	}
+
+	return showSlice, errorSlice
+}
+
+func (repository *Repository) FetchShowExistenceSlice(constraint string) ([]int, []error) {
+	idSlice, err := service.FetchExistenceSlice(repository.connection, database.TableShowFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence slice: %v", err)
+
+		return []int{}, []error{err}
+	}
+
+	if len(idSlice) == 0 {
+		repository.logger.Print("Existence slice appears to be empty.")
+
+		return []int{}, nil
+	}
+
+	return idSlice, nil
+}
+
+func (repository *Repository) fetchGenreFragmentSlice(showFragment model.ShowFragment) ([]model.ShowGenreFragment, error) {
+	zero := []model.ShowGenreFragment{}
+
+	showGenreRelationshipSlice, err := service.FetchRelationshipSlice[model.ShowGenreRelationship](repository.connection, database.TableShowGenreRelationships, fmt.Sprintf("show=%d", showFragment.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between show '%d' and genres: %v", showFragment.ID, err)
+
+		return zero, err
+	}
+
+	var genreFragmentSlice []model.ShowGenreFragment
+
+	for _, relationship := range showGenreRelationshipSlice {
+		genreFragment, err := service.FetchFragment[model.ShowGenreFragment](repository.connection, database.TableShowGenreFragments, fmt.Sprintf("id=%d", relationship.Genre))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch genre '%d': %v", relationship.Genre, err)
+		}
+
+		if genreFragment.ID != 0 {
+			genreFragmentSlice = append(genreFragmentSlice, genreFragment)
+		}
+	}
+
+	return genreFragmentSlice, nil
+}
+
+func (repository *Repository) fetchNetworkFragmentSlice(showFragment model.ShowFragment) ([]model.ShowNetworkFragment, error) {
+	zero := []model.ShowNetworkFragment{}
+
+	showNetworkRelationshipSlice, err := service.FetchRelationshipSlice[model.ShowNetworkRelationship](repository.connection, database.TableShowNetworkRelationships, fmt.Sprintf("show=%d", showFragment.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationships between show '%d' and networks: %v", showFragment.ID, err)
+
+		return zero, err
+	}
+
+	var networkFragmentSlice []model.ShowNetworkFragment
+
+	for _, relationship := range showNetworkRelationshipSlice {
+		networkFragment, err := service.FetchFragment[model.ShowNetworkFragment](repository.connection, database.TableShowNetworkFragments, fmt.Sprintf("id=%d", relationship.Network))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch network '%d': %v", relationship.Network, err)
+		}
+
+		if networkFragment.ID != 0 {
+			networkFragmentSlice = append(networkFragmentSlice, networkFragment)
+		}
+	}
+
+	return networkFragmentSlice, nil
+}
+
+// Fetch the seasons of a show in season number order, each with its episodes in episode number order.
+func (repository *Repository) fetchSeasonSlice(showFragment model.ShowFragment) ([]model.ShowSeason, error) {
+	seasonFragmentSlice, err := service.FetchFragmentSlice[model.ShowSeasonFragment](repository.connection, database.TableShowSeasonFragments, fmt.Sprintf("show=%d", showFragment.ID))
+
+	if err != nil {
+		return []model.ShowSeason{}, err
+	}
+
+	episodeFragmentSlice, err := service.FetchFragmentSlice[model.ShowEpisodeFragment](repository.connection, database.TableShowEpisodeFragments, fmt.Sprintf("show=%d", showFragment.ID))
+
+	if err != nil {
+		return []model.ShowSeason{}, err
+	}
+
+	slices.SortStableFunc(seasonFragmentSlice, func(a model.ShowSeasonFragment, b model.ShowSeasonFragment) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+
+	slices.SortStableFunc(episodeFragmentSlice, func(a model.ShowEpisodeFragment, b model.ShowEpisodeFragment) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+
+	var seasonSlice []model.ShowSeason
+
+	for _, seasonFragment := range seasonFragmentSlice {
+		season := model.ShowSeason{
+			ID:          seasonFragment.ID,
+			Number:      seasonFragment.Number,
+			Title:       seasonFragment.Title,
+			Description: seasonFragment.Description,
+			AirDate:     seasonFragment.AirDate,
+			Image:       seasonFragment.Image,
+			Reference:   seasonFragment.Reference,
+			Episodes:    []model.ShowEpisodeFragment{},
+		}
+
+		for _, episodeFragment := range episodeFragmentSlice {
+			if episodeFragment.Season == seasonFragment.ID {
+				season.Episodes = append(season.Episodes, episodeFragment)
+			}
+		}
+
+		seasonSlice = append(seasonSlice, season)
+	}
+
+	return seasonSlice, nil
+}
+
+func mapShow(showFragment model.ShowFragment, genreFragmentSlice []model.ShowGenreFragment, networkFragmentSlice []model.ShowNetworkFragment, seasonSlice []model.ShowSeason) model.Show {
+	if genreFragmentSlice == nil {
+		genreFragmentSlice = make([]model.ShowGenreFragment, 0)
+	}
+
+	if networkFragmentSlice == nil {
+		networkFragmentSlice = make([]model.ShowNetworkFragment, 0)
+	}
+
+	if seasonSlice == nil {
+		seasonSlice = make([]model.ShowSeason, 0)
+	}
+
+	return model.Show{
+		ID:           showFragment.ID,
+		Title:        showFragment.Title,
+		Tagline:      showFragment.Tagline,
+		Description:  showFragment.Description,
+		Genres:       genreFragmentSlice,
+		Networks:     networkFragmentSlice,
+		Seasons:      seasonSlice,
+		FirstAirDate: showFragment.FirstAirDate,
+		LastAirDate:  showFragment.LastAirDate,
+		Status:       showFragment.Status,
+		Episodes:     showFragment.Episodes,
+		Runtime:      showFragment.Runtime,
+		Image:        showFragment.Image,
+		Reference:    showFragment.Reference,
+	}
+}
+
+// Fetch the rating aggregate (i.e., review count and average rating) of a show.
+//
+// Return: rating aggregate and nil with success, empty rating aggregate and error without.
+func (repository *Repository) FetchShowRating(id int) (reviewModel.RatingAggregate, error) {
+	statement, err := database.CreateQuery("COUNT(rating) AS count, COALESCE(AVG(rating), 0)::FLOAT8 AS average", database.TableShowReviewFragments, fmt.Sprintf("show=%d", id), "")
+
+	if err != nil {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch rating aggregate of show '%d': %v", id, err)
+
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	response, err := database.MapQueryResponse[reviewModel.RatingAggregate](rows)
+
+	if err != nil || len(response) == 0 {
+		return reviewModel.RatingAggregate{}, err
+	}
+
+	return response[0], nil
+}
@@ -0,0 +1,48 @@
+package helper
+
+import (
+	"fmt"
+
+	model "github.com/muzzarellimj/grace-material-api/internal/model/show"
+	TMDBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/themoviedb.org"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+func MapSearchResultSlice(input []TMDBModel.TMDBShowSearchResult) []model.ShowSearchResult {
+	var resultSlice []model.ShowSearchResult
+
+	for _, result := range input {
+		mappedResult := model.ShowSearchResult{
+			ID:           result.ID,
+			Title:        result.Name,
+			FirstAirDate: util.ParseDateTime(result.FirstAirDate),
+			Image:        FormatImagePath(result.Image),
+		}
+
+		resultSlice = append(resultSlice, mappedResult)
+	}
+
+	return resultSlice
+}
+
+// Format the path of an image (i.e., a poster, still, or logo), which may be absent.
+//
+// Return: formatted image path, or an empty string without an image.
+func FormatImagePath(path string) string {
+	if path == "" {
+		return ""
+	}
+
+	return fmt.Sprint("https://image.tmdb.org/t/p/original", path)
+}
+
+// Extract the typical episode runtime of a show, which TMDB provides as a (possibly empty) list of runtimes.
+//
+// Return: first runtime in minutes, or 0 without a runtime.
+func ExtractRuntime(runtimes []int) int {
+	if len(runtimes) == 0 {
+		return 0
+	}
+
+	return runtimes[0]
+}
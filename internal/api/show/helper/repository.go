@@ -0,0 +1,99 @@
+package helper
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/show"
+	TMDBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/themoviedb.org"
+)
+
+// The TMDB operations required by the show repository, which are satisfied by a TMDB client or a fake.
+type Provider interface {
+	TMDBGetShow(id string) (TMDBModel.TMDBShowDetailResponse, error)
+	TMDBGetSeason(id string, number int) (TMDBModel.TMDBSeasonDetailResponse, error)
+	TMDBSearchShow(name string) (TMDBModel.TMDBShowSearchResponse, error)
+}
+
+// A show repository, which fetches, stores, and updates shows, with their seasons and episodes, in the provided
+// database pool with metadata from the provided TMDB provider.
+type Repository struct {
+	connection database.PgxPool
+	client     Provider
+	logger     *log.Logger
+}
+
+// Create a show repository with a database pool, TMDB provider, and logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, client Provider, logger *log.Logger) *Repository {
+	return &Repository{
+		connection: connection,
+		client:     client,
+		logger:     logger,
+	}
+}
+
+// Search TMDB shows by name, and map results to the supported search result model.
+//
+// Return: mapped search result slice and nil with success, empty slice and error without.
+func (repository *Repository) SearchShows(query string) ([]model.ShowSearchResult, error) {
+	results, err := repository.client.TMDBSearchShow(query)
+
+	if err != nil {
+		repository.logger.Printf("Unable to search TMDB shows with query '%s': %v", query, err)
+
+		return []model.ShowSearchResult{}, err
+	}
+
+	return MapSearchResultSlice(results.Results), nil
+}
+
+// Store a show with a provided TMDB numeric identifier, with its seasons and episodes, unless a show with that
+// reference already exists.
+//
+// Return: numeric identifier, whether the show was newly stored, and nil with success; 0, false, and error without.
+// A 0 identifier without error indicates no TMDB show matched the identifier.
+func (repository *Repository) StoreShow(id string) (int, bool, error) {
+	reference, err := strconv.Atoi(id)
+
+	if err != nil {
+		repository.logger.Printf("Unable to parse TMDB show identifier '%s': %v", id, err)
+
+		return 0, false, err
+	}
+
+	existingShow, err := repository.FetchShow(fmt.Sprintf("reference=%d", reference))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existing show '%d': %v", reference, err)
+
+		return 0, false, err
+	}
+
+	if existingShow.ID != 0 {
+		return existingShow.ID, false, nil
+	}
+
+	show, err := repository.client.TMDBGetShow(strconv.Itoa(reference))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch show '%d' TMDB record: %v", reference, err)
+
+		return 0, false, err
+	}
+
+	if show.ID == 0 {
+		return 0, false, nil
+	}
+
+	showId, err := repository.ProcessShowStorage(show)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	return showId, true, nil
+}
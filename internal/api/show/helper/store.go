@@ -0,0 +1,191 @@
+package helper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/show"
+	TMDBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/themoviedb.org"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+func (repository *Repository) ProcessShowStorage(show TMDBModel.TMDBShowDetailResponse) (int, error) {
+	showId, err := repository.storeShowFragment(show)
+
+	if err != nil {
+		repository.logger.Printf("Unable to store show '%d' : %v", show.ID, err)
+
+		return 0, err
+	}
+
+	genreIdSlice := repository.processGenreFragmentSlice(show.Genres)
+	networkIdSlice := repository.processNetworkFragmentSlice(show.Networks)
+
+	service.StoreRelationshipSlice(repository.connection, database.TableShowGenreRelationships, database.PropertiesShowGenreRelationships, service.RelationshipSliceArgument{
+		SourceName:          "show",
+		SourceArgument:      showId,
+		DestinationName:     "genre",
+		DestinationArgument: genreIdSlice,
+	})
+
+	service.StoreRelationshipSlice(repository.connection, database.TableShowNetworkRelationships, database.PropertiesShowNetworkRelationships, service.RelationshipSliceArgument{
+		SourceName:          "show",
+		SourceArgument:      showId,
+		DestinationName:     "network",
+		DestinationArgument: networkIdSlice,
+	})
+
+	repository.processSeasonStorage(showId, show)
+
+	return showId, nil
+}
+
+// Store the seasons of a show and the episodes of each, fetched per season from TMDB, where specials (i.e., season 0)
+// are not stored, since they are not counted among the episodes of a show.
+func (repository *Repository) processSeasonStorage(showId int, show TMDBModel.TMDBShowDetailResponse) {
+	for _, summary := range show.Seasons {
+		if summary.SeasonNumber <= 0 {
+			continue
+		}
+
+		season, err := repository.client.TMDBGetSeason(strconv.Itoa(show.ID), summary.SeasonNumber)
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch season '%d' of show '%d' TMDB record: %v", summary.SeasonNumber, show.ID, err)
+
+			continue
+		}
+
+		seasonId, err := service.StoreFragment(repository.connection, database.TableShowSeasonFragments, database.PropertiesShowSeasonFragments, pgx.NamedArgs{
+			"show":        showId,
+			"number":      season.SeasonNumber,
+			"title":       season.Name,
+			"description": season.Overview,
+			"air_date":    util.ParseDateTime(season.AirDate),
+			"image":       FormatImagePath(season.Image),
+			"reference":   season.ID,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store season '%d' of show '%d' fragment: %v", season.SeasonNumber, show.ID, err)
+
+			continue
+		}
+
+		for _, episode := range season.Episodes {
+			_, err := service.StoreFragment(repository.connection, database.TableShowEpisodeFragments, database.PropertiesShowEpisodeFragments, pgx.NamedArgs{
+				"show":        showId,
+				"season":      seasonId,
+				"number":      episode.EpisodeNumber,
+				"title":       episode.Name,
+				"description": episode.Overview,
+				"air_date":    util.ParseDateTime(episode.AirDate),
+				"runtime":     episode.Runtime,
+				"image":       FormatImagePath(episode.Image),
+				"reference":   episode.ID,
+			})
+
+			if err != nil {
+				repository.logger.Printf("Unable to store episode '%d' of season '%d' of show '%d' fragment: %v", episode.EpisodeNumber, season.SeasonNumber, show.ID, err)
+			}
+		}
+	}
+}
+
+func (repository *Repository) storeShowFragment(show TMDBModel.TMDBShowDetailResponse) (int, error) {
+	showId, err := service.StoreFragment(repository.connection, database.TableShowFragments, database.PropertiesShowFragments, pgx.NamedArgs{
+		"title":          show.Name,
+		"tagline":        show.Tagline,
+		"description":    show.Overview,
+		"first_air_date": util.ParseDateTime(show.FirstAirDate),
+		"last_air_date":  util.ParseDateTime(show.LastAirDate),
+		"status":         show.Status,
+		"seasons":        show.NumberOfSeasons,
+		"episodes":       show.NumberOfEpisodes,
+		"runtime":        ExtractRuntime(show.EpisodeRunTime),
+		"image":          FormatImagePath(show.Image),
+		"reference":      show.ID,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store show '%d' fragment: %v", show.ID, err)
+
+		return 0, err
+	}
+
+	return showId, nil
+}
+
+func (repository *Repository) processGenreFragmentSlice(genres []TMDBModel.TMDBGenre) []int {
+	var genreIdSlice []int
+
+	for _, genre := range genres {
+		existingGenreFragment, err := service.FetchFragment[model.ShowGenreFragment](repository.connection, database.TableShowGenreFragments, fmt.Sprintf("reference=%d", genre.ID))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch existing genre '%d' fragment: %v", genre.ID, err)
+
+			continue
+		}
+
+		if existingGenreFragment.ID != 0 {
+			genreIdSlice = append(genreIdSlice, existingGenreFragment.ID)
+
+			continue
+		}
+
+		genreId, err := service.StoreFragment(repository.connection, database.TableShowGenreFragments, database.PropertiesShowGenreFragments, pgx.NamedArgs{
+			"name":      genre.Name,
+			"reference": genre.ID,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store new genre '%d' fragment: %v", genre.ID, err)
+		}
+
+		if genreId != 0 {
+			genreIdSlice = append(genreIdSlice, genreId)
+		}
+	}
+
+	return genreIdSlice
+}
+
+func (repository *Repository) processNetworkFragmentSlice(networks []TMDBModel.TMDBNetwork) []int {
+	var networkIdSlice []int
+
+	for _, network := range networks {
+		existingNetworkFragment, err := service.FetchFragment[model.ShowNetworkFragment](repository.connection, database.TableShowNetworkFragments, fmt.Sprintf("reference=%d", network.ID))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch existing network '%d' fragment: %v", network.ID, err)
+
+			continue
+		}
+
+		if existingNetworkFragment.ID != 0 {
+			networkIdSlice = append(networkIdSlice, existingNetworkFragment.ID)
+
+			continue
+		}
+
+		networkId, err := service.StoreFragment(repository.connection, database.TableShowNetworkFragments, database.PropertiesShowNetworkFragments, pgx.NamedArgs{
+			"name":      network.Name,
+			"image":     FormatImagePath(network.Image),
+			"reference": network.ID,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store new network '%d' fragment: %v", network.ID, err)
+		}
+
+		if networkId != 0 {
+			networkIdSlice = append(networkIdSlice, networkId)
+		}
+	}
+
+	return networkIdSlice
+}
@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/show"
+)
+
+func (repository *Repository) UpdateShowFragment(show model.ShowFragment) (int, error) {
+	id, err := service.UpdateFragment(repository.connection, database.TableShowFragments, database.PropertiesShowFragments, fmt.Sprintf("id=%d", show.ID), pgx.NamedArgs{
+		"title":          show.Title,
+		"tagline":        show.Tagline,
+		"description":    show.Description,
+		"first_air_date": show.FirstAirDate,
+		"last_air_date":  show.LastAirDate,
+		"status":         show.Status,
+		"seasons":        show.Seasons,
+		"episodes":       show.Episodes,
+		"runtime":        show.Runtime,
+		"image":          show.Image,
+		"reference":      show.Reference,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to update show '%d' fragment: %v", show.ID, err)
+
+		return 0, err
+	}
+
+	return id, nil
+}
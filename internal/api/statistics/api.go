@@ -0,0 +1,71 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/statistics/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+)
+
+// A statistics request handler, which holds the dependencies shared between statistics routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a statistics request handler with a statistics repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetStatistics(context *gin.Context) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to fetch statistics without an authenticated principal.",
+		})
+
+		return
+	}
+
+	year := 0
+
+	if yearArg := context.Query("year"); yearArg != "" {
+		parsedYear, err := strconv.Atoi(yearArg)
+
+		if err != nil || parsedYear < 1 || parsedYear > 9999 {
+			context.IndentedJSON(http.StatusBadRequest, gin.H{
+				"status":  http.StatusBadRequest,
+				"message": fmt.Sprintf("Invalid year argument '%s' provided in query parameter 'year'.", yearArg),
+			})
+
+			return
+		}
+
+		year = parsedYear
+	}
+
+	statistics, err := handler.repository.FetchStatistics(principal.Subject, year)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to fetch statistics and map to supported data structure.",
+		})
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   statistics,
+	})
+}
@@ -0,0 +1,203 @@
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	progressHelper "github.com/muzzarellimj/grace-material-api/internal/api/progress/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/statistics"
+)
+
+// Fetch statistics of the user with the provided reference: materials collected and finished, top relationships (e.g.,
+// genres) and the release year distribution of collected materials, and pages read, minutes listened (i.e., of
+// audiobooks), and runtime watched of finished materials. A non-zero year limits statistics to materials collected or
+// finished in that year (UTC).
+//
+// Return: statistics and nil with success, empty statistics and error without.
+func (repository *Repository) FetchStatistics(reference string, year int) (model.Statistics, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return model.Statistics{}, err
+	}
+
+	from, to := period(year)
+
+	statistics := model.Statistics{
+		Year:      year,
+		Materials: make(map[string]model.MaterialStatistics),
+	}
+
+	for _, materialType := range repository.materialTypes {
+		measurable, exists := lookup(materialType)
+
+		if !exists {
+			continue
+		}
+
+		collectedConstraint := fmt.Sprintf(
+			"r.collection IN (SELECT c.id FROM %s c WHERE c.owner=%d) AND r.date_added >= %d AND r.date_added < %d",
+			database.TableCollectionFragments, user.ID, from, to,
+		)
+
+		collectedSlice, err := repository.fetchMaterialSlice(measurable, fmt.Sprintf("%s r", measurable.bridge), collectedConstraint, fmt.Sprintf("JOIN %s m ON m.id = r.%s", measurable.Table, measurable.Column))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch collected %s statistics for user '%d': %v", materialType, user.ID, err)
+
+			return model.Statistics{}, err
+		}
+
+		finishedSlice, err := repository.fetchMaterialSlice(measurable, fmt.Sprintf("%s m", measurable.Table), progressHelper.FinishedConstraint("m.id", materialType, user.ID, from, to))
+
+		if err != nil {
+			repository.logger.Printf("Unable to fetch finished %s statistics for user '%d': %v", materialType, user.ID, err)
+
+			return model.Statistics{}, err
+		}
+
+		materialStatistics := model.MaterialStatistics{
+			Collected:    len(collectedSlice),
+			Finished:     len(finishedSlice),
+			Top:          make(map[string][]model.StatisticsEntry),
+			ReleaseYears: countReleaseYears(collectedSlice),
+		}
+
+		for _, dimension := range measurable.dimensions {
+			entrySlice, err := repository.fetchStatisticsEntrySlice(measurable, dimension, collectedConstraint)
+
+			if err != nil {
+				repository.logger.Printf("Unable to fetch %s %s statistics for user '%d': %v", materialType, dimension.name, user.ID, err)
+
+				return model.Statistics{}, err
+			}
+
+			materialStatistics.Top[dimension.name] = entrySlice
+		}
+
+		for _, fragment := range finishedSlice {
+			switch materialType {
+			case material.TypeBook:
+				if fragment.Unit == "minute" {
+					statistics.MinutesListened += fragment.Total
+				} else {
+					statistics.PagesRead += fragment.Total
+				}
+			case material.TypeMovie, material.TypeShow:
+				statistics.RuntimeWatched += fragment.Total
+			}
+		}
+
+		statistics.Materials[materialType] = materialStatistics
+	}
+
+	return statistics, nil
+}
+
+// Fetch material identifiers, release dates, totals, and units with the provided source, constraint, and directives.
+func (repository *Repository) fetchMaterialSlice(measurable measurable, from string, constraint string, directives ...string) ([]model.MaterialFragment, error) {
+	statement, err := database.CreateQuery(
+		fmt.Sprintf("DISTINCT m.id, %s AS release_date, %s AS total%s", measurable.release, measurable.total, measurable.selectUnit()),
+		from,
+		constraint,
+		"",
+		directives...,
+	)
+
+	if err != nil {
+		return []model.MaterialFragment{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return []model.MaterialFragment{}, err
+	}
+
+	return database.MapQueryResponse[model.MaterialFragment](rows)
+}
+
+// Fetch the most common fragments of a relationship among collected materials, most common first.
+func (repository *Repository) fetchStatisticsEntrySlice(measurable measurable, dimension dimension, constraint string) ([]model.StatisticsEntry, error) {
+	statement, err := database.CreateQuery(
+		fmt.Sprintf("f.id, %s AS name, COUNT(DISTINCT r.%s) AS count", dimension.label, measurable.Column),
+		fmt.Sprintf("%s r", measurable.bridge),
+		constraint,
+		"f.id",
+		fmt.Sprintf("JOIN %s b ON b.%s = r.%s", dimension.bridge, measurable.Column, measurable.Column),
+		fmt.Sprintf("JOIN %s f ON f.id = b.%s", dimension.table, dimension.column),
+	)
+
+	if err != nil {
+		return []model.StatisticsEntry{}, err
+	}
+
+	rows, err := database.ExecuteQuery(repository.connection, statement)
+
+	if err != nil {
+		return []model.StatisticsEntry{}, err
+	}
+
+	entrySlice, err := database.MapQueryResponse[model.StatisticsEntry](rows)
+
+	if err != nil {
+		return []model.StatisticsEntry{}, err
+	}
+
+	slices.SortStableFunc(entrySlice, func(a model.StatisticsEntry, b model.StatisticsEntry) int {
+		if a.Count != b.Count {
+			return cmp.Compare(b.Count, a.Count)
+		}
+
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	if len(entrySlice) > MaximumTopEntries {
+		entrySlice = entrySlice[:MaximumTopEntries]
+	}
+
+	if entrySlice == nil {
+		entrySlice = []model.StatisticsEntry{}
+	}
+
+	return entrySlice, nil
+}
+
+// Count materials per release year (UTC), earliest first, ignoring materials without a release date.
+func countReleaseYears(fragmentSlice []model.MaterialFragment) []model.ReleaseYearCount {
+	counts := make(map[int]int)
+
+	for _, fragment := range fragmentSlice {
+		if fragment.ReleaseDate == 0 {
+			continue
+		}
+
+		counts[time.Unix(fragment.ReleaseDate, 0).UTC().Year()]++
+	}
+
+	releaseYears := []model.ReleaseYearCount{}
+
+	for year, count := range counts {
+		releaseYears = append(releaseYears, model.ReleaseYearCount{Year: year, Count: count})
+	}
+
+	slices.SortFunc(releaseYears, func(a model.ReleaseYearCount, b model.ReleaseYearCount) int {
+		return cmp.Compare(a.Year, b.Year)
+	})
+
+	return releaseYears
+}
+
+// Determine the Unix timestamp period of a year (UTC), the latter exclusive, or of all time when the year is zero.
+func period(year int) (int64, int64) {
+	if year == 0 {
+		return 0, math.MaxInt64
+	}
+
+	return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).Unix(), time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+}
@@ -0,0 +1,170 @@
+package helper
+
+import (
+	"fmt"
+	"log"
+	"slices"
+
+	bookHelper "github.com/muzzarellimj/grace-material-api/internal/api/book/helper"
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+)
+
+// The maximum number of entries in each top list (e.g., top genres).
+const MaximumTopEntries = 10
+
+// A relationship of a material type to aggregate (e.g., book authors), described by its name in the response, its
+// relationship table and column, its fragment table, and the name selected from the fragment table (e.g., 'f.name').
+type dimension struct {
+	name   string
+	bridge string
+	column string
+	table  string
+	label  string
+}
+
+// A material type which may be measured, described by its collection relationship table, the release date and total
+// (e.g., pages) selected from the material table, and its relationships to aggregate, where a material type measured in
+// more than one unit selects the unit from the material table too (e.g., minutes for audiobooks).
+type measurable struct {
+	material.Material
+
+	bridge     string
+	release    string
+	total      string
+	measure    string
+	dimensions []dimension
+}
+
+var measurables = map[string]measurable{
+	material.TypeBook: {
+		bridge:  database.TableCollectionBookRelationships,
+		release: "m.publish_date",
+		total:   fmt.Sprintf("CASE WHEN m.format = '%s' AND m.duration > 0 THEN m.duration ELSE m.pages END", bookHelper.FormatAudiobook),
+		measure: fmt.Sprintf("CASE WHEN m.format = '%s' AND m.duration > 0 THEN 'minute' ELSE 'page' END", bookHelper.FormatAudiobook),
+		dimensions: []dimension{
+			{name: "topics", bridge: database.TableBookTopicRelationships, column: "topic", table: database.TableBookTopicFragments, label: "f.name"},
+			{name: "authors", bridge: database.TableBookAuthorRelationships, column: "author", table: database.TableBookAuthorFragments, label: "f.display_name"},
+			{name: "publishers", bridge: database.TableBookPublisherRelationships, column: "publisher", table: database.TableBookPublisherFragments, label: "f.name"},
+		},
+	},
+	material.TypeGame: {
+		bridge:  database.TableCollectionGameRelationships,
+		release: "m.release_date",
+		total:   "0",
+		dimensions: []dimension{
+			{name: "genres", bridge: database.TableGameGenreRelationships, column: "genre", table: database.TableGameGenreFragments, label: "f.name"},
+			{name: "studios", bridge: database.TableGameStudioRelationships, column: "studio", table: database.TableGameStudioFragments, label: "f.name"},
+			{name: "platforms", bridge: database.TableGamePlatformRelationships, column: "platform", table: database.TableGamePlatformFragments, label: "f.name"},
+		},
+	},
+	material.TypeMovie: {
+		bridge:  database.TableCollectionMovieRelationships,
+		release: "m.release_date",
+		total:   "m.runtime",
+		dimensions: []dimension{
+			{name: "genres", bridge: database.TableMovieGenreRelationships, column: "genre", table: database.TableMovieGenreFragments, label: "f.name"},
+			{name: "production_companies", bridge: database.TableMovieProductionCompanyRelationships, column: "production_company", table: database.TableMovieProductionCompanyFragments, label: "f.name"},
+		},
+	},
+	material.TypeShow: {
+		bridge:  database.TableCollectionShowRelationships,
+		release: "m.first_air_date",
+		total:   "m.episodes * m.runtime",
+		dimensions: []dimension{
+			{name: "genres", bridge: database.TableShowGenreRelationships, column: "genre", table: database.TableShowGenreFragments, label: "f.name"},
+			{name: "networks", bridge: database.TableShowNetworkRelationships, column: "network", table: database.TableShowNetworkFragments, label: "f.name"},
+		},
+	},
+	material.TypeAlbum: {
+		bridge:  database.TableCollectionAlbumRelationships,
+		release: "m.release_date",
+		total:   "m.runtime",
+		dimensions: []dimension{
+			{name: "artists", bridge: database.TableAlbumArtistRelationships, column: "artist", table: database.TableAlbumArtistFragments, label: "f.name"},
+			{name: "labels", bridge: database.TableAlbumLabelRelationships, column: "label", table: database.TableAlbumLabelFragments, label: "f.name"},
+			{name: "genres", bridge: database.TableAlbumGenreRelationships, column: "genre", table: database.TableAlbumGenreFragments, label: "f.name"},
+		},
+	},
+	material.TypeBoardGame: {
+		bridge:  database.TableCollectionBoardGameRelationships,
+		release: "m.release_date",
+		total:   "m.playing_time",
+		dimensions: []dimension{
+			{name: "designers", bridge: database.TableBoardGameDesignerRelationships, column: "designer", table: database.TableBoardGameDesignerFragments, label: "f.name"},
+			{name: "publishers", bridge: database.TableBoardGamePublisherRelationships, column: "publisher", table: database.TableBoardGamePublisherFragments, label: "f.name"},
+			{name: "mechanics", bridge: database.TableBoardGameMechanicRelationships, column: "mechanic", table: database.TableBoardGameMechanicFragments, label: "f.name"},
+			{name: "categories", bridge: database.TableBoardGameCategoryRelationships, column: "category", table: database.TableBoardGameCategoryFragments, label: "f.name"},
+		},
+	},
+	material.TypeComic: {
+		bridge:  database.TableCollectionComicRelationships,
+		release: "m.start_date",
+		total:   "m.issues",
+		dimensions: []dimension{
+			{name: "creators", bridge: database.TableComicCreatorRelationships, column: "creator", table: database.TableComicCreatorFragments, label: "f.name"},
+			{name: "publishers", bridge: database.TableComicPublisherRelationships, column: "publisher", table: database.TableComicPublisherFragments, label: "f.name"},
+		},
+	},
+	material.TypePodcast: {
+		bridge:  database.TableCollectionPodcastRelationships,
+		release: "m.first_release_date",
+		total:   "m.episodes",
+		dimensions: []dimension{
+			{name: "categories", bridge: database.TablePodcastCategoryRelationships, column: "category", table: database.TablePodcastCategoryFragments, label: "f.name"},
+		},
+	},
+}
+
+func lookup(materialType string) (measurable, bool) {
+	measurable, exists := measurables[materialType]
+
+	if !exists {
+		return measurable, false
+	}
+
+	measurable.Material, exists = material.Lookup(materialType)
+
+	return measurable, exists
+}
+
+// Create the selection of the unit of each material, empty where the material type is measured in a single unit.
+func (measurable measurable) selectUnit() string {
+	if measurable.measure == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(", %s AS unit", measurable.measure)
+}
+
+// A statistics repository, which aggregates per-user collections and progress of the enabled material types in the
+// provided database pool.
+type Repository struct {
+	connection    database.PgxPool
+	users         *userHelper.Repository
+	materialTypes []string
+	logger        *log.Logger
+}
+
+// Create a statistics repository with a database pool, user repository, enabled material types (e.g., "book"), and
+// logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, users *userHelper.Repository, materialTypes []string, logger *log.Logger) *Repository {
+	return &Repository{
+		connection:    connection,
+		users:         users,
+		materialTypes: materialTypes,
+		logger:        logger,
+	}
+}
+
+// Determine whether a material type is known and enabled.
+//
+// Return: true if supported, false if not.
+func (repository *Repository) Supports(materialType string) bool {
+	_, exists := lookup(materialType)
+
+	return exists && slices.Contains(repository.materialTypes, materialType)
+}
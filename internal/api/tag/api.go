@@ -0,0 +1,290 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/api/tag/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+)
+
+const errorMessage string = "Unable to fetch tags and map to supported data structure."
+
+// A tag request handler, which holds the dependencies shared between tag routes.
+type Handler struct {
+	repository *helper.Repository
+}
+
+// Create a tag request handler with a tag repository.
+//
+// Return: configured handler.
+func NewHandler(repository *helper.Repository) *Handler {
+	return &Handler{
+		repository: repository,
+	}
+}
+
+func (handler *Handler) HandleGetTagSlice(context *gin.Context) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to fetch tags without an authenticated principal.",
+		})
+
+		return
+	}
+
+	tagSlice, err := handler.repository.FetchTagSlice(principal.Subject)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(tagSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   tagSlice,
+	})
+}
+
+func (handler *Handler) HandleGetMaterialTagSlice(context *gin.Context) {
+	principal, materialType, id, ok := handler.bindMaterialRequest(context)
+
+	if !ok {
+		return
+	}
+
+	tagSlice, err := handler.repository.FetchMaterialTagSlice(principal, materialType, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": errorMessage,
+		})
+
+		return
+	}
+
+	if len(tagSlice) == 0 {
+		context.Status(http.StatusNoContent)
+
+		return
+	}
+
+	context.IndentedJSON(http.StatusOK, gin.H{
+		"status": http.StatusOK,
+		"data":   tagSlice,
+	})
+}
+
+func (handler *Handler) HandlePostMaterialTag(context *gin.Context) {
+	principal, materialType, id, ok := handler.bindMaterialRequest(context)
+
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Name string `json:"name"`
+	}
+
+	err := context.BindJSON(&body)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "Unable to bind request JSON body to tag model.",
+		})
+
+		return
+	}
+
+	name, ok := bindTagName(context, body.Name)
+
+	if !ok {
+		return
+	}
+
+	tag, created, err := handler.repository.TagMaterial(principal, materialType, id, name)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to tag material.",
+		})
+
+		return
+	}
+
+	if tag.ID == 0 {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find %s with numeric identifier '%d'.", materialType, id),
+		})
+
+		return
+	}
+
+	status := http.StatusOK
+
+	if created {
+		status = http.StatusCreated
+	}
+
+	context.IndentedJSON(status, gin.H{
+		"status": status,
+		"data":   tag,
+	})
+}
+
+func (handler *Handler) HandleDeleteMaterialTag(context *gin.Context) {
+	principal, materialType, id, ok := handler.bindMaterialRequest(context)
+
+	if !ok {
+		return
+	}
+
+	name, ok := bindTagName(context, context.Query("name"))
+
+	if !ok {
+		return
+	}
+
+	removed, err := handler.repository.UntagMaterial(principal, materialType, id, name)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to remove tag from material.",
+		})
+
+		return
+	}
+
+	if !removed {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find tag '%s' on %s with numeric identifier '%d'.", name, materialType, id),
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
+
+func (handler *Handler) HandleDeleteTag(context *gin.Context) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to delete tag without an authenticated principal.",
+		})
+
+		return
+	}
+
+	id, err := strconv.Atoi(context.Query("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid tag identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return
+	}
+
+	deleted, err := handler.repository.DeleteTag(principal.Subject, id)
+
+	if err != nil {
+		context.IndentedJSON(http.StatusInternalServerError, gin.H{
+			"status":  http.StatusInternalServerError,
+			"message": "Unable to delete tag.",
+		})
+
+		return
+	}
+
+	if !deleted {
+		context.IndentedJSON(http.StatusNotFound, gin.H{
+			"status":  http.StatusNotFound,
+			"message": fmt.Sprintf("Unable to find tag with numeric identifier '%d'.", id),
+		})
+
+		return
+	}
+
+	context.Status(http.StatusNoContent)
+}
+
+// Bind the principal subject, material type route parameter, and numeric identifier query parameter shared by
+// material tag routes, responding with an error when any is missing or invalid.
+func (handler *Handler) bindMaterialRequest(context *gin.Context) (string, string, int, bool) {
+	principal, ok := middleware.Principal(context)
+
+	if !ok {
+		context.IndentedJSON(http.StatusUnauthorized, gin.H{
+			"status":  http.StatusUnauthorized,
+			"message": "Unable to access tags without an authenticated principal.",
+		})
+
+		return "", "", 0, false
+	}
+
+	materialType := context.Param("type")
+
+	if !handler.repository.Supports(materialType) {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material type argument '%s' provided in route parameter 'type'.", materialType),
+		})
+
+		return "", "", 0, false
+	}
+
+	id, err := strconv.Atoi(context.Query("id"))
+
+	if err != nil || id <= 0 {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid material identifier argument '%s' provided in query parameter 'id'.", context.Query("id")),
+		})
+
+		return "", "", 0, false
+	}
+
+	return principal.Subject, materialType, id, true
+}
+
+// Bind a trimmed tag name, responding with an error when it is empty or too long.
+func bindTagName(context *gin.Context, value string) (string, bool) {
+	name := strings.TrimSpace(value)
+
+	if name == "" || len(name) > helper.MaximumTagLength {
+		context.IndentedJSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": fmt.Sprintf("Invalid tag name '%s' provided; expected between 1 and %d characters.", value, helper.MaximumTagLength),
+		})
+
+		return "", false
+	}
+
+	return name, true
+}
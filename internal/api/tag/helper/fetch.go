@@ -0,0 +1,60 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/tag"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+// Fetch the tags of the user with the provided reference.
+//
+// Return: tag fragment slice and nil with success, empty slice and error without.
+func (repository *Repository) FetchTagSlice(reference string) ([]model.TagFragment, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return []model.TagFragment{}, err
+	}
+
+	tagSlice, err := service.FetchFragmentSlice[model.TagFragment](repository.connection, database.TableTagFragments, fmt.Sprintf("owner=%d", user.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch tags of user '%d': %v", user.ID, err)
+
+		return []model.TagFragment{}, err
+	}
+
+	return tagSlice, nil
+}
+
+// Fetch the tags of the user with the provided reference applied to a material.
+//
+// Return: tag fragment slice and nil with success, empty slice and error without.
+func (repository *Repository) FetchMaterialTagSlice(reference string, materialType string, id int) ([]model.TagFragment, error) {
+	taggable, _ := lookup(materialType)
+
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return []model.TagFragment{}, err
+	}
+
+	constraint := fmt.Sprintf("owner=%d AND id IN (SELECT tag FROM %s WHERE %s=%d)", user.ID, taggable.bridge, taggable.Column, id)
+
+	tagSlice, err := service.FetchFragmentSlice[model.TagFragment](repository.connection, database.TableTagFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch tags of %s '%d' for user '%d': %v", materialType, id, user.ID, err)
+
+		return []model.TagFragment{}, err
+	}
+
+	return tagSlice, nil
+}
+
+func (repository *Repository) fetchTag(owner int, name string) (model.TagFragment, error) {
+	return service.FetchFragment[model.TagFragment](repository.connection, database.TableTagFragments, fmt.Sprintf("owner=%d AND name='%s'", owner, util.FormatPSQLString(name)))
+}
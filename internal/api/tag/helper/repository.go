@@ -0,0 +1,93 @@
+package helper
+
+import (
+	"fmt"
+	"log"
+	"slices"
+
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+// The maximum tag name length, in bytes.
+const MaximumTagLength = 64
+
+// A material type which may be tagged, described by its tag relationship table and properties.
+type taggable struct {
+	material.Material
+
+	bridge     string
+	properties []string
+}
+
+var taggables = map[string]taggable{
+	material.TypeBook:      {bridge: database.TableBookTagRelationships, properties: database.PropertiesBookTagRelationships},
+	material.TypeGame:      {bridge: database.TableGameTagRelationships, properties: database.PropertiesGameTagRelationships},
+	material.TypeMovie:     {bridge: database.TableMovieTagRelationships, properties: database.PropertiesMovieTagRelationships},
+	material.TypeShow:      {bridge: database.TableShowTagRelationships, properties: database.PropertiesShowTagRelationships},
+	material.TypeAlbum:     {bridge: database.TableAlbumTagRelationships, properties: database.PropertiesAlbumTagRelationships},
+	material.TypeBoardGame: {bridge: database.TableBoardGameTagRelationships, properties: database.PropertiesBoardGameTagRelationships},
+	material.TypeComic:     {bridge: database.TableComicTagRelationships, properties: database.PropertiesComicTagRelationships},
+	material.TypePodcast:   {bridge: database.TablePodcastTagRelationships, properties: database.PropertiesPodcastTagRelationships},
+}
+
+func lookup(materialType string) (taggable, bool) {
+	taggable, exists := taggables[materialType]
+
+	if !exists {
+		return taggable, false
+	}
+
+	taggable.Material, exists = material.Lookup(materialType)
+
+	return taggable, exists
+}
+
+// Create a constraint to filter materials of a type by a tag name of the user with the provided reference, where the
+// column holds the material numeric identifier (e.g., "id" or "m.id").
+//
+// Return: constraint, which matches nothing for an unknown material type.
+func Constraint(column string, materialType string, reference string, name string) string {
+	taggable, exists := lookup(materialType)
+
+	if !exists {
+		return "FALSE"
+	}
+
+	return fmt.Sprintf(
+		"%s IN (SELECT r.%s FROM %s r JOIN %s t ON t.id = r.tag JOIN %s u ON u.id = t.owner WHERE u.reference='%s' AND t.name='%s')",
+		column, taggable.Column, taggable.bridge, database.TableTagFragments, database.TableUserFragments, util.FormatPSQLString(reference), util.FormatPSQLString(name),
+	)
+}
+
+// A tag repository, which stores and fetches per-user tags of the enabled material types in the provided database
+// pool.
+type Repository struct {
+	connection    database.PgxPool
+	users         *userHelper.Repository
+	materialTypes []string
+	logger        *log.Logger
+}
+
+// Create a tag repository with a database pool, user repository, enabled material types (e.g., "book"), and logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, users *userHelper.Repository, materialTypes []string, logger *log.Logger) *Repository {
+	return &Repository{
+		connection:    connection,
+		users:         users,
+		materialTypes: materialTypes,
+		logger:        logger,
+	}
+}
+
+// Determine whether a material type is known and enabled.
+//
+// Return: true if supported, false if not.
+func (repository *Repository) Supports(materialType string) bool {
+	_, exists := lookup(materialType)
+
+	return exists && slices.Contains(repository.materialTypes, materialType)
+}
@@ -0,0 +1,134 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/tag"
+)
+
+// Tag a material with a tag name of the user with the provided reference, storing the tag on first use.
+//
+// Return: tag fragment, whether the material was newly tagged, and nil with success; empty tag fragment, false, and
+// error without. An empty tag fragment without error indicates no material matched the identifier.
+func (repository *Repository) TagMaterial(reference string, materialType string, id int, name string) (model.TagFragment, bool, error) {
+	taggable, _ := lookup(materialType)
+
+	materialSlice, err := service.FetchExistenceSlice(repository.connection, taggable.Table, fmt.Sprintf("id=%d", id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch existence of %s '%d': %v", materialType, id, err)
+
+		return model.TagFragment{}, false, err
+	}
+
+	if len(materialSlice) == 0 {
+		return model.TagFragment{}, false, nil
+	}
+
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return model.TagFragment{}, false, err
+	}
+
+	tag, err := repository.fetchTag(user.ID, name)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch tag '%s' of user '%d': %v", name, user.ID, err)
+
+		return model.TagFragment{}, false, err
+	}
+
+	if tag.ID == 0 {
+		tag = model.TagFragment{Owner: user.ID, Name: name}
+
+		tag.ID, err = service.StoreFragment(repository.connection, database.TableTagFragments, database.PropertiesTagFragments, pgx.NamedArgs{
+			"owner": tag.Owner,
+			"name":  tag.Name,
+		})
+
+		if err != nil {
+			repository.logger.Printf("Unable to store tag '%s' of user '%d': %v", name, user.ID, err)
+
+			return model.TagFragment{}, false, err
+		}
+	}
+
+	relationshipSlice, err := service.FetchExistenceSlice(repository.connection, database.TableTagFragments, fmt.Sprintf("id=%d AND id IN (SELECT tag FROM %s WHERE %s=%d)", tag.ID, taggable.bridge, taggable.Column, id))
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch relationship between %s '%d' and tag '%d': %v", materialType, id, tag.ID, err)
+
+		return model.TagFragment{}, false, err
+	}
+
+	if len(relationshipSlice) > 0 {
+		return tag, false, nil
+	}
+
+	err = service.StoreRelationship(repository.connection, taggable.bridge, taggable.properties, pgx.NamedArgs{
+		taggable.Column: id,
+		"tag":           tag.ID,
+	})
+
+	if err != nil {
+		repository.logger.Printf("Unable to store relationship between %s '%d' and tag '%d': %v", materialType, id, tag.ID, err)
+
+		return model.TagFragment{}, false, err
+	}
+
+	return tag, true, nil
+}
+
+// Remove a tag name of the user with the provided reference from a material; the tag itself is kept.
+//
+// Return: whether the material was tagged and nil with success, false and error without.
+func (repository *Repository) UntagMaterial(reference string, materialType string, id int, name string) (bool, error) {
+	taggable, _ := lookup(materialType)
+
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return false, err
+	}
+
+	tag, err := repository.fetchTag(user.ID, name)
+
+	if err != nil || tag.ID == 0 {
+		return false, err
+	}
+
+	count, err := service.DeleteRelationship(repository.connection, taggable.bridge, fmt.Sprintf("%s=%d AND tag=%d", taggable.Column, id, tag.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to delete relationship between %s '%d' and tag '%d': %v", materialType, id, tag.ID, err)
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Delete a tag of the user with the provided reference, which removes it from every material.
+//
+// Return: whether the tag existed and nil with success, false and error without.
+func (repository *Repository) DeleteTag(reference string, id int) (bool, error) {
+	user, err := repository.users.ResolveUser(reference)
+
+	if err != nil {
+		return false, err
+	}
+
+	count, err := service.DeleteRelationship(repository.connection, database.TableTagFragments, fmt.Sprintf("id=%d AND owner=%d", id, user.ID))
+
+	if err != nil {
+		repository.logger.Printf("Unable to delete tag '%d' of user '%d': %v", id, user.ID, err)
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	model "github.com/muzzarellimj/grace-material-api/internal/model/third_party/boardgamegeek.com"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+const (
+	BGGBase           = "https://boardgamegeek.com/xmlapi2"
+	BGGEndpointThing  = "/thing"
+	BGGEndpointSearch = "/search"
+	BGGTypeBoardGame  = "boardgame"
+)
+
+// Get a BoardGameGeek XML API2 resource with a provided model to decode to and query parameters.
+//
+// Return: decoded model and nil with success, empty model and error without. An empty model without error indicates no
+// resource matched the query.
+func BGGGetResource[M interface{}](client *Client, endpoint string, queryParams map[string]string) (M, error) {
+	var zero M
+
+	path, err := util.CreateRequestPath(client.base, endpoint, "", queryParams)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, endpoint, err)
+
+		return zero, err
+	}
+
+	headers := map[string]string{
+		"Accept": "application/xml",
+	}
+
+	if client.token != "" {
+		headers["Authorization"] = fmt.Sprint("Bearer ", client.token)
+	}
+
+	request, err := util.CreateRequest(http.MethodGet, path, []byte{}, headers)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create '%s' request to '%s': %v\n", http.MethodGet, path, err)
+
+		return zero, err
+	}
+
+	response, err := util.ExecuteClientRequest(client.client, request)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
+
+		return zero, err
+	}
+
+	if response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusBadRequest {
+		fmt.Fprintf(os.Stdout, "Unable to find BoardGameGeek resource at '%s'.\n", request.URL.String())
+
+		return zero, nil
+	}
+
+	// BoardGameGeek responds 202 Accepted while a request is queued and 429 Too Many Requests when rate limited, which
+	// are surfaced as errors so the request may be retried later.
+	if response.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected BoardGameGeek response status '%d'", response.StatusCode)
+
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
+
+		return zero, err
+	}
+
+	var resource M
+
+	err = xml.NewDecoder(response.Body).Decode(&resource)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to decode response to provided resource model: %v\n", err)
+
+		return zero, err
+	}
+
+	return resource, nil
+}
+
+// Get a board game with a provided BoardGameGeek numeric identifier, including its designers, publishers, mechanics,
+// and categories as links.
+//
+// Return: decoded thing and nil with success, empty thing and error without. An empty thing without error indicates no
+// board game matched the identifier.
+func (client *Client) BGGGetBoardGame(id string) (model.BGGThing, error) {
+	response, err := BGGGetResource[model.BGGThingResponse](client, BGGEndpointThing, map[string]string{"id": id, "type": BGGTypeBoardGame})
+
+	if err != nil || len(response.Items) == 0 {
+		return model.BGGThing{}, err
+	}
+
+	return response.Items[0], nil
+}
+
+// Search board games by name, which is escaped.
+//
+// Return: decoded search response and nil with success, empty search response and error without.
+func (client *Client) BGGSearchBoardGame(query string) (model.BGGSearchResponse, error) {
+	return BGGGetResource[model.BGGSearchResponse](client, BGGEndpointSearch, map[string]string{"query": url.QueryEscape(query), "type": BGGTypeBoardGame})
+}
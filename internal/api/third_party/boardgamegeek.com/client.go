@@ -0,0 +1,33 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+// A BoardGameGeek client, which holds the base URL, application token, and HTTP client shared across requests.
+type Client struct {
+	base   string
+	token  string
+	client *http.Client
+}
+
+// Create a BoardGameGeek client with provided configuration and request timeout; an empty base URL defaults to
+// BGGBase.
+//
+// Return: configured client.
+func NewClient(configuration config.BGGConfig, timeout time.Duration) *Client {
+	client := &Client{
+		base:   configuration.Base,
+		token:  configuration.Token,
+		client: &http.Client{Timeout: timeout},
+	}
+
+	if client.base == "" {
+		client.base = BGGBase
+	}
+
+	return client
+}
@@ -0,0 +1,191 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	model "github.com/muzzarellimj/grace-material-api/internal/model/third_party/comicvine.gamespot.com"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+const (
+	CVBase            = "https://comicvine.gamespot.com/api"
+	CVUserAgent       = "grace-material-api/1.0 ( https://github.com/muzzarellimj/grace-material-api )"
+	CVEndpointVolume  = "/volume"
+	CVEndpointIssue   = "/issue"
+	CVEndpointIssues  = "/issues/"
+	CVEndpointSearch  = "/search/"
+	CVResourceVolume  = "volume"
+	CVPrefixVolume    = "4050"
+	CVPrefixIssue     = "4000"
+	CVPageLimit       = 100
+	CVStatusOK        = 1
+	CVStatusNotFound  = 101
+	CVVolumeFieldList = "id,name,deck,description,start_year,count_of_issues,image,publisher,first_issue"
+	CVIssueFieldList  = "id,name,issue_number,cover_date,image,volume"
+	CVSearchFieldList = "id,name,start_year,count_of_issues,image,publisher"
+)
+
+// Get a Comic Vine resource with a provided result model to decode to, endpoint, identifier (empty for a list or
+// search), and query parameters.
+//
+// Return: decoded response and nil with success, empty response and error without. An empty response without error
+// indicates no resource matched the identifier.
+func CVGetResource[R interface{}](client *Client, endpoint string, id string, queryParams map[string]string) (model.CVResponse[R], error) {
+	var zero model.CVResponse[R]
+
+	queryParams["api_key"] = client.key
+	queryParams["format"] = "json"
+
+	path, err := util.CreateRequestPath(client.base, endpoint, id, queryParams)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, endpoint, err)
+
+		return zero, err
+	}
+
+	// Comic Vine rejects requests with a generic user agent, so every request identifies the application.
+	request, err := util.CreateRequest(http.MethodGet, path, []byte{}, map[string]string{
+		"Accept":     "application/json",
+		"User-Agent": CVUserAgent,
+	})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create '%s' request to '%s%s': %v\n", http.MethodGet, client.base, endpoint, err)
+
+		return zero, err
+	}
+
+	response, err := util.ExecuteClientRequest(client.client, request)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s%s': %v\n", request.Method, client.base, endpoint, err)
+
+		return zero, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		fmt.Fprintf(os.Stdout, "Unable to find Comic Vine resource at '%s%s'.\n", endpoint, id)
+
+		return zero, nil
+	}
+
+	if response.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected Comic Vine response status '%d'", response.StatusCode)
+
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s%s': %v\n", request.Method, client.base, endpoint, err)
+
+		return zero, err
+	}
+
+	// Comic Vine responds with an empty results array when no resource matches an identifier, which cannot be decoded
+	// to a resource model, so results are decoded only after the status code is checked.
+	var envelope model.CVResponse[json.RawMessage]
+
+	err = json.NewDecoder(response.Body).Decode(&envelope)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to decode response to Comic Vine response model: %v\n", err)
+
+		return zero, err
+	}
+
+	if envelope.StatusCode == CVStatusNotFound {
+		fmt.Fprintf(os.Stdout, "Unable to find Comic Vine resource at '%s%s'.\n", endpoint, id)
+
+		return zero, nil
+	}
+
+	if envelope.StatusCode != CVStatusOK {
+		err := fmt.Errorf("unexpected Comic Vine status code '%d': %s", envelope.StatusCode, envelope.Error)
+
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s%s': %v\n", request.Method, client.base, endpoint, err)
+
+		return zero, err
+	}
+
+	resource := model.CVResponse[R]{
+		Error:                envelope.Error,
+		StatusCode:           envelope.StatusCode,
+		Limit:                envelope.Limit,
+		Offset:               envelope.Offset,
+		NumberOfPageResults:  envelope.NumberOfPageResults,
+		NumberOfTotalResults: envelope.NumberOfTotalResults,
+	}
+
+	err = json.Unmarshal(envelope.Results, &resource.Results)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to decode response to provided resource model: %v\n", err)
+
+		return zero, err
+	}
+
+	return resource, nil
+}
+
+// Get a volume (i.e., a single run of a series) with a provided Comic Vine numeric identifier.
+//
+// Return: decoded volume and nil with success, empty volume and error without. An empty volume without error indicates
+// no volume matched the identifier.
+func (client *Client) CVGetVolume(id string) (model.CVVolume, error) {
+	response, err := CVGetResource[model.CVVolume](client, CVEndpointVolume, fmt.Sprintf("%s-%s/", CVPrefixVolume, id), map[string]string{"field_list": CVVolumeFieldList})
+
+	return response.Results, err
+}
+
+// Get an issue with a provided Comic Vine numeric identifier, including its credited people and their roles.
+//
+// Return: decoded issue and nil with success, empty issue and error without. An empty issue without error indicates no
+// issue matched the identifier.
+func (client *Client) CVGetIssue(id string) (model.CVIssue, error) {
+	response, err := CVGetResource[model.CVIssue](client, CVEndpointIssue, fmt.Sprintf("%s-%s/", CVPrefixIssue, id), map[string]string{})
+
+	return response.Results, err
+}
+
+// Get every issue of a volume with a provided Comic Vine numeric identifier, which are fetched a page at a time.
+//
+// Return: decoded issue slice and nil with success, empty slice and error without.
+func (client *Client) CVGetVolumeIssues(id string) ([]model.CVIssue, error) {
+	var issueSlice []model.CVIssue
+
+	for offset := 0; ; offset += CVPageLimit {
+		response, err := CVGetResource[[]model.CVIssue](client, CVEndpointIssues, "", map[string]string{
+			"filter":     fmt.Sprint("volume:", id),
+			"field_list": CVIssueFieldList,
+			"limit":      strconv.Itoa(CVPageLimit),
+			"offset":     strconv.Itoa(offset),
+		})
+
+		if err != nil {
+			return []model.CVIssue{}, err
+		}
+
+		issueSlice = append(issueSlice, response.Results...)
+
+		if len(response.Results) < CVPageLimit || len(issueSlice) >= response.NumberOfTotalResults {
+			break
+		}
+	}
+
+	return issueSlice, nil
+}
+
+// Search volumes by name, which is escaped.
+//
+// Return: decoded search result slice and nil with success, empty slice and error without.
+func (client *Client) CVSearchVolume(query string) ([]model.CVSearchResult, error) {
+	response, err := CVGetResource[[]model.CVSearchResult](client, CVEndpointSearch, "", map[string]string{
+		"query":      url.QueryEscape(query),
+		"resources":  CVResourceVolume,
+		"field_list": CVSearchFieldList,
+	})
+
+	return response.Results, err
+}
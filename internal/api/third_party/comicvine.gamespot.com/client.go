@@ -0,0 +1,32 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+// A Comic Vine client, which holds the base URL, API key, and HTTP client shared across requests.
+type Client struct {
+	base   string
+	key    string
+	client *http.Client
+}
+
+// Create a Comic Vine client with provided configuration and request timeout; an empty base URL defaults to CVBase.
+//
+// Return: configured client.
+func NewClient(configuration config.ComicVineConfig, timeout time.Duration) *Client {
+	client := &Client{
+		base:   configuration.Base,
+		key:    configuration.APIKey,
+		client: &http.Client{Timeout: timeout},
+	}
+
+	if client.base == "" {
+		client.base = CVBase
+	}
+
+	return client
+}
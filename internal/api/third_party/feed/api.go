@@ -0,0 +1,100 @@
+package api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	model "github.com/muzzarellimj/grace-material-api/internal/model/third_party/feed"
+	"golang.org/x/net/html/charset"
+)
+
+const (
+	FeedUserAgent   = "grace-material-api/1.0 ( https://github.com/muzzarellimj/grace-material-api )"
+	FeedAccept      = "application/rss+xml, application/atom+xml, application/xml;q=0.9, text/xml;q=0.8"
+	MaximumFeedSize = 32 << 20
+)
+
+// Get a podcast feed document with a provided feed URL, which must be an absolute HTTP or HTTPS URL, where a feed
+// larger than MaximumFeedSize is truncated and fails to decode.
+//
+// Return: decoded feed document and nil with success, empty document and error without. An empty document without
+// error indicates no feed was found at the URL.
+func (client *Client) FeedGetDocument(feedURL string) (model.FeedDocument, error) {
+	var zero model.FeedDocument
+
+	parsed, err := url.Parse(feedURL)
+
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		err := fmt.Errorf("invalid feed URL '%s'", feedURL)
+
+		fmt.Fprintf(os.Stderr, "Unable to create request to feed: %v\n", err)
+
+		return zero, err
+	}
+
+	request, err := http.NewRequest(http.MethodGet, parsed.String(), nil)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create '%s' request to '%s': %v\n", http.MethodGet, feedURL, err)
+
+		return zero, err
+	}
+
+	request.Header.Set("Accept", FeedAccept)
+	request.Header.Set("User-Agent", client.userAgent)
+
+	response, err := client.client.Do(request)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, feedURL, err)
+
+		return zero, err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusGone {
+		fmt.Fprintf(os.Stdout, "Unable to find feed at '%s'.\n", feedURL)
+
+		return zero, nil
+	}
+
+	if response.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected feed response status '%d'", response.StatusCode)
+
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, feedURL, err)
+
+		return zero, err
+	}
+
+	var document model.FeedDocument
+
+	// Feeds are not always encoded in UTF-8 (e.g., 'ISO-8859-1' in the XML declaration), so the decoder converts other
+	// declared encodings, and HTML entities common in hand-written feeds (e.g., '&nbsp;') are tolerated.
+	decoder := xml.NewDecoder(io.LimitReader(response.Body, MaximumFeedSize))
+	decoder.CharsetReader = charset.NewReaderLabel
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+
+	err = decoder.Decode(&document)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to decode response to feed document model: %v\n", err)
+
+		return zero, err
+	}
+
+	if document.XMLName.Local != "rss" && document.XMLName.Local != "feed" {
+		err := fmt.Errorf("unsupported feed document root '%s'", document.XMLName.Local)
+
+		fmt.Fprintf(os.Stderr, "Unable to decode response to feed document model: %v\n", err)
+
+		return zero, err
+	}
+
+	return document, nil
+}
@@ -0,0 +1,31 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+// A podcast feed client, which holds the user agent and HTTP client shared across requests.
+type Client struct {
+	userAgent string
+	client    *http.Client
+}
+
+// Create a podcast feed client with provided configuration and request timeout; an empty user agent defaults to
+// FeedUserAgent.
+//
+// Return: configured client.
+func NewClient(configuration config.PodcastConfig, timeout time.Duration) *Client {
+	client := &Client{
+		userAgent: configuration.UserAgent,
+		client:    &http.Client{Timeout: timeout},
+	}
+
+	if client.userAgent == "" {
+		client.userAgent = FeedUserAgent
+	}
+
+	return client
+}
@@ -6,12 +6,14 @@ import (
 	"net/http"
 	"os"
 
+	model "github.com/muzzarellimj/grace-material-api/internal/model/third_party/igdb.com"
 	"github.com/muzzarellimj/grace-material-api/internal/util"
 )
 
 const (
 	IGDBEndpointCompany         = "/v4/companies"
 	IGDBEndpointCover           = "/v4/covers"
+	IGDBEndpointExternalGame    = "/v4/external_games"
 	IGDBEndpointFranchise       = "/v4/franchises"
 	IGDBEndpointGame            = "/v4/games"
 	IGDBEndpointGenre           = "/v4/genres"
@@ -19,13 +21,18 @@ const (
 	IGDBEndpointPlatform        = "/v4/platforms"
 )
 
+// IGDB external game categories, which identify the service an external game identifier belongs to.
+const (
+	IGDBExternalGameCategorySteam = 1
+)
+
 // Get an IGDB resource slice with a provided model to decode to and an Apicalypse-compliant constraint.
 //
 // Return: decoded model slice and nil with success, empty model slice and error without.
-func IGDBGetResourceSlice[M interface{}](endpoint string, constraint string) ([]M, error) {
+func IGDBGetResourceSlice[M interface{}](client *Client, endpoint string, constraint string) ([]M, error) {
 	var zero []M
 
-	path, err := util.CreateRequestPath(os.Getenv("AWS_PROXY_HOST"), endpoint, "", map[string]string{})
+	path, err := util.CreateRequestPath(client.host, endpoint, "", map[string]string{})
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to create request path to AWS IGDB proxy at endpoint '%s': %v\n", endpoint, err)
@@ -34,7 +41,7 @@ func IGDBGetResourceSlice[M interface{}](endpoint string, constraint string) ([]
 	}
 
 	request, err := util.CreateRequest(http.MethodPost, path, []byte(constraint), map[string]string{
-		"x-api-key": os.Getenv("AWS_PROXY_API_KEY"),
+		"x-api-key": client.key,
 	})
 
 	if err != nil {
@@ -43,7 +50,7 @@ func IGDBGetResourceSlice[M interface{}](endpoint string, constraint string) ([]
 		return zero, err
 	}
 
-	response, err := util.ExecuteRequest(request)
+	response, err := util.ExecuteClientRequest(client.client, request)
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to execute request to AWS IGDB proxy at endpoint '%s': %v\n", endpoint, err)
@@ -67,10 +74,10 @@ func IGDBGetResourceSlice[M interface{}](endpoint string, constraint string) ([]
 // Get one IGDB resource with a provided model to decode to and an Apicalyps-compliant constraint.
 //
 // Return: decoded model and nil with success, empty model and error without.
-func IGDBGetResource[M interface{}](endpoint string, constraint string) (M, error) {
+func IGDBGetResource[M interface{}](client *Client, endpoint string, constraint string) (M, error) {
 	var zero M
 
-	resourceSlice, err := IGDBGetResourceSlice[M](endpoint, constraint)
+	resourceSlice, err := IGDBGetResourceSlice[M](client, endpoint, constraint)
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to get and decode initial resource slice: %v\n", err)
@@ -84,3 +91,45 @@ func IGDBGetResource[M interface{}](endpoint string, constraint string) (M, erro
 
 	return zero, nil
 }
+
+// Get a game with a provided IGDB numeric identifier, including nested franchises, genres, involved companies, and
+// platforms.
+//
+// Return: decoded game response and nil with success, empty game response and error without.
+func (client *Client) IGDBGetGame(id string) (model.IGDBGameResponse, error) {
+	return IGDBGetResource[model.IGDBGameResponse](client, IGDBEndpointGame, fmt.Sprintf("fields id,cover.*,first_release_date,franchises.*,genres.*,involved_companies.*,name,platforms.*,release_dates.date,release_dates.platform,release_dates.region,storyline,summary; where id=%s;", id))
+}
+
+// Search main games (i.e., excluding DLC, bundles, etc.) by name.
+//
+// Return: decoded game search response slice and nil with success, empty slice and error without.
+func (client *Client) IGDBSearchGame(query string) ([]model.IGDBGameSearchResponse, error) {
+	return IGDBGetResourceSlice[model.IGDBGameSearchResponse](client, IGDBEndpointGame, fmt.Sprintf(`fields id,name,cover.*,first_release_date; search "%s"; where (status=0 | status=null) & category=0;`, query))
+}
+
+// Get the games similar to a game with a provided IGDB numeric identifier, as determined by IGDB.
+//
+// Return: decoded game search response slice and nil with success, empty slice and error without.
+func (client *Client) IGDBGetSimilarGames(id int) ([]model.IGDBGameSearchResponse, error) {
+	game, err := IGDBGetResource[model.IGDBSimilarGamesResponse](client, IGDBEndpointGame, fmt.Sprintf("fields id,similar_games.id,similar_games.name,similar_games.cover.*,similar_games.first_release_date; where id=%d;", id))
+
+	if err != nil {
+		return []model.IGDBGameSearchResponse{}, err
+	}
+
+	return game.SimilarGames, nil
+}
+
+// Get the external game records of a Steam application identifier, each referencing an IGDB game.
+//
+// Return: decoded external game response slice and nil with success, empty slice and error without.
+func (client *Client) IGDBGetSteamGameSlice(appid string) ([]model.IGDBExternalGameResponse, error) {
+	return IGDBGetResourceSlice[model.IGDBExternalGameResponse](client, IGDBEndpointExternalGame, fmt.Sprintf(`fields id,game,uid; where category=%d & uid="%s";`, IGDBExternalGameCategorySteam, appid))
+}
+
+// Get a company with a provided IGDB numeric identifier.
+//
+// Return: decoded company response and nil with success, empty company response and error without.
+func (client *Client) IGDBGetCompany(id int) (model.IGDBCompanyResponse, error) {
+	return IGDBGetResource[model.IGDBCompanyResponse](client, IGDBEndpointCompany, fmt.Sprintf("fields id,name,description; where id=%d;", id))
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+// An IGDB client, which holds the AWS IGDB proxy host, proxy API key, and HTTP client shared across requests.
+type Client struct {
+	host   string
+	key    string
+	client *http.Client
+}
+
+// Create an IGDB client with provided configuration and request timeout.
+//
+// Return: configured client.
+func NewClient(configuration config.IGDBConfig, timeout time.Duration) *Client {
+	return &Client{
+		host:   configuration.Host,
+		key:    configuration.APIKey,
+		client: &http.Client{Timeout: timeout},
+	}
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	model "github.com/muzzarellimj/grace-material-api/internal/model/third_party/musicbrainz.org"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+const (
+	MBBase                 = "https://musicbrainz.org/ws/2"
+	MBUserAgent            = "grace-material-api/1.0 ( https://github.com/muzzarellimj/grace-material-api )"
+	MBEndpointRelease      = "/release"
+	MBEndpointReleaseGroup = "/release-group"
+)
+
+const (
+	CAABase         = "https://coverartarchive.org"
+	CAARouteRelease = "release"
+	CAARouteFront   = "front"
+)
+
+// Subqueries included with a release lookup (i.e., artist credits, labels, track lists, release group, genres, and tags).
+const MBReleaseIncludes = "artists+labels+recordings+release-groups+genres+tags"
+
+// Get a MusicBrainz resource with a provided model to decode to, identifier (empty for a search), and query parameters.
+//
+// Return: decoded model and nil with success, empty model and error without. An empty model without error indicates no
+// resource matched the identifier.
+func MBGetResource[M interface{}](client *Client, endpoint string, id string, queryParams map[string]string) (M, error) {
+	var zero M
+
+	queryParams["fmt"] = "json"
+
+	path, err := util.CreateRequestPath(client.base, endpoint, id, queryParams)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, endpoint, err)
+
+		return zero, err
+	}
+
+	request, err := util.CreateRequest(http.MethodGet, path, []byte{}, map[string]string{
+		"Accept":     "application/json",
+		"User-Agent": client.userAgent,
+	})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create '%s' request to '%s': %v\n", http.MethodGet, path, err)
+
+		return zero, err
+	}
+
+	response, err := util.ExecuteClientRequest(client.client, request)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
+
+		return zero, err
+	}
+
+	if response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusBadRequest {
+		fmt.Fprintf(os.Stdout, "Unable to find MusicBrainz resource at '%s'.\n", request.URL.String())
+
+		return zero, nil
+	}
+
+	if response.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected MusicBrainz response status '%d'", response.StatusCode)
+
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
+
+		return zero, err
+	}
+
+	var resource M
+
+	err = json.NewDecoder(response.Body).Decode(&resource)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to decode response to provided resource model: %v\n", err)
+
+		return zero, err
+	}
+
+	return resource, nil
+}
+
+// Get a release (i.e., a particular issue of an album, such as a vinyl pressing) with a provided MBID, including its
+// artists, labels, track lists, release group, genres, and tags.
+//
+// Return: decoded release response and nil with success, empty release response and error without.
+func (client *Client) MBGetRelease(id string) (model.MBReleaseResponse, error) {
+	return MBGetResource[model.MBReleaseResponse](client, MBEndpointRelease, id, map[string]string{"inc": MBReleaseIncludes})
+}
+
+// Get a release group (i.e., an album across its releases) with a provided MBID, including its releases.
+//
+// Return: decoded release group response and nil with success, empty release group response and error without.
+func (client *Client) MBGetReleaseGroup(id string) (model.MBReleaseGroupResponse, error) {
+	return MBGetResource[model.MBReleaseGroupResponse](client, MBEndpointReleaseGroup, id, map[string]string{"inc": "releases"})
+}
+
+// Search release groups by title or artist with a Lucene query, which is escaped.
+//
+// Return: decoded release group search response and nil with success, empty search response and error without.
+func (client *Client) MBSearchReleaseGroup(query string) (model.MBReleaseGroupSearchResponse, error) {
+	return MBGetResource[model.MBReleaseGroupSearchResponse](client, MBEndpointReleaseGroup, "", map[string]string{"query": url.QueryEscape(query)})
+}
+
+// Search releases by barcode (i.e., UPC or EAN).
+//
+// Return: decoded release search response and nil with success, empty search response and error without.
+func (client *Client) MBSearchBarcode(barcode string) (model.MBReleaseSearchResponse, error) {
+	return MBGetResource[model.MBReleaseSearchResponse](client, MBEndpointRelease, "", map[string]string{"query": fmt.Sprint("barcode:", barcode)})
+}
+
+// Create the Cover Art Archive URL of the front cover of a release with a provided MBID.
+//
+// Return: front cover URL.
+func (client *Client) CAAFrontCover(id string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", client.coverArtBase, CAARouteRelease, id, CAARouteFront)
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+// A MusicBrainz client, which holds the base URL, user agent, Cover Art Archive base URL, and HTTP client shared across
+// requests.
+type Client struct {
+	base         string
+	userAgent    string
+	coverArtBase string
+	client       *http.Client
+}
+
+// Create a MusicBrainz client with provided configuration and request timeout; an empty base URL, user agent, or Cover
+// Art Archive base URL defaults to MBBase, MBUserAgent, or CAABase, respectively.
+//
+// Return: configured client.
+func NewClient(configuration config.MusicBrainzConfig, timeout time.Duration) *Client {
+	client := &Client{
+		base:         configuration.Base,
+		userAgent:    configuration.UserAgent,
+		coverArtBase: configuration.CoverArtBase,
+		client:       &http.Client{Timeout: timeout},
+	}
+
+	if client.base == "" {
+		client.base = MBBase
+	}
+
+	if client.userAgent == "" {
+		client.userAgent = MBUserAgent
+	}
+
+	if client.coverArtBase == "" {
+		client.coverArtBase = CAABase
+	}
+
+	return client
+}
@@ -22,7 +22,7 @@ const (
 // Get an authority control figure which can include a name, viographical information, and image, among other items.
 //
 // Return: decoded author response and nil with sucess, empty author response and error without.
-func OLGetAuthor(id string) (model.OLAuthorResponse, error) {
+func (client *Client) OLGetAuthor(id string) (model.OLAuthorResponse, error) {
 	var zero model.OLAuthorResponse
 
 	if id == "" {
@@ -33,10 +33,10 @@ func OLGetAuthor(id string) (model.OLAuthorResponse, error) {
 		return zero, err
 	}
 
-	path, err := util.CreateRequestPath(OLBase, OLEndpointAuthor, fmt.Sprint(id, ".json"), map[string]string{})
+	path, err := util.CreateRequestPath(client.base, OLEndpointAuthor, fmt.Sprint(id, ".json"), map[string]string{})
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", OLBase, OLEndpointAuthor, err)
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, OLEndpointAuthor, err)
 
 		return zero, err
 	}
@@ -49,7 +49,7 @@ func OLGetAuthor(id string) (model.OLAuthorResponse, error) {
 		return zero, err
 	}
 
-	response, err := util.ExecuteRequest(request)
+	response, err := util.ExecuteClientRequest(client.client, request)
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
@@ -79,7 +79,7 @@ func OLGetAuthor(id string) (model.OLAuthorResponse, error) {
 // Get the material record unique to an ISBN where data may differ between other editions of the same work.
 //
 // Return: decoded edition response and nil with success, empty edition response and error without.
-func OLGetEdition(id string) (model.OLEditionResponse, error) {
+func (client *Client) OLGetEdition(id string) (model.OLEditionResponse, error) {
 	var zero model.OLEditionResponse
 
 	if id == "" {
@@ -90,10 +90,10 @@ func OLGetEdition(id string) (model.OLEditionResponse, error) {
 		return zero, err
 	}
 
-	path, err := util.CreateRequestPath(OLBase, OLEndpointEdition, fmt.Sprint(id, ".json"), map[string]string{})
+	path, err := util.CreateRequestPath(client.base, OLEndpointEdition, fmt.Sprint(id, ".json"), map[string]string{})
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", OLBase, OLEndpointEdition, err)
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, OLEndpointEdition, err)
 
 		return zero, err
 	}
@@ -106,7 +106,7 @@ func OLGetEdition(id string) (model.OLEditionResponse, error) {
 		return zero, err
 	}
 
-	response, err := util.ExecuteRequest(request)
+	response, err := util.ExecuteClientRequest(client.client, request)
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
@@ -137,7 +137,7 @@ func OLGetEdition(id string) (model.OLEditionResponse, error) {
 // may differ in language, version, cover, etc.
 //
 // Return: decoded work response and nil with success, empty work response and error without.
-func OLGetWork(id string) (model.OLWorkResponse, error) {
+func (client *Client) OLGetWork(id string) (model.OLWorkResponse, error) {
 	var zero model.OLWorkResponse
 
 	if id == "" {
@@ -148,10 +148,10 @@ func OLGetWork(id string) (model.OLWorkResponse, error) {
 		return zero, err
 	}
 
-	path, err := util.CreateRequestPath(OLBase, OLEndpointWork, fmt.Sprint(id, ".json"), map[string]string{})
+	path, err := util.CreateRequestPath(client.base, OLEndpointWork, fmt.Sprint(id, ".json"), map[string]string{})
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", OLBase, OLEndpointWork, err)
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, OLEndpointWork, err)
 
 		return zero, err
 	}
@@ -164,7 +164,7 @@ func OLGetWork(id string) (model.OLWorkResponse, error) {
 		return zero, err
 	}
 
-	response, err := util.ExecuteRequest(request)
+	response, err := util.ExecuteClientRequest(client.client, request)
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
@@ -191,7 +191,11 @@ func OLGetWork(id string) (model.OLWorkResponse, error) {
 	return work, nil
 }
 
-func OLSearchBook(query string) (model.OLBookSearchResponse, error) {
+// Search editions by title, author, or ISBN, optionally limited to editions in a MARC language (e.g., "eng"), where an
+// empty language searches editions in every language.
+//
+// Return: decoded search response and nil with success, empty search response and error without.
+func (client *Client) OLSearchBook(query string, language string) (model.OLBookSearchResponse, error) {
 	var zero model.OLBookSearchResponse
 
 	if query == "" {
@@ -202,10 +206,14 @@ func OLSearchBook(query string) (model.OLBookSearchResponse, error) {
 		return zero, err
 	}
 
-	path, err := util.CreateRequestPath(OLBase, fmt.Sprint(OLEndpointSearch, ".json"), "", map[string]string{"q": fmt.Sprint(query, " language:eng")})
+	if language != "" {
+		query = fmt.Sprintf("%s language:%s", query, language)
+	}
+
+	path, err := util.CreateRequestPath(client.base, fmt.Sprint(OLEndpointSearch, ".json"), "", map[string]string{"q": query})
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", OLBase, OLEndpointSearch, err)
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, OLEndpointSearch, err)
 
 		return zero, err
 	}
@@ -218,7 +226,7 @@ func OLSearchBook(query string) (model.OLBookSearchResponse, error) {
 		return zero, err
 	}
 
-	response, err := util.ExecuteRequest(request)
+	response, err := util.ExecuteClientRequest(client.client, request)
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
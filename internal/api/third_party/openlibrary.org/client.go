@@ -0,0 +1,30 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+// An OpenLibrary client, which holds the base URL and HTTP client shared across requests.
+type Client struct {
+	base   string
+	client *http.Client
+}
+
+// Create an OpenLibrary client with provided configuration and request timeout; an empty base URL defaults to OLBase.
+//
+// Return: configured client.
+func NewClient(configuration config.OpenLibraryConfig, timeout time.Duration) *Client {
+	base := configuration.Base
+
+	if base == "" {
+		base = OLBase
+	}
+
+	return &Client{
+		base:   base,
+		client: &http.Client{Timeout: timeout},
+	}
+}
@@ -14,21 +14,29 @@ const (
 	TMDBBase                = "https://api.themoviedb.org/3"
 	TMDBEndpointMovie       = "/movie"
 	TMDBEndpointSearchMovie = "/search/movie"
+	TMDBEndpointShow        = "/tv"
+	TMDBEndpointSearchShow  = "/search/tv"
 )
 
-// Get the top-level details of a movie with a provided numeric identifier.
+const (
+	TMDBRouteCredits         = "credits"
+	TMDBRouteRecommendations = "recommendations"
+	TMDBRouteSeason          = "season"
+)
+
+// Get the top-level details and credits (i.e., cast and crew) of a movie with a provided numeric identifier.
 //
 // Return: decoded movie detail response and nil with success, empty movie detail response and error without.
-func TMDBGetMovie(id string) (model.TMDBMovieDetailResponse, error) {
-	path, err := util.CreateRequestPath(TMDBBase, TMDBEndpointMovie, id, map[string]string{})
+func (client *Client) TMDBGetMovie(id string) (model.TMDBMovieDetailResponse, error) {
+	path, err := util.CreateRequestPath(client.base, TMDBEndpointMovie, id, map[string]string{"append_to_response": TMDBRouteCredits})
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", TMDBBase, TMDBEndpointMovie, err)
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, TMDBEndpointMovie, err)
 
 		return model.TMDBMovieDetailResponse{}, err
 	}
 
-	request, err := util.CreateRequest(http.MethodGet, path, []byte{}, map[string]string{"Authorization": fmt.Sprint("Bearer ", os.Getenv("TMDB_API_KEY"))})
+	request, err := util.CreateRequest(http.MethodGet, path, []byte{}, map[string]string{"Authorization": fmt.Sprint("Bearer ", client.key)})
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to create '%s' request to '%s': %v\n", http.MethodGet, path, err)
@@ -36,7 +44,7 @@ func TMDBGetMovie(id string) (model.TMDBMovieDetailResponse, error) {
 		return model.TMDBMovieDetailResponse{}, err
 	}
 
-	response, err := util.ExecuteRequest(request)
+	response, err := util.ExecuteClientRequest(client.client, request)
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
@@ -60,16 +68,16 @@ func TMDBGetMovie(id string) (model.TMDBMovieDetailResponse, error) {
 // Search movies by original, translated, or alternative title.
 //
 // Return: decoded movie search response and nil with success, empty movie search response and nil without.
-func TMDBSearchMovie(title string) (model.TMDBMovieSearchResponse, error) {
-	path, err := util.CreateRequestPath(TMDBBase, TMDBEndpointSearchMovie, "", map[string]string{"query": title, "language": "en-US"})
+func (client *Client) TMDBSearchMovie(title string) (model.TMDBMovieSearchResponse, error) {
+	path, err := util.CreateRequestPath(client.base, TMDBEndpointSearchMovie, "", map[string]string{"query": title, "language": "en-US"})
 
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", TMDBBase, TMDBEndpointSearchMovie, err)
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, TMDBEndpointSearchMovie, err)
 
 		return model.TMDBMovieSearchResponse{}, err
 	}
 
-	request, err := util.CreateRequest(http.MethodGet, path, []byte{}, map[string]string{"Authorization": fmt.Sprint("Bearer ", os.Getenv("TMDB_API_KEY"))})
+	request, err := util.CreateRequest(http.MethodGet, path, []byte{}, map[string]string{"Authorization": fmt.Sprint("Bearer ", client.key)})
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to create '%s' request to '%s': %v\n", http.MethodGet, path, err)
@@ -77,7 +85,7 @@ func TMDBSearchMovie(title string) (model.TMDBMovieSearchResponse, error) {
 		return model.TMDBMovieSearchResponse{}, err
 	}
 
-	response, err := util.ExecuteRequest(request)
+	response, err := util.ExecuteClientRequest(client.client, request)
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
@@ -97,3 +105,167 @@ func TMDBSearchMovie(title string) (model.TMDBMovieSearchResponse, error) {
 
 	return searchResult, nil
 }
+
+// Get the movies recommended alongside a movie with a provided numeric identifier, as determined by TMDB.
+//
+// Return: decoded movie search response and nil with success, empty movie search response and error without.
+func (client *Client) TMDBGetMovieRecommendations(id string) (model.TMDBMovieSearchResponse, error) {
+	path, err := util.CreateRequestPath(client.base, TMDBEndpointMovie, fmt.Sprintf("%s/%s", id, TMDBRouteRecommendations), map[string]string{"language": "en-US"})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, TMDBEndpointMovie, err)
+
+		return model.TMDBMovieSearchResponse{}, err
+	}
+
+	request, err := util.CreateRequest(http.MethodGet, path, []byte{}, map[string]string{"Authorization": fmt.Sprint("Bearer ", client.key)})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create '%s' request to '%s': %v\n", http.MethodGet, path, err)
+
+		return model.TMDBMovieSearchResponse{}, err
+	}
+
+	response, err := util.ExecuteClientRequest(client.client, request)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
+
+		return model.TMDBMovieSearchResponse{}, err
+	}
+
+	var recommendations model.TMDBMovieSearchResponse
+
+	err = json.NewDecoder(response.Body).Decode(&recommendations)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to decode response as movie recommendation model: %v\n", err)
+
+		return model.TMDBMovieSearchResponse{}, err
+	}
+
+	return recommendations, nil
+}
+
+// Get the top-level details and season summaries of a show with a provided numeric identifier.
+//
+// Return: decoded show detail response and nil with success, empty show detail response and error without.
+func (client *Client) TMDBGetShow(id string) (model.TMDBShowDetailResponse, error) {
+	path, err := util.CreateRequestPath(client.base, TMDBEndpointShow, id, map[string]string{"language": "en-US"})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, TMDBEndpointShow, err)
+
+		return model.TMDBShowDetailResponse{}, err
+	}
+
+	request, err := util.CreateRequest(http.MethodGet, path, []byte{}, map[string]string{"Authorization": fmt.Sprint("Bearer ", client.key)})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create '%s' request to '%s': %v\n", http.MethodGet, path, err)
+
+		return model.TMDBShowDetailResponse{}, err
+	}
+
+	response, err := util.ExecuteClientRequest(client.client, request)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
+
+		return model.TMDBShowDetailResponse{}, err
+	}
+
+	var show model.TMDBShowDetailResponse
+
+	err = json.NewDecoder(response.Body).Decode(&show)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to decode response as show detail model: %v\n", err)
+
+		return model.TMDBShowDetailResponse{}, err
+	}
+
+	return show, nil
+}
+
+// Get the details and episodes of a season, by number, of a show with a provided numeric identifier.
+//
+// Return: decoded season detail response and nil with success, empty season detail response and error without.
+func (client *Client) TMDBGetSeason(id string, number int) (model.TMDBSeasonDetailResponse, error) {
+	path, err := util.CreateRequestPath(client.base, TMDBEndpointShow, fmt.Sprintf("%s/%s/%d", id, TMDBRouteSeason, number), map[string]string{"language": "en-US"})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, TMDBEndpointShow, err)
+
+		return model.TMDBSeasonDetailResponse{}, err
+	}
+
+	request, err := util.CreateRequest(http.MethodGet, path, []byte{}, map[string]string{"Authorization": fmt.Sprint("Bearer ", client.key)})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create '%s' request to '%s': %v\n", http.MethodGet, path, err)
+
+		return model.TMDBSeasonDetailResponse{}, err
+	}
+
+	response, err := util.ExecuteClientRequest(client.client, request)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
+
+		return model.TMDBSeasonDetailResponse{}, err
+	}
+
+	var season model.TMDBSeasonDetailResponse
+
+	err = json.NewDecoder(response.Body).Decode(&season)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to decode response as season detail model: %v\n", err)
+
+		return model.TMDBSeasonDetailResponse{}, err
+	}
+
+	return season, nil
+}
+
+// Search shows by original, translated, or alternative name.
+//
+// Return: decoded show search response and nil with success, empty show search response and error without.
+func (client *Client) TMDBSearchShow(name string) (model.TMDBShowSearchResponse, error) {
+	path, err := util.CreateRequestPath(client.base, TMDBEndpointSearchShow, "", map[string]string{"query": name, "language": "en-US"})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create request path to '%s%s': %v\n", client.base, TMDBEndpointSearchShow, err)
+
+		return model.TMDBShowSearchResponse{}, err
+	}
+
+	request, err := util.CreateRequest(http.MethodGet, path, []byte{}, map[string]string{"Authorization": fmt.Sprint("Bearer ", client.key)})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create '%s' request to '%s': %v\n", http.MethodGet, path, err)
+
+		return model.TMDBShowSearchResponse{}, err
+	}
+
+	response, err := util.ExecuteClientRequest(client.client, request)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to execute '%s' request to '%s': %v\n", request.Method, request.URL.String(), err)
+
+		return model.TMDBShowSearchResponse{}, err
+	}
+
+	var searchResult model.TMDBShowSearchResponse
+
+	err = json.NewDecoder(response.Body).Decode(&searchResult)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to decode response as show search result model: %v\n", err)
+
+		return model.TMDBShowSearchResponse{}, err
+	}
+
+	return searchResult, nil
+}
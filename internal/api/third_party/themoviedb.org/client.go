@@ -0,0 +1,32 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+// A TMDB client, which holds the base URL, API key, and HTTP client shared across requests.
+type Client struct {
+	base   string
+	key    string
+	client *http.Client
+}
+
+// Create a TMDB client with provided configuration and request timeout; an empty base URL defaults to TMDBBase.
+//
+// Return: configured client.
+func NewClient(configuration config.TMDBConfig, timeout time.Duration) *Client {
+	base := configuration.Base
+
+	if base == "" {
+		base = TMDBBase
+	}
+
+	return &Client{
+		base:   base,
+		key:    configuration.APIKey,
+		client: &http.Client{Timeout: timeout},
+	}
+}
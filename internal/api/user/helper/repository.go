@@ -0,0 +1,75 @@
+package helper
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/user"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+// A user repository, which resolves authenticated principals to users in the provided database pool.
+type Repository struct {
+	connection database.PgxPool
+	logger     *log.Logger
+}
+
+// Create a user repository with a database pool and logger.
+//
+// Return: configured repository.
+func NewRepository(connection database.PgxPool, logger *log.Logger) *Repository {
+	return &Repository{
+		connection: connection,
+		logger:     logger,
+	}
+}
+
+// Resolve a user by reference (i.e., the authenticated principal subject), storing a new user on first sight, or
+// fetching the user stored by a concurrent first request.
+//
+// Return: user fragment and nil with success, empty user fragment and error without.
+func (repository *Repository) ResolveUser(reference string) (model.UserFragment, error) {
+	constraint := fmt.Sprintf("reference='%s'", util.FormatPSQLString(reference))
+
+	existingUser, err := service.FetchFragment[model.UserFragment](repository.connection, database.TableUserFragments, constraint)
+
+	if err != nil {
+		repository.logger.Printf("Unable to fetch user with reference '%s': %v", reference, err)
+
+		return model.UserFragment{}, err
+	}
+
+	if existingUser.ID != 0 {
+		return existingUser, nil
+	}
+
+	user := model.UserFragment{
+		Reference:   reference,
+		DateCreated: time.Now().Unix(),
+	}
+
+	user.ID, err = service.StoreFragment(repository.connection, database.TableUserFragments, database.PropertiesUserFragments, pgx.NamedArgs{
+		"reference":    user.Reference,
+		"date_created": user.DateCreated,
+	})
+
+	if database.IsUniqueViolation(err) {
+		concurrentUser, fetchErr := service.FetchFragment[model.UserFragment](repository.connection, database.TableUserFragments, constraint)
+
+		if fetchErr == nil && concurrentUser.ID != 0 {
+			return concurrentUser, nil
+		}
+	}
+
+	if err != nil {
+		repository.logger.Printf("Unable to store user with reference '%s': %v", reference, err)
+
+		return model.UserFragment{}, err
+	}
+
+	return user, nil
+}
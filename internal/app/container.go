@@ -0,0 +1,350 @@
+package app
+
+import (
+	"context"
+	"log"
+
+	"github.com/gin-gonic/gin"
+	albumApi "github.com/muzzarellimj/grace-material-api/internal/api/album"
+	albumHelper "github.com/muzzarellimj/grace-material-api/internal/api/album/helper"
+	boardGameApi "github.com/muzzarellimj/grace-material-api/internal/api/boardgame"
+	boardGameHelper "github.com/muzzarellimj/grace-material-api/internal/api/boardgame/helper"
+	bookApi "github.com/muzzarellimj/grace-material-api/internal/api/book"
+	bookHelper "github.com/muzzarellimj/grace-material-api/internal/api/book/helper"
+	collectionApi "github.com/muzzarellimj/grace-material-api/internal/api/collection"
+	collectionHelper "github.com/muzzarellimj/grace-material-api/internal/api/collection/helper"
+	comicApi "github.com/muzzarellimj/grace-material-api/internal/api/comic"
+	comicHelper "github.com/muzzarellimj/grace-material-api/internal/api/comic/helper"
+	exportApi "github.com/muzzarellimj/grace-material-api/internal/api/export"
+	gameApi "github.com/muzzarellimj/grace-material-api/internal/api/game"
+	gameHelper "github.com/muzzarellimj/grace-material-api/internal/api/game/helper"
+	importApi "github.com/muzzarellimj/grace-material-api/internal/api/importer"
+	listApi "github.com/muzzarellimj/grace-material-api/internal/api/list"
+	listHelper "github.com/muzzarellimj/grace-material-api/internal/api/list/helper"
+	movieApi "github.com/muzzarellimj/grace-material-api/internal/api/movie"
+	movieHelper "github.com/muzzarellimj/grace-material-api/internal/api/movie/helper"
+	podcastApi "github.com/muzzarellimj/grace-material-api/internal/api/podcast"
+	podcastHelper "github.com/muzzarellimj/grace-material-api/internal/api/podcast/helper"
+	progressApi "github.com/muzzarellimj/grace-material-api/internal/api/progress"
+	progressHelper "github.com/muzzarellimj/grace-material-api/internal/api/progress/helper"
+	recommendationApi "github.com/muzzarellimj/grace-material-api/internal/api/recommendation"
+	recommendationHelper "github.com/muzzarellimj/grace-material-api/internal/api/recommendation/helper"
+	reviewApi "github.com/muzzarellimj/grace-material-api/internal/api/review"
+	reviewHelper "github.com/muzzarellimj/grace-material-api/internal/api/review/helper"
+	showApi "github.com/muzzarellimj/grace-material-api/internal/api/show"
+	showHelper "github.com/muzzarellimj/grace-material-api/internal/api/show/helper"
+	statisticsApi "github.com/muzzarellimj/grace-material-api/internal/api/statistics"
+	statisticsHelper "github.com/muzzarellimj/grace-material-api/internal/api/statistics/helper"
+	tagApi "github.com/muzzarellimj/grace-material-api/internal/api/tag"
+	tagHelper "github.com/muzzarellimj/grace-material-api/internal/api/tag/helper"
+	BGGAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/boardgamegeek.com"
+	CVAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/comicvine.gamespot.com"
+	FeedAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/feed"
+	IGDBAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/igdb.com"
+	MBAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/musicbrainz.org"
+	OLAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/openlibrary.org"
+	TMDBAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/themoviedb.org"
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/archive"
+	"github.com/muzzarellimj/grace-material-api/internal/auth"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/importer"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+)
+
+// The application container, which constructs every repository and handler once from the loaded configuration,
+// database pool, and logger, so that multiple independently configured containers may coexist (e.g., in tests).
+type Container struct {
+	Config     config.Config
+	Connection database.PgxPool
+	Logger     *log.Logger
+	Auth       *auth.Authenticator
+	Importer   *importer.Importer
+	Archiver   *archive.Archiver
+
+	Book      *bookApi.Handler
+	Game      *gameApi.Handler
+	Movie     *movieApi.Handler
+	Show      *showApi.Handler
+	Album     *albumApi.Handler
+	BoardGame *boardGameApi.Handler
+	Comic     *comicApi.Handler
+	Podcast   *podcastApi.Handler
+
+	Collection *collectionApi.Handler
+	Progress   *progressApi.Handler
+	Review     *reviewApi.Handler
+	Tag        *tagApi.Handler
+	List       *listApi.Handler
+	Statistics *statisticsApi.Handler
+
+	Recommendation *recommendationApi.Handler
+	Import         *importApi.Handler
+	Export         *exportApi.Handler
+
+	podcasts *podcastHelper.Repository
+}
+
+// Create an application container with provider clients built from configuration; handlers for disabled features
+// are left nil.
+//
+// Return: configured container.
+func NewContainer(configuration config.Config, connection database.PgxPool, logger *log.Logger) *Container {
+	container := &Container{
+		Config:     configuration,
+		Connection: connection,
+		Logger:     logger,
+		Auth:       auth.NewAuthenticator(configuration.Auth, configuration.Provider.Timeout),
+	}
+
+	var materialTypes []string
+	var books importer.BookStorage
+	var games importer.GameStorage
+	var movies importer.MovieStorage
+	var sources archive.Sources
+
+	if configuration.Feature.Books {
+		materialTypes = append(materialTypes, material.TypeBook)
+
+		client := OLAPI.NewClient(configuration.Provider.OpenLibrary, configuration.Provider.Timeout)
+		repository := bookHelper.NewRepository(connection, client, logger)
+
+		books = repository
+		sources.Books = repository
+		container.Book = bookApi.NewHandler(repository)
+	}
+
+	if configuration.Feature.Games {
+		materialTypes = append(materialTypes, material.TypeGame)
+
+		client := IGDBAPI.NewClient(configuration.Provider.IGDB, configuration.Provider.Timeout)
+		repository := gameHelper.NewRepository(connection, client, logger)
+
+		games = repository
+		sources.Games = repository
+		container.Game = gameApi.NewHandler(repository)
+	}
+
+	if configuration.Feature.Movies {
+		materialTypes = append(materialTypes, material.TypeMovie)
+
+		client := TMDBAPI.NewClient(configuration.Provider.TMDB, configuration.Provider.Timeout)
+		repository := movieHelper.NewRepository(connection, client, logger)
+
+		movies = repository
+		sources.Movies = repository
+		container.Movie = movieApi.NewHandler(repository)
+	}
+
+	if configuration.Feature.Shows {
+		materialTypes = append(materialTypes, material.TypeShow)
+
+		client := TMDBAPI.NewClient(configuration.Provider.TMDB, configuration.Provider.Timeout)
+		repository := showHelper.NewRepository(connection, client, logger)
+
+		sources.Shows = repository
+		container.Show = showApi.NewHandler(repository)
+	}
+
+	if configuration.Feature.Albums {
+		materialTypes = append(materialTypes, material.TypeAlbum)
+
+		client := MBAPI.NewClient(configuration.Provider.MusicBrainz, configuration.Provider.Timeout)
+		repository := albumHelper.NewRepository(connection, client, logger)
+
+		sources.Albums = repository
+		container.Album = albumApi.NewHandler(repository)
+	}
+
+	if configuration.Feature.BoardGames {
+		materialTypes = append(materialTypes, material.TypeBoardGame)
+
+		client := BGGAPI.NewClient(configuration.Provider.BGG, configuration.Provider.Timeout)
+		repository := boardGameHelper.NewRepository(connection, client, logger)
+
+		sources.BoardGames = repository
+		container.BoardGame = boardGameApi.NewHandler(repository)
+	}
+
+	if configuration.Feature.Comics {
+		materialTypes = append(materialTypes, material.TypeComic)
+
+		client := CVAPI.NewClient(configuration.Provider.ComicVine, configuration.Provider.Timeout)
+		repository := comicHelper.NewRepository(connection, client, logger)
+
+		sources.Comics = repository
+		container.Comic = comicApi.NewHandler(repository)
+	}
+
+	if configuration.Feature.Podcasts {
+		materialTypes = append(materialTypes, material.TypePodcast)
+
+		client := FeedAPI.NewClient(configuration.Provider.Podcast, configuration.Provider.Timeout)
+		repository := podcastHelper.NewRepository(connection, client, logger)
+
+		sources.Podcasts = repository
+		container.podcasts = repository
+		container.Podcast = podcastApi.NewHandler(repository)
+	}
+
+	users := userHelper.NewRepository(connection, logger)
+	collections := collectionHelper.NewRepository(connection, users, materialTypes, logger)
+
+	container.Importer = importer.NewImporter(books, games, movies, collections, logger)
+	container.Archiver = archive.NewArchiver(connection, users, collections, sources, logger)
+
+	container.Collection = collectionApi.NewHandler(collections)
+	container.Progress = progressApi.NewHandler(progressHelper.NewRepository(connection, users, materialTypes, logger))
+	container.Review = reviewApi.NewHandler(reviewHelper.NewRepository(connection, users, materialTypes, logger))
+	container.Tag = tagApi.NewHandler(tagHelper.NewRepository(connection, users, materialTypes, logger))
+	container.List = listApi.NewHandler(listHelper.NewRepository(connection, users, materialTypes, logger))
+	container.Statistics = statisticsApi.NewHandler(statisticsHelper.NewRepository(connection, users, materialTypes, logger))
+	container.Recommendation = recommendationApi.NewHandler(recommendationHelper.NewRepository(connection, materialTypes, logger))
+	container.Import = importApi.NewHandler(container.Importer)
+	container.Export = exportApi.NewHandler(container.Archiver)
+
+	return container
+}
+
+// Start the background jobs of every enabled material type (i.e., the periodic podcast feed refresh, unless its
+// interval is zero), which run until the context is cancelled.
+func (container *Container) Start(ctx context.Context) {
+	if container.podcasts != nil && container.Config.Provider.Podcast.Refresh > 0 {
+		go container.podcasts.ScheduleRefresh(ctx, container.Config.Provider.Podcast.Refresh)
+	}
+}
+
+// Register the routes of every enabled material type with the provided router, where read routes require the read
+// scope (unless configured as public), write routes require the write scope, and per-user routes always require an
+// authenticated principal.
+func (container *Container) Register(router gin.IRouter) {
+	read := router.Group("/api", middleware.AuthorizeRead(container.Auth))
+	write := router.Group("/api", middleware.Authorize(container.Auth, auth.ScopeWrite))
+	owner := router.Group("/api", middleware.Authorize(container.Auth, auth.ScopeRead))
+
+	if container.Book != nil {
+		read.GET("/book", container.Book.HandleGetBook)
+		write.PUT("/book", container.Book.HandlePutBook)
+		write.POST("/book", container.Book.HandlePostBook)
+		write.POST("/book/scan", container.Book.HandlePostBookScan)
+		read.GET("/book/exist", container.Book.HandleGetBookExistenceSlice)
+		read.GET("/book/search", container.Book.HandleGetBookSearch)
+		read.GET("/book/work", container.Book.HandleGetWork)
+		read.GET("/book/author", container.Book.HandleGetAuthorSlice)
+		write.PUT("/book/work", container.Book.HandlePutWork)
+		write.POST("/book/series", container.Book.HandlePostBookSeries)
+		write.DELETE("/book/series", container.Book.HandleDeleteBookSeries)
+		read.GET("/series", container.Book.HandleGetSeriesSlice)
+		read.GET("/series/:id", container.Book.HandleGetSeries)
+		write.PUT("/series/:id", container.Book.HandlePutSeries)
+	}
+
+	if container.Game != nil {
+		read.GET("/game", container.Game.HandleGetGame)
+		write.PUT("/game", container.Game.HandlePutGame)
+		write.POST("/game", container.Game.HandlePostGame)
+		read.GET("/game/exist", container.Game.HandleGetGameExistenceSlice)
+		read.GET("/game/search", container.Game.HandleGetGameSearch)
+		read.GET("/game/similar", container.Game.HandleGetGameSimilar)
+	}
+
+	if container.Movie != nil {
+		read.GET("/movie", container.Movie.HandleGetMovie)
+		write.PUT("/movie", container.Movie.HandlePutMovie)
+		write.POST("/movie", container.Movie.HandlePostMovie)
+		read.GET("/movie/exist", container.Movie.HandleGetMovieExistenceSlice)
+		read.GET("/movie/search", container.Movie.HandleGetMovieSearch)
+		read.GET("/movie/similar", container.Movie.HandleGetMovieSimilar)
+		read.GET("/movie/person", container.Movie.HandleGetPerson)
+	}
+
+	if container.Show != nil {
+		read.GET("/show", container.Show.HandleGetShow)
+		write.PUT("/show", container.Show.HandlePutShow)
+		write.POST("/show", container.Show.HandlePostShow)
+		read.GET("/show/exist", container.Show.HandleGetShowExistenceSlice)
+		read.GET("/show/search", container.Show.HandleGetShowSearch)
+	}
+
+	if container.Album != nil {
+		read.GET("/album", container.Album.HandleGetAlbum)
+		write.PUT("/album", container.Album.HandlePutAlbum)
+		write.POST("/album", container.Album.HandlePostAlbum)
+		write.POST("/album/scan", container.Album.HandlePostAlbumScan)
+		read.GET("/album/exist", container.Album.HandleGetAlbumExistenceSlice)
+		read.GET("/album/search", container.Album.HandleGetAlbumSearch)
+	}
+
+	if container.BoardGame != nil {
+		read.GET("/boardgame", container.BoardGame.HandleGetBoardGame)
+		write.PUT("/boardgame", container.BoardGame.HandlePutBoardGame)
+		write.POST("/boardgame", container.BoardGame.HandlePostBoardGame)
+		read.GET("/boardgame/exist", container.BoardGame.HandleGetBoardGameExistenceSlice)
+		read.GET("/boardgame/search", container.BoardGame.HandleGetBoardGameSearch)
+	}
+
+	if container.Comic != nil {
+		read.GET("/comic", container.Comic.HandleGetComic)
+		write.PUT("/comic", container.Comic.HandlePutComic)
+		write.POST("/comic", container.Comic.HandlePostComic)
+		read.GET("/comic/exist", container.Comic.HandleGetComicExistenceSlice)
+		read.GET("/comic/search", container.Comic.HandleGetComicSearch)
+	}
+
+	if container.Podcast != nil {
+		read.GET("/podcast", container.Podcast.HandleGetPodcast)
+		write.PUT("/podcast", container.Podcast.HandlePutPodcast)
+		write.POST("/podcast", container.Podcast.HandlePostPodcast)
+		write.POST("/podcast/refresh", container.Podcast.HandlePostPodcastRefresh)
+		read.GET("/podcast/exist", container.Podcast.HandleGetPodcastExistenceSlice)
+	}
+
+	owner.GET("/collection", container.Collection.HandleGetCollection)
+	write.POST("/collection/:type", container.Collection.HandlePostCollectionItem)
+	write.DELETE("/collection/:type", container.Collection.HandleDeleteCollectionItem)
+	owner.GET("/collection/:type/issue", container.Collection.HandleGetCollectionIssueSlice)
+	write.POST("/collection/:type/issue", container.Collection.HandlePostCollectionIssue)
+	write.DELETE("/collection/:type/issue", container.Collection.HandleDeleteCollectionIssue)
+	write.PUT("/collection/:type/platform", container.Collection.HandlePutCollectionPlatform)
+
+	owner.GET("/progress", container.Progress.HandleGetProgressFeed)
+	owner.GET("/progress/:type", container.Progress.HandleGetProgressHistory)
+	write.POST("/progress/:type", container.Progress.HandlePostProgress)
+	write.DELETE("/progress/:type", container.Progress.HandleDeleteProgress)
+	owner.GET("/progress/:type/episode", container.Progress.HandleGetEpisodeProgress)
+	write.POST("/progress/:type/episode", container.Progress.HandlePostEpisodeProgress)
+	write.DELETE("/progress/:type/episode", container.Progress.HandleDeleteEpisodeProgress)
+	owner.GET("/progress/:type/issue", container.Progress.HandleGetIssueProgress)
+	write.POST("/progress/:type/issue", container.Progress.HandlePostIssueProgress)
+	write.DELETE("/progress/:type/issue", container.Progress.HandleDeleteIssueProgress)
+
+	owner.GET("/review", container.Review.HandleGetReviewSlice)
+	write.POST("/review/:type", container.Review.HandlePostReview)
+	write.DELETE("/review/:type", container.Review.HandleDeleteReview)
+
+	owner.GET("/tag", container.Tag.HandleGetTagSlice)
+	write.DELETE("/tag", container.Tag.HandleDeleteTag)
+	owner.GET("/tag/:type", container.Tag.HandleGetMaterialTagSlice)
+	write.POST("/tag/:type", container.Tag.HandlePostMaterialTag)
+	write.DELETE("/tag/:type", container.Tag.HandleDeleteMaterialTag)
+
+	owner.GET("/list", container.List.HandleGetListSlice)
+	write.POST("/list", container.List.HandlePostList)
+	owner.GET("/list/:id", container.List.HandleGetList)
+	write.PUT("/list/:id", container.List.HandlePutList)
+	write.DELETE("/list/:id", container.List.HandleDeleteList)
+	write.POST("/list/:id/item", container.List.HandlePostListItem)
+	write.DELETE("/list/:id/item", container.List.HandleDeleteListItem)
+	write.PUT("/list/:id/order", container.List.HandlePutListOrder)
+
+	owner.GET("/statistics", container.Statistics.HandleGetStatistics)
+
+	read.GET("/recommendation/:type", container.Recommendation.HandleGetRecommendationSlice)
+
+	write.POST("/import/:source", container.Import.HandlePostImport)
+	owner.GET("/import/:id", container.Import.HandleGetImport)
+
+	owner.GET("/export", container.Export.HandleGetExport)
+}
@@ -0,0 +1,260 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	collectionHelper "github.com/muzzarellimj/grace-material-api/internal/api/collection/helper"
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	albumModel "github.com/muzzarellimj/grace-material-api/internal/model/album"
+	boardGameModel "github.com/muzzarellimj/grace-material-api/internal/model/boardgame"
+	bookModel "github.com/muzzarellimj/grace-material-api/internal/model/book"
+	comicModel "github.com/muzzarellimj/grace-material-api/internal/model/comic"
+	gameModel "github.com/muzzarellimj/grace-material-api/internal/model/game"
+	movieModel "github.com/muzzarellimj/grace-material-api/internal/model/movie"
+	podcastModel "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+	showModel "github.com/muzzarellimj/grace-material-api/internal/model/show"
+)
+
+// The archive format version written by export; restore accepts this version and earlier.
+const Version = 1
+
+var ErrUnsupportedVersion = fmt.Errorf("archive version must be between 1 and %d", Version)
+
+var ErrUnsupportedFormat = errors.New("export format must be 'json', 'ndjson', 'csv', or 'archive'")
+
+// Export formats, as used in the export route query parameter 'format'.
+const (
+	FormatJSON    = "json"
+	FormatNDJSON  = "ndjson"
+	FormatCSV     = "csv"
+	FormatArchive = "archive"
+)
+
+// A table holding per-user data about a material type, described by its name and properties (column names).
+type table struct {
+	name       string
+	properties []string
+}
+
+// A material type which may be archived, described by the tables holding per-user data about it and any further
+// columns of the collection table archived with each item (e.g., 'r.platform').
+type archivable struct {
+	material.Material
+
+	collection table
+	columns    string
+	progress   table
+	review     table
+	tag        table
+}
+
+var archivables = map[string]archivable{
+	material.TypeBook: {
+		collection: table{database.TableCollectionBookRelationships, database.PropertiesCollectionBookRelationships},
+		progress:   table{database.TableBookProgressFragments, database.PropertiesBookProgressFragments},
+		review:     table{database.TableBookReviewFragments, database.PropertiesBookReviewFragments},
+		tag:        table{database.TableBookTagRelationships, database.PropertiesBookTagRelationships},
+	},
+	material.TypeGame: {
+		collection: table{database.TableCollectionGameRelationships, database.PropertiesCollectionGameRelationships},
+		columns:    "r.platform",
+		progress:   table{database.TableGameProgressFragments, database.PropertiesGameProgressFragments},
+		review:     table{database.TableGameReviewFragments, database.PropertiesGameReviewFragments},
+		tag:        table{database.TableGameTagRelationships, database.PropertiesGameTagRelationships},
+	},
+	material.TypeMovie: {
+		collection: table{database.TableCollectionMovieRelationships, database.PropertiesCollectionMovieRelationships},
+		progress:   table{database.TableMovieProgressFragments, database.PropertiesMovieProgressFragments},
+		review:     table{database.TableMovieReviewFragments, database.PropertiesMovieReviewFragments},
+		tag:        table{database.TableMovieTagRelationships, database.PropertiesMovieTagRelationships},
+	},
+	material.TypeShow: {
+		collection: table{database.TableCollectionShowRelationships, database.PropertiesCollectionShowRelationships},
+		progress:   table{database.TableShowProgressFragments, database.PropertiesShowProgressFragments},
+		review:     table{database.TableShowReviewFragments, database.PropertiesShowReviewFragments},
+		tag:        table{database.TableShowTagRelationships, database.PropertiesShowTagRelationships},
+	},
+	material.TypeAlbum: {
+		collection: table{database.TableCollectionAlbumRelationships, database.PropertiesCollectionAlbumRelationships},
+		progress:   table{database.TableAlbumProgressFragments, database.PropertiesAlbumProgressFragments},
+		review:     table{database.TableAlbumReviewFragments, database.PropertiesAlbumReviewFragments},
+		tag:        table{database.TableAlbumTagRelationships, database.PropertiesAlbumTagRelationships},
+	},
+	material.TypeBoardGame: {
+		collection: table{database.TableCollectionBoardGameRelationships, database.PropertiesCollectionBoardGameRelationships},
+		progress:   table{database.TableBoardGameProgressFragments, database.PropertiesBoardGameProgressFragments},
+		review:     table{database.TableBoardGameReviewFragments, database.PropertiesBoardGameReviewFragments},
+		tag:        table{database.TableBoardGameTagRelationships, database.PropertiesBoardGameTagRelationships},
+	},
+	material.TypeComic: {
+		collection: table{database.TableCollectionComicRelationships, database.PropertiesCollectionComicRelationships},
+		progress:   table{database.TableComicProgressFragments, database.PropertiesComicProgressFragments},
+		review:     table{database.TableComicReviewFragments, database.PropertiesComicReviewFragments},
+		tag:        table{database.TableComicTagRelationships, database.PropertiesComicTagRelationships},
+	},
+	material.TypePodcast: {
+		collection: table{database.TableCollectionPodcastRelationships, database.PropertiesCollectionPodcastRelationships},
+		progress:   table{database.TablePodcastProgressFragments, database.PropertiesPodcastProgressFragments},
+		review:     table{database.TablePodcastReviewFragments, database.PropertiesPodcastReviewFragments},
+		tag:        table{database.TablePodcastTagRelationships, database.PropertiesPodcastTagRelationships},
+	},
+}
+
+// The tables holding per-user data about parts of a material (i.e., watched show episodes, and owned and read comic
+// issues).
+var (
+	episodeProgress  = table{database.TableEpisodeProgressFragments, database.PropertiesEpisodeProgressFragments}
+	collectionIssues = table{database.TableCollectionIssueRelationships, database.PropertiesCollectionIssueRelationships}
+	issueProgress    = table{database.TableIssueProgressFragments, database.PropertiesIssueProgressFragments}
+)
+
+func lookup(materialType string) (archivable, bool) {
+	archivable, exists := archivables[materialType]
+
+	if !exists {
+		return archivable, false
+	}
+
+	archivable.Material, exists = material.Lookup(materialType)
+
+	return archivable, exists
+}
+
+// The book operations required by export, which are satisfied by a book repository.
+type BookSource interface {
+	FetchBook(constraint string) (bookModel.Book, error)
+}
+
+// The game operations required by export, which are satisfied by a game repository.
+type GameSource interface {
+	FetchGame(constraint string) (gameModel.Game, error)
+}
+
+// The movie operations required by export, which are satisfied by a movie repository.
+type MovieSource interface {
+	FetchMovie(constraint string) (movieModel.Movie, error)
+}
+
+// The show operations required by export, which are satisfied by a show repository.
+type ShowSource interface {
+	FetchShow(constraint string) (showModel.Show, error)
+}
+
+// The album operations required by export, which are satisfied by an album repository.
+type AlbumSource interface {
+	FetchAlbum(constraint string) (albumModel.Album, error)
+}
+
+// The board game operations required by export, which are satisfied by a board game repository.
+type BoardGameSource interface {
+	FetchBoardGame(constraint string) (boardGameModel.BoardGame, error)
+}
+
+// The comic operations required by export, which are satisfied by a comic repository.
+type ComicSource interface {
+	FetchComic(constraint string) (comicModel.Comic, error)
+}
+
+// The podcast operations required by export, which are satisfied by a podcast repository.
+type PodcastSource interface {
+	FetchPodcast(constraint string) (podcastModel.Podcast, error)
+}
+
+// The material repositories of enabled material types, where the source of a disabled material type is nil.
+type Sources struct {
+	Books      BookSource
+	Games      GameSource
+	Movies     MovieSource
+	Shows      ShowSource
+	Albums     AlbumSource
+	BoardGames BoardGameSource
+	Comics     ComicSource
+	Podcasts   PodcastSource
+}
+
+// An archiver, which exports the materials and per-user data (collection, progress, reviews, tags, and lists) of a
+// user to a versioned archive and restores such an archive, preserving relationships and provider references.
+type Archiver struct {
+	connection  database.PgxPool
+	users       *userHelper.Repository
+	collections *collectionHelper.Repository
+	sources     Sources
+	logger      *log.Logger
+}
+
+// Create an archiver with a database pool, user and collection repositories, the material repositories of enabled
+// material types, and logger.
+//
+// Return: configured archiver.
+func NewArchiver(connection database.PgxPool, users *userHelper.Repository, collections *collectionHelper.Repository, sources Sources, logger *log.Logger) *Archiver {
+	return &Archiver{
+		connection:  connection,
+		users:       users,
+		collections: collections,
+		sources:     sources,
+		logger:      logger,
+	}
+}
+
+// Determine the material types enabled for export, in archive order.
+func (archiver *Archiver) materialTypes() []string {
+	var materialTypes []string
+
+	if archiver.sources.Books != nil {
+		materialTypes = append(materialTypes, material.TypeBook)
+	}
+
+	if archiver.sources.Games != nil {
+		materialTypes = append(materialTypes, material.TypeGame)
+	}
+
+	if archiver.sources.Movies != nil {
+		materialTypes = append(materialTypes, material.TypeMovie)
+	}
+
+	if archiver.sources.Shows != nil {
+		materialTypes = append(materialTypes, material.TypeShow)
+	}
+
+	if archiver.sources.Albums != nil {
+		materialTypes = append(materialTypes, material.TypeAlbum)
+	}
+
+	if archiver.sources.BoardGames != nil {
+		materialTypes = append(materialTypes, material.TypeBoardGame)
+	}
+
+	if archiver.sources.Comics != nil {
+		materialTypes = append(materialTypes, material.TypeComic)
+	}
+
+	if archiver.sources.Podcasts != nil {
+		materialTypes = append(materialTypes, material.TypePodcast)
+	}
+
+	return materialTypes
+}
+
+// Determine whether an export format is known.
+//
+// Return: true if supported, false if not.
+func SupportsFormat(format string) bool {
+	switch format {
+	case FormatJSON, FormatNDJSON, FormatCSV, FormatArchive:
+		return true
+	default:
+		return false
+	}
+}
+
+// Determine whether a material type is known and enabled for export.
+//
+// Return: true if supported, false if not.
+func (archiver *Archiver) Supports(materialType string) bool {
+	return slices.Contains(archiver.materialTypes(), materialType)
+}
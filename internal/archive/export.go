@@ -0,0 +1,384 @@
+package archive
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	albumModel "github.com/muzzarellimj/grace-material-api/internal/model/album"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/archive"
+	boardGameModel "github.com/muzzarellimj/grace-material-api/internal/model/boardgame"
+	bookModel "github.com/muzzarellimj/grace-material-api/internal/model/book"
+	comicModel "github.com/muzzarellimj/grace-material-api/internal/model/comic"
+	gameModel "github.com/muzzarellimj/grace-material-api/internal/model/game"
+	listModel "github.com/muzzarellimj/grace-material-api/internal/model/list"
+	movieModel "github.com/muzzarellimj/grace-material-api/internal/model/movie"
+	podcastModel "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+	showModel "github.com/muzzarellimj/grace-material-api/internal/model/show"
+)
+
+// Export the per-user data of the user with the provided reference, with every material it refers to, as an archive.
+//
+// Return: archive and nil with success, empty archive and error without.
+func (archiver *Archiver) Export(reference string) (model.Archive, error) {
+	user, err := archiver.users.ResolveUser(reference)
+
+	if err != nil {
+		return model.Archive{}, err
+	}
+
+	archive := model.Archive{
+		Version:          Version,
+		DateCreated:      time.Now().Unix(),
+		Books:            []bookModel.Book{},
+		Games:            []gameModel.Game{},
+		Movies:           []movieModel.Movie{},
+		Shows:            []showModel.Show{},
+		Albums:           []albumModel.Album{},
+		BoardGames:       []boardGameModel.BoardGame{},
+		Comics:           []comicModel.Comic{},
+		Podcasts:         []podcastModel.Podcast{},
+		Collection:       []model.ArchiveCollectionItem{},
+		CollectionIssues: []model.ArchiveCollectionIssue{},
+		Progress:         []model.ArchiveProgressEntry{},
+		EpisodeProgress:  []model.ArchiveEpisodeProgress{},
+		IssueProgress:    []model.ArchiveIssueProgress{},
+		Reviews:          []model.ArchiveReview{},
+		Tags:             []model.ArchiveTag{},
+		Lists:            []model.ArchiveList{},
+	}
+
+	materialTypes := archiver.materialTypes()
+
+	for _, materialType := range materialTypes {
+		archivable, _ := lookup(materialType)
+
+		err = archiver.exportUserData(&archive, archivable, user.ID)
+
+		if err != nil {
+			archiver.logger.Printf("Unable to export %s data of user '%d': %v", materialType, user.ID, err)
+
+			return model.Archive{}, err
+		}
+	}
+
+	if archiver.Supports(material.TypeShow) {
+		archive.EpisodeProgress, err = archiver.exportEpisodeProgressSlice(user.ID)
+
+		if err != nil {
+			archiver.logger.Printf("Unable to export episode progress of user '%d': %v", user.ID, err)
+
+			return model.Archive{}, err
+		}
+	}
+
+	if archiver.Supports(material.TypeComic) {
+		err = archiver.exportIssueData(&archive, user.ID)
+
+		if err != nil {
+			archiver.logger.Printf("Unable to export issue data of user '%d': %v", user.ID, err)
+
+			return model.Archive{}, err
+		}
+	}
+
+	archive.Lists, err = archiver.exportListSlice(user.ID, materialTypes)
+
+	if err != nil {
+		archiver.logger.Printf("Unable to export lists of user '%d': %v", user.ID, err)
+
+		return model.Archive{}, err
+	}
+
+	err = archiver.exportMaterials(&archive)
+
+	if err != nil {
+		return model.Archive{}, err
+	}
+
+	return archive, nil
+}
+
+func (archiver *Archiver) exportUserData(archive *model.Archive, archivable archivable, owner int) error {
+	selection := fmt.Sprintf("'%s' AS type, r.%s AS material, r.date_added", archivable.Type, archivable.Column)
+
+	if archivable.columns != "" {
+		selection = fmt.Sprintf("%s, %s", selection, archivable.columns)
+	}
+
+	collectionSlice, err := query[model.ArchiveCollectionItem](archiver.connection,
+		selection,
+		fmt.Sprintf("%s r", archivable.collection.name),
+		fmt.Sprintf("c.owner=%d", owner),
+		fmt.Sprintf("JOIN %s c ON c.id = r.collection", database.TableCollectionFragments),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	progressSlice, err := query[model.ArchiveProgressEntry](archiver.connection,
+		fmt.Sprintf("'%s' AS type, %s AS material, status, progress, date_recorded", archivable.Type, archivable.Column),
+		archivable.progress.name,
+		fmt.Sprintf("owner=%d", owner),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	reviewSlice, err := query[model.ArchiveReview](archiver.connection,
+		fmt.Sprintf("'%s' AS type, %s AS material, rating, review, date_created, date_updated", archivable.Type, archivable.Column),
+		archivable.review.name,
+		fmt.Sprintf("owner=%d", owner),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	tagSlice, err := query[model.ArchiveTag](archiver.connection,
+		fmt.Sprintf("'%s' AS type, r.%s AS material, t.name", archivable.Type, archivable.Column),
+		fmt.Sprintf("%s r", archivable.tag.name),
+		fmt.Sprintf("t.owner=%d", owner),
+		fmt.Sprintf("JOIN %s t ON t.id = r.tag", database.TableTagFragments),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	slices.SortStableFunc(progressSlice, func(a model.ArchiveProgressEntry, b model.ArchiveProgressEntry) int {
+		return cmp.Compare(a.DateRecorded, b.DateRecorded)
+	})
+
+	archive.Collection = append(archive.Collection, collectionSlice...)
+	archive.Progress = append(archive.Progress, progressSlice...)
+	archive.Reviews = append(archive.Reviews, reviewSlice...)
+	archive.Tags = append(archive.Tags, tagSlice...)
+
+	return nil
+}
+
+func (archiver *Archiver) exportEpisodeProgressSlice(owner int) ([]model.ArchiveEpisodeProgress, error) {
+	progressSlice, err := query[model.ArchiveEpisodeProgress](archiver.connection,
+		"e.show, w.episode, w.date_watched",
+		fmt.Sprintf("%s w", episodeProgress.name),
+		fmt.Sprintf("w.owner=%d", owner),
+		fmt.Sprintf("JOIN %s e ON e.id = w.episode", database.TableShowEpisodeFragments),
+	)
+
+	if err != nil {
+		return []model.ArchiveEpisodeProgress{}, err
+	}
+
+	slices.SortStableFunc(progressSlice, func(a model.ArchiveEpisodeProgress, b model.ArchiveEpisodeProgress) int {
+		return cmp.Compare(a.DateWatched, b.DateWatched)
+	})
+
+	if progressSlice == nil {
+		progressSlice = []model.ArchiveEpisodeProgress{}
+	}
+
+	return progressSlice, nil
+}
+
+func (archiver *Archiver) exportIssueData(archive *model.Archive, owner int) error {
+	collectionSlice, err := query[model.ArchiveCollectionIssue](archiver.connection,
+		"i.comic, r.issue, r.date_added",
+		fmt.Sprintf("%s r", collectionIssues.name),
+		fmt.Sprintf("c.owner=%d", owner),
+		fmt.Sprintf("JOIN %s c ON c.id = r.collection", database.TableCollectionFragments),
+		fmt.Sprintf("JOIN %s i ON i.id = r.issue", database.TableComicIssueFragments),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	progressSlice, err := query[model.ArchiveIssueProgress](archiver.connection,
+		"i.comic, w.issue, w.date_read",
+		fmt.Sprintf("%s w", issueProgress.name),
+		fmt.Sprintf("w.owner=%d", owner),
+		fmt.Sprintf("JOIN %s i ON i.id = w.issue", database.TableComicIssueFragments),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	slices.SortStableFunc(progressSlice, func(a model.ArchiveIssueProgress, b model.ArchiveIssueProgress) int {
+		return cmp.Compare(a.DateRead, b.DateRead)
+	})
+
+	archive.CollectionIssues = append(archive.CollectionIssues, collectionSlice...)
+	archive.IssueProgress = append(archive.IssueProgress, progressSlice...)
+
+	return nil
+}
+
+func (archiver *Archiver) exportListSlice(owner int, materialTypes []string) ([]model.ArchiveList, error) {
+	listSlice, err := service.FetchFragmentSlice[listModel.ListFragment](archiver.connection, database.TableListFragments, fmt.Sprintf("owner=%d", owner))
+
+	if err != nil {
+		return []model.ArchiveList{}, err
+	}
+
+	archiveListSlice := []model.ArchiveList{}
+
+	for _, list := range listSlice {
+		itemSlice, err := service.FetchFragmentSlice[listModel.ListItemFragment](archiver.connection, database.TableListItemFragments, fmt.Sprintf("list=%d", list.ID))
+
+		if err != nil {
+			return []model.ArchiveList{}, err
+		}
+
+		archiveList := model.ArchiveList{
+			Name:        list.Name,
+			Description: list.Description,
+			DateCreated: list.DateCreated,
+			Items:       []model.ArchiveListItem{},
+		}
+
+		for _, item := range itemSlice {
+			if slices.Contains(materialTypes, item.Type) {
+				archiveList.Items = append(archiveList.Items, model.ArchiveListItem{Position: item.Position, Type: item.Type, Material: item.Material})
+			}
+		}
+
+		slices.SortStableFunc(archiveList.Items, func(a model.ArchiveListItem, b model.ArchiveListItem) int {
+			return cmp.Compare(a.Position, b.Position)
+		})
+
+		archiveListSlice = append(archiveListSlice, archiveList)
+	}
+
+	return archiveListSlice, nil
+}
+
+// Export every material referred to by the per-user data of an archive, in ascending numeric identifier order.
+func (archiver *Archiver) exportMaterials(archive *model.Archive) error {
+	ids := make(map[string][]int)
+
+	refer := func(materialType string, id int) {
+		if !slices.Contains(ids[materialType], id) {
+			ids[materialType] = append(ids[materialType], id)
+		}
+	}
+
+	for _, item := range archive.Collection {
+		refer(item.Type, item.Material)
+	}
+
+	for _, entry := range archive.Progress {
+		refer(entry.Type, entry.Material)
+	}
+
+	for _, entry := range archive.EpisodeProgress {
+		refer(material.TypeShow, entry.Show)
+	}
+
+	for _, issue := range archive.CollectionIssues {
+		refer(material.TypeComic, issue.Comic)
+	}
+
+	for _, entry := range archive.IssueProgress {
+		refer(material.TypeComic, entry.Comic)
+	}
+
+	for _, review := range archive.Reviews {
+		refer(review.Type, review.Material)
+	}
+
+	for _, tag := range archive.Tags {
+		refer(tag.Type, tag.Material)
+	}
+
+	for _, list := range archive.Lists {
+		for _, item := range list.Items {
+			refer(item.Type, item.Material)
+		}
+	}
+
+	for materialType, idSlice := range ids {
+		slices.Sort(idSlice)
+
+		for _, id := range idSlice {
+			constraint := fmt.Sprintf("id=%d", id)
+
+			var err error
+
+			switch materialType {
+			case material.TypeBook:
+				var book bookModel.Book
+
+				book, err = archiver.sources.Books.FetchBook(constraint)
+				archive.Books = append(archive.Books, book)
+			case material.TypeGame:
+				var game gameModel.Game
+
+				game, err = archiver.sources.Games.FetchGame(constraint)
+				archive.Games = append(archive.Games, game)
+			case material.TypeMovie:
+				var movie movieModel.Movie
+
+				movie, err = archiver.sources.Movies.FetchMovie(constraint)
+				archive.Movies = append(archive.Movies, movie)
+			case material.TypeShow:
+				var show showModel.Show
+
+				show, err = archiver.sources.Shows.FetchShow(constraint)
+				archive.Shows = append(archive.Shows, show)
+			case material.TypeAlbum:
+				var album albumModel.Album
+
+				album, err = archiver.sources.Albums.FetchAlbum(constraint)
+				archive.Albums = append(archive.Albums, album)
+			case material.TypeBoardGame:
+				var boardGame boardGameModel.BoardGame
+
+				boardGame, err = archiver.sources.BoardGames.FetchBoardGame(constraint)
+				archive.BoardGames = append(archive.BoardGames, boardGame)
+			case material.TypeComic:
+				var comic comicModel.Comic
+
+				comic, err = archiver.sources.Comics.FetchComic(constraint)
+				archive.Comics = append(archive.Comics, comic)
+			case material.TypePodcast:
+				var podcast podcastModel.Podcast
+
+				podcast, err = archiver.sources.Podcasts.FetchPodcast(constraint)
+				archive.Podcasts = append(archive.Podcasts, podcast)
+			}
+
+			if err != nil {
+				archiver.logger.Printf("Unable to export %s '%d': %v", materialType, id, err)
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Execute a query with the provided selection, source, constraint, and directives (e.g., joins), mapping each row.
+func query[M interface{}](connection database.PgxPool, selection string, from string, constraint string, directives ...string) ([]M, error) {
+	statement, err := database.CreateQuery(selection, from, constraint, "", directives...)
+
+	if err != nil {
+		return []M{}, err
+	}
+
+	rows, err := database.ExecuteQuery(connection, statement)
+
+	if err != nil {
+		return []M{}, err
+	}
+
+	return database.MapQueryResponse[M](rows)
+}
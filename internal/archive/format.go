@@ -0,0 +1,401 @@
+package archive
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/archive"
+)
+
+// CSV header rows per material type, where the trailing columns hold per-user data.
+var (
+	headerBooks      = []string{"id", "title", "subtitle", "authors", "publishers", "topics", "series", "publish_date", "pages", "isbn10", "isbn13", "edition_reference", "work_reference"}
+	headerGames      = []string{"id", "title", "summary", "franchises", "genres", "platforms", "studios", "release_date", "reference"}
+	headerMovies     = []string{"id", "title", "tagline", "genres", "production_companies", "release_date", "runtime", "reference"}
+	headerShows      = []string{"id", "title", "tagline", "genres", "networks", "first_air_date", "last_air_date", "status", "seasons", "episodes", "runtime", "reference"}
+	headerAlbums     = []string{"id", "title", "artist_credit", "artists", "labels", "genres", "release_date", "country", "format", "discs", "tracks", "runtime", "barcode", "reference", "group_reference"}
+	headerBoardGames = []string{"id", "title", "designers", "publishers", "mechanics", "categories", "release_date", "min_players", "max_players", "playing_time", "min_age", "reference"}
+	headerComics     = []string{"id", "title", "creators", "publishers", "volumes", "start_date", "issues", "references"}
+	headerPodcasts   = []string{"id", "title", "author", "categories", "language", "explicit", "first_release_date", "last_release_date", "episodes", "link", "reference"}
+	headerUser       = []string{"date_added", "status", "progress", "rating", "review", "tags"}
+)
+
+// Write the materials of an archive as one indented JSON document.
+//
+// Return: nil with success, error without.
+func WriteJSON(writer io.Writer, archive model.Archive) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "    ")
+
+	return encoder.Encode(model.MaterialExport{
+		Books:      archive.Books,
+		Games:      archive.Games,
+		Movies:     archive.Movies,
+		Shows:      archive.Shows,
+		Albums:     archive.Albums,
+		BoardGames: archive.BoardGames,
+		Comics:     archive.Comics,
+		Podcasts:   archive.Podcasts,
+	})
+}
+
+// Write the materials of an archive as newline-delimited JSON, one material per line.
+//
+// Return: nil with success, error without.
+func WriteNDJSON(writer io.Writer, archive model.Archive) error {
+	encoder := json.NewEncoder(writer)
+
+	for _, book := range archive.Books {
+		if err := encoder.Encode(model.MaterialExportLine{Type: material.TypeBook, Material: book}); err != nil {
+			return err
+		}
+	}
+
+	for _, game := range archive.Games {
+		if err := encoder.Encode(model.MaterialExportLine{Type: material.TypeGame, Material: game}); err != nil {
+			return err
+		}
+	}
+
+	for _, movie := range archive.Movies {
+		if err := encoder.Encode(model.MaterialExportLine{Type: material.TypeMovie, Material: movie}); err != nil {
+			return err
+		}
+	}
+
+	for _, show := range archive.Shows {
+		if err := encoder.Encode(model.MaterialExportLine{Type: material.TypeShow, Material: show}); err != nil {
+			return err
+		}
+	}
+
+	for _, album := range archive.Albums {
+		if err := encoder.Encode(model.MaterialExportLine{Type: material.TypeAlbum, Material: album}); err != nil {
+			return err
+		}
+	}
+
+	for _, boardGame := range archive.BoardGames {
+		if err := encoder.Encode(model.MaterialExportLine{Type: material.TypeBoardGame, Material: boardGame}); err != nil {
+			return err
+		}
+	}
+
+	for _, comic := range archive.Comics {
+		if err := encoder.Encode(model.MaterialExportLine{Type: material.TypeComic, Material: comic}); err != nil {
+			return err
+		}
+	}
+
+	for _, podcast := range archive.Podcasts {
+		if err := encoder.Encode(model.MaterialExportLine{Type: material.TypePodcast, Material: podcast}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Write the materials of one type in an archive as flat CSV, with the per-user data of each in trailing columns.
+//
+// Return: nil with success, error without.
+func WriteCSV(writer io.Writer, archive model.Archive, materialType string) error {
+	var recordSlice [][]string
+
+	switch materialType {
+	case material.TypeBook:
+		recordSlice = append(recordSlice, append(headerBooks, headerUser...))
+
+		for _, book := range archive.Books {
+			var authorSlice, publisherSlice, topicSlice, seriesSlice []string
+
+			for _, author := range book.Authors {
+				if author.DisplayName != "" {
+					authorSlice = append(authorSlice, author.DisplayName)
+
+					continue
+				}
+
+				authorSlice = append(authorSlice, strings.Join(strings.Fields(fmt.Sprintf("%s %s %s", author.FirstName, author.MiddleName, author.LastName)), " "))
+			}
+
+			for _, publisher := range book.Publishers {
+				publisherSlice = append(publisherSlice, publisher.Name)
+			}
+
+			for _, topic := range book.Topics {
+				topicSlice = append(topicSlice, topic.Name)
+			}
+
+			for _, series := range book.Series {
+				if series.Position == 0 {
+					seriesSlice = append(seriesSlice, series.Name)
+
+					continue
+				}
+
+				seriesSlice = append(seriesSlice, fmt.Sprintf("%s #%s", series.Name, strconv.FormatFloat(series.Position, 'f', -1, 64)))
+			}
+
+			recordSlice = append(recordSlice, append([]string{
+				formatInt(book.ID), book.Title, book.Subtitle, joinNames(authorSlice), joinNames(publisherSlice), joinNames(topicSlice), joinNames(seriesSlice),
+				formatDate(book.PublishDate), formatInt(book.Pages), book.ISBN10, book.ISBN13, book.EditionReference, book.WorkReference,
+			}, userRecord(archive, materialType, book.ID)...))
+		}
+	case material.TypeGame:
+		recordSlice = append(recordSlice, append(headerGames, headerUser...))
+
+		for _, game := range archive.Games {
+			var franchiseSlice, genreSlice, platformSlice, studioSlice []string
+
+			for _, franchise := range game.Franchises {
+				franchiseSlice = append(franchiseSlice, franchise.Name)
+			}
+
+			for _, genre := range game.Genres {
+				genreSlice = append(genreSlice, genre.Name)
+			}
+
+			for _, platform := range game.Platforms {
+				platformSlice = append(platformSlice, platform.Name)
+			}
+
+			for _, studio := range game.Studios {
+				studioSlice = append(studioSlice, studio.Name)
+			}
+
+			recordSlice = append(recordSlice, append([]string{
+				formatInt(game.ID), game.Title, game.Summary, joinNames(franchiseSlice), joinNames(genreSlice), joinNames(platformSlice),
+				joinNames(studioSlice), formatDate(int64(game.ReleaseDate)), formatInt(game.Reference),
+			}, userRecord(archive, materialType, game.ID)...))
+		}
+	case material.TypeMovie:
+		recordSlice = append(recordSlice, append(headerMovies, headerUser...))
+
+		for _, movie := range archive.Movies {
+			var genreSlice, companySlice []string
+
+			for _, genre := range movie.Genres {
+				genreSlice = append(genreSlice, genre.Name)
+			}
+
+			for _, company := range movie.ProductionCompanies {
+				companySlice = append(companySlice, company.Name)
+			}
+
+			recordSlice = append(recordSlice, append([]string{
+				formatInt(movie.ID), movie.Title, movie.Tagline, joinNames(genreSlice), joinNames(companySlice),
+				formatDate(movie.ReleaseDate), formatInt(movie.Runtime), formatInt(movie.Reference),
+			}, userRecord(archive, materialType, movie.ID)...))
+		}
+	case material.TypeShow:
+		recordSlice = append(recordSlice, append(headerShows, headerUser...))
+
+		for _, show := range archive.Shows {
+			var genreSlice, networkSlice []string
+
+			for _, genre := range show.Genres {
+				genreSlice = append(genreSlice, genre.Name)
+			}
+
+			for _, network := range show.Networks {
+				networkSlice = append(networkSlice, network.Name)
+			}
+
+			recordSlice = append(recordSlice, append([]string{
+				formatInt(show.ID), show.Title, show.Tagline, joinNames(genreSlice), joinNames(networkSlice), formatDate(show.FirstAirDate),
+				formatDate(show.LastAirDate), show.Status, formatInt(len(show.Seasons)), formatInt(show.Episodes), formatInt(show.Runtime), formatInt(show.Reference),
+			}, userRecord(archive, materialType, show.ID)...))
+		}
+	case material.TypeAlbum:
+		recordSlice = append(recordSlice, append(headerAlbums, headerUser...))
+
+		for _, album := range archive.Albums {
+			var artistSlice, labelSlice, genreSlice []string
+
+			for _, artist := range album.Artists {
+				artistSlice = append(artistSlice, artist.Name)
+			}
+
+			for _, label := range album.Labels {
+				if label.CatalogNumber == "" {
+					labelSlice = append(labelSlice, label.Name)
+
+					continue
+				}
+
+				labelSlice = append(labelSlice, fmt.Sprintf("%s (%s)", label.Name, label.CatalogNumber))
+			}
+
+			for _, genre := range album.Genres {
+				genreSlice = append(genreSlice, genre.Name)
+			}
+
+			recordSlice = append(recordSlice, append([]string{
+				formatInt(album.ID), album.Title, album.ArtistCredit, joinNames(artistSlice), joinNames(labelSlice), joinNames(genreSlice), formatDate(album.ReleaseDate),
+				album.Country, album.Format, formatInt(album.Discs), formatInt(len(album.Tracks)), formatInt(album.Runtime), album.Barcode, album.Reference, album.GroupReference,
+			}, userRecord(archive, materialType, album.ID)...))
+		}
+	case material.TypeBoardGame:
+		recordSlice = append(recordSlice, append(headerBoardGames, headerUser...))
+
+		for _, boardGame := range archive.BoardGames {
+			var designerSlice, publisherSlice, mechanicSlice, categorySlice []string
+
+			for _, designer := range boardGame.Designers {
+				designerSlice = append(designerSlice, designer.Name)
+			}
+
+			for _, publisher := range boardGame.Publishers {
+				publisherSlice = append(publisherSlice, publisher.Name)
+			}
+
+			for _, mechanic := range boardGame.Mechanics {
+				mechanicSlice = append(mechanicSlice, mechanic.Name)
+			}
+
+			for _, category := range boardGame.Categories {
+				categorySlice = append(categorySlice, category.Name)
+			}
+
+			recordSlice = append(recordSlice, append([]string{
+				formatInt(boardGame.ID), boardGame.Title, joinNames(designerSlice), joinNames(publisherSlice), joinNames(mechanicSlice), joinNames(categorySlice),
+				formatDate(boardGame.ReleaseDate), formatInt(boardGame.MinPlayers), formatInt(boardGame.MaxPlayers), formatInt(boardGame.PlayingTime), formatInt(boardGame.MinAge),
+				formatInt(boardGame.Reference),
+			}, userRecord(archive, materialType, boardGame.ID)...))
+		}
+	case material.TypeComic:
+		recordSlice = append(recordSlice, append(headerComics, headerUser...))
+
+		for _, comic := range archive.Comics {
+			var creatorSlice, publisherSlice, volumeSlice, referenceSlice []string
+
+			for _, creator := range comic.Creators {
+				creatorSlice = append(creatorSlice, fmt.Sprintf("%s (%s)", creator.Name, creator.Role))
+			}
+
+			for _, publisher := range comic.Publishers {
+				publisherSlice = append(publisherSlice, publisher.Name)
+			}
+
+			for _, volume := range comic.Volumes {
+				volumeSlice = append(volumeSlice, volume.Title)
+				referenceSlice = append(referenceSlice, formatInt(volume.Reference))
+			}
+
+			recordSlice = append(recordSlice, append([]string{
+				formatInt(comic.ID), comic.Title, joinNames(creatorSlice), joinNames(publisherSlice), joinNames(volumeSlice), formatDate(comic.StartDate),
+				formatInt(comic.Issues), joinNames(referenceSlice),
+			}, userRecord(archive, materialType, comic.ID)...))
+		}
+	case material.TypePodcast:
+		recordSlice = append(recordSlice, append(headerPodcasts, headerUser...))
+
+		for _, podcast := range archive.Podcasts {
+			var categorySlice []string
+
+			for _, category := range podcast.Categories {
+				categorySlice = append(categorySlice, category.Name)
+			}
+
+			recordSlice = append(recordSlice, append([]string{
+				formatInt(podcast.ID), podcast.Title, podcast.Author, joinNames(categorySlice), podcast.Language, strconv.FormatBool(podcast.Explicit),
+				formatDate(podcast.FirstReleaseDate), formatDate(podcast.LastReleaseDate), formatInt(len(podcast.Episodes)), podcast.Link, podcast.Reference,
+			}, userRecord(archive, materialType, podcast.ID)...))
+		}
+	default:
+		return fmt.Errorf("unsupported material type '%s'", materialType)
+	}
+
+	return csv.NewWriter(writer).WriteAll(recordSlice)
+}
+
+// Write an archive as one indented JSON document, which may be restored.
+//
+// Return: nil with success, error without.
+func WriteArchive(writer io.Writer, archive model.Archive) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "    ")
+
+	return encoder.Encode(archive)
+}
+
+// Read an archive written by WriteArchive, with a version this build is able to restore.
+//
+// Return: archive and nil with success, empty archive and ErrUnsupportedVersion or error without.
+func ReadArchive(reader io.Reader) (model.Archive, error) {
+	var archive model.Archive
+
+	err := json.NewDecoder(reader).Decode(&archive)
+
+	if err != nil {
+		return model.Archive{}, err
+	}
+
+	if archive.Version < 1 || archive.Version > Version {
+		return model.Archive{}, ErrUnsupportedVersion
+	}
+
+	return archive, nil
+}
+
+// Create the per-user columns of a CSV record (date added, latest status and progress, rating, review, and tags).
+func userRecord(archive model.Archive, materialType string, id int) []string {
+	record := make([]string, len(headerUser))
+
+	for _, item := range archive.Collection {
+		if item.Type == materialType && item.Material == id {
+			record[0] = formatDate(item.DateAdded)
+		}
+	}
+
+	for _, entry := range archive.Progress {
+		if entry.Type == materialType && entry.Material == id {
+			record[1] = entry.Status
+			record[2] = formatInt(entry.Progress)
+		}
+	}
+
+	for _, review := range archive.Reviews {
+		if review.Type == materialType && review.Material == id {
+			record[3] = formatInt(review.Rating)
+			record[4] = review.Review
+		}
+	}
+
+	var tagSlice []string
+
+	for _, tag := range archive.Tags {
+		if tag.Type == materialType && tag.Material == id {
+			tagSlice = append(tagSlice, tag.Name)
+		}
+	}
+
+	record[5] = joinNames(tagSlice)
+
+	return record
+}
+
+// Join names for a flat CSV field (e.g., 'Horror; Science Fiction').
+func joinNames(nameSlice []string) string {
+	return strings.Join(nameSlice, "; ")
+}
+
+// Format a Unix timestamp as a date (UTC) for a flat CSV field, or empty when zero.
+func formatDate(timestamp int64) string {
+	if timestamp == 0 {
+		return ""
+	}
+
+	return time.Unix(timestamp, 0).UTC().Format(time.DateOnly)
+}
+
+func formatInt(value int) string {
+	return strconv.Itoa(value)
+}
@@ -0,0 +1,1329 @@
+package archive
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	movieHelper "github.com/muzzarellimj/grace-material-api/internal/api/movie/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	albumModel "github.com/muzzarellimj/grace-material-api/internal/model/album"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/archive"
+	boardGameModel "github.com/muzzarellimj/grace-material-api/internal/model/boardgame"
+	bookModel "github.com/muzzarellimj/grace-material-api/internal/model/book"
+	comicModel "github.com/muzzarellimj/grace-material-api/internal/model/comic"
+	gameModel "github.com/muzzarellimj/grace-material-api/internal/model/game"
+	listModel "github.com/muzzarellimj/grace-material-api/internal/model/list"
+	movieModel "github.com/muzzarellimj/grace-material-api/internal/model/movie"
+	podcastModel "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+	showModel "github.com/muzzarellimj/grace-material-api/internal/model/show"
+	tagModel "github.com/muzzarellimj/grace-material-api/internal/model/tag"
+	"github.com/muzzarellimj/grace-material-api/internal/personname"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+// Restore an archive for the user with the provided reference. Materials are matched to existing materials by
+// provider reference or stored with their relationships otherwise, and per-user data already present (e.g., a review
+// of the same material) is skipped, so that restoring an archive twice does not duplicate it.
+//
+// Return: restore report and nil with success, empty report and ErrUnsupportedVersion or error without.
+func (archiver *Archiver) Restore(reference string, archive model.Archive) (model.RestoreReport, error) {
+	if archive.Version < 1 || archive.Version > Version {
+		return model.RestoreReport{}, ErrUnsupportedVersion
+	}
+
+	collection, err := archiver.collections.ResolveCollection(reference)
+
+	if err != nil {
+		return model.RestoreReport{}, err
+	}
+
+	report := model.RestoreReport{}
+
+	showIds, episodeIds := archiver.restoreShows(archive.Shows, &report.Shows)
+	comicIds, issueIds := archiver.restoreComics(archive.Comics, &report.Comics)
+
+	// archived numeric identifiers per material type, mapped to restored numeric identifiers
+	ids := map[string]map[int]int{
+		material.TypeBook:      archiver.restoreBooks(archive.Books, &report.Books),
+		material.TypeGame:      archiver.restoreGames(archive.Games, &report.Games),
+		material.TypeMovie:     archiver.restoreMovies(archive.Movies, &report.Movies),
+		material.TypeShow:      showIds,
+		material.TypeAlbum:     archiver.restoreAlbums(archive.Albums, &report.Albums),
+		material.TypeBoardGame: archiver.restoreBoardGames(archive.BoardGames, &report.BoardGames),
+		material.TypeComic:     comicIds,
+		material.TypePodcast:   archiver.restorePodcasts(archive.Podcasts, &report.Podcasts),
+	}
+
+	resolve := func(materialType string, id int) (archivable, int, bool) {
+		archivable, _ := lookup(materialType)
+		restoredId, exists := ids[materialType][id]
+
+		if !exists {
+			report.Skipped++
+		}
+
+		return archivable, restoredId, exists
+	}
+
+	for _, item := range archive.Collection {
+		archivable, id, exists := resolve(item.Type, item.Material)
+
+		if !exists {
+			continue
+		}
+
+		arguments := pgx.NamedArgs{
+			"collection":      collection.ID,
+			archivable.Column: id,
+			"date_added":      item.DateAdded,
+		}
+
+		if item.Platform != nil {
+			if platformId, exists := archiver.resolvePlatform(archive.Games, *item.Platform); exists {
+				arguments["platform"] = platformId
+			}
+		}
+
+		err = archiver.restoreRow(archivable.collection, fmt.Sprintf("collection=%d AND %s=%d", collection.ID, archivable.Column, id), &report.Collection, &report.Skipped, arguments)
+
+		if err != nil {
+			return report, err
+		}
+	}
+
+	for _, issue := range archive.CollectionIssues {
+		id, exists := issueIds[issue.Issue]
+
+		if !exists {
+			report.Skipped++
+
+			continue
+		}
+
+		err = archiver.restoreRow(collectionIssues, fmt.Sprintf("collection=%d AND issue=%d", collection.ID, id), &report.Collection, &report.Skipped, pgx.NamedArgs{
+			"collection": collection.ID,
+			"issue":      id,
+			"date_added": issue.DateAdded,
+		})
+
+		if err != nil {
+			return report, err
+		}
+	}
+
+	for _, entry := range archive.Progress {
+		archivable, id, exists := resolve(entry.Type, entry.Material)
+
+		if !exists {
+			continue
+		}
+
+		err = archiver.restoreRow(archivable.progress, fmt.Sprintf("owner=%d AND %s=%d AND date_recorded=%d", collection.Owner, archivable.Column, id, entry.DateRecorded), &report.Progress, &report.Skipped, pgx.NamedArgs{
+			"owner":           collection.Owner,
+			archivable.Column: id,
+			"status":          entry.Status,
+			"progress":        entry.Progress,
+			"date_recorded":   entry.DateRecorded,
+		})
+
+		if err != nil {
+			return report, err
+		}
+	}
+
+	for _, entry := range archive.EpisodeProgress {
+		id, exists := episodeIds[entry.Episode]
+
+		if !exists {
+			report.Skipped++
+
+			continue
+		}
+
+		err = archiver.restoreRow(episodeProgress, fmt.Sprintf("owner=%d AND episode=%d AND date_watched=%d", collection.Owner, id, entry.DateWatched), &report.Progress, &report.Skipped, pgx.NamedArgs{
+			"owner":        collection.Owner,
+			"episode":      id,
+			"date_watched": entry.DateWatched,
+		})
+
+		if err != nil {
+			return report, err
+		}
+	}
+
+	for _, entry := range archive.IssueProgress {
+		id, exists := issueIds[entry.Issue]
+
+		if !exists {
+			report.Skipped++
+
+			continue
+		}
+
+		err = archiver.restoreRow(issueProgress, fmt.Sprintf("owner=%d AND issue=%d AND date_read=%d", collection.Owner, id, entry.DateRead), &report.Progress, &report.Skipped, pgx.NamedArgs{
+			"owner":     collection.Owner,
+			"issue":     id,
+			"date_read": entry.DateRead,
+		})
+
+		if err != nil {
+			return report, err
+		}
+	}
+
+	for _, review := range archive.Reviews {
+		archivable, id, exists := resolve(review.Type, review.Material)
+
+		if !exists {
+			continue
+		}
+
+		err = archiver.restoreRow(archivable.review, fmt.Sprintf("owner=%d AND %s=%d", collection.Owner, archivable.Column, id), &report.Reviews, &report.Skipped, pgx.NamedArgs{
+			"owner":           collection.Owner,
+			archivable.Column: id,
+			"rating":          review.Rating,
+			"review":          review.Review,
+			"date_created":    review.DateCreated,
+			"date_updated":    review.DateUpdated,
+		})
+
+		if err != nil {
+			return report, err
+		}
+	}
+
+	tagIds := make(map[string]int)
+
+	for _, tag := range archive.Tags {
+		archivable, id, exists := resolve(tag.Type, tag.Material)
+
+		if !exists {
+			continue
+		}
+
+		if _, resolved := tagIds[tag.Name]; !resolved {
+			tagIds[tag.Name], err = archiver.restoreTag(collection.Owner, tag.Name)
+
+			if err != nil {
+				return report, err
+			}
+		}
+
+		err = archiver.restoreRow(archivable.tag, fmt.Sprintf("tag=%d AND %s=%d", tagIds[tag.Name], archivable.Column, id), &report.Tags, &report.Skipped, pgx.NamedArgs{
+			archivable.Column: id,
+			"tag":             tagIds[tag.Name],
+		})
+
+		if err != nil {
+			return report, err
+		}
+	}
+
+	for _, list := range archive.Lists {
+		var itemSlice []model.ArchiveListItem
+
+		for _, item := range list.Items {
+			if _, id, exists := resolve(item.Type, item.Material); exists {
+				itemSlice = append(itemSlice, model.ArchiveListItem{Position: item.Position, Type: item.Type, Material: id})
+			}
+		}
+
+		err = archiver.restoreList(collection.Owner, list, itemSlice, &report)
+
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// Store a row of per-user data in the provided table, unless a row matching the provided constraint exists, and count
+// it as restored or skipped.
+func (archiver *Archiver) restoreRow(table table, constraint string, restored *int, skipped *int, arguments pgx.NamedArgs) error {
+	existingSlice, err := query[int](archiver.connection, "1", table.name, constraint)
+
+	if err != nil {
+		archiver.logger.Printf("Unable to fetch existing row in '%s' with constraint '%s': %v", table.name, constraint, err)
+
+		return err
+	}
+
+	if len(existingSlice) > 0 {
+		*skipped++
+
+		return nil
+	}
+
+	err = service.StoreRelationship(archiver.connection, table.name, table.properties, arguments)
+
+	if err != nil {
+		archiver.logger.Printf("Unable to restore row in '%s' with constraint '%s': %v", table.name, constraint, err)
+
+		return err
+	}
+
+	*restored++
+
+	return nil
+}
+
+func (archiver *Archiver) restoreTag(owner int, name string) (int, error) {
+	existingTag, err := service.FetchFragment[tagModel.TagFragment](archiver.connection, database.TableTagFragments, fmt.Sprintf("owner=%d AND name='%s'", owner, util.FormatPSQLString(name)))
+
+	if err != nil {
+		archiver.logger.Printf("Unable to fetch existing tag '%s' of user '%d': %v", name, owner, err)
+
+		return 0, err
+	}
+
+	if existingTag.ID != 0 {
+		return existingTag.ID, nil
+	}
+
+	tagId, err := service.StoreFragment(archiver.connection, database.TableTagFragments, database.PropertiesTagFragments, pgx.NamedArgs{
+		"owner": owner,
+		"name":  name,
+	})
+
+	if err != nil {
+		archiver.logger.Printf("Unable to restore tag '%s' of user '%d': %v", name, owner, err)
+
+		return 0, err
+	}
+
+	return tagId, nil
+}
+
+// Restore a list with its restorable items, unless the user has a list with the same name.
+func (archiver *Archiver) restoreList(owner int, list model.ArchiveList, itemSlice []model.ArchiveListItem, report *model.RestoreReport) error {
+	existingList, err := service.FetchFragment[listModel.ListFragment](archiver.connection, database.TableListFragments, fmt.Sprintf("owner=%d AND name='%s'", owner, util.FormatPSQLString(list.Name)))
+
+	if err != nil {
+		archiver.logger.Printf("Unable to fetch existing list '%s' of user '%d': %v", list.Name, owner, err)
+
+		return err
+	}
+
+	if existingList.ID != 0 {
+		report.Skipped++
+
+		return nil
+	}
+
+	listId, err := service.StoreFragment(archiver.connection, database.TableListFragments, database.PropertiesListFragments, pgx.NamedArgs{
+		"owner":        owner,
+		"name":         list.Name,
+		"description":  list.Description,
+		"date_created": list.DateCreated,
+	})
+
+	if err != nil {
+		archiver.logger.Printf("Unable to restore list '%s' of user '%d': %v", list.Name, owner, err)
+
+		return err
+	}
+
+	for _, item := range itemSlice {
+		_, err = service.StoreFragment(archiver.connection, database.TableListItemFragments, database.PropertiesListItemFragments, pgx.NamedArgs{
+			"list":     listId,
+			"position": item.Position,
+			"type":     item.Type,
+			"material": item.Material,
+		})
+
+		if err != nil {
+			archiver.logger.Printf("Unable to restore %s '%d' in list '%d': %v", item.Type, item.Material, listId, err)
+
+			return err
+		}
+	}
+
+	report.Lists++
+
+	return nil
+}
+
+// Restore books, matched by OL edition identifier, with works matched by OL work identifier, authors matched by OL
+// author identifier, and narrators, publishers, topics, and series matched by name.
+//
+// Return: archived numeric identifiers mapped to restored numeric identifiers.
+func (archiver *Archiver) restoreBooks(bookSlice []bookModel.Book, restored *int) map[int]int {
+	ids := make(map[int]int)
+
+	for _, book := range bookSlice {
+		existingBook, err := service.FetchFragment[bookModel.BookFragment](archiver.connection, database.TableBookFragments, fmt.Sprintf("edition_reference='%s'", util.FormatPSQLString(book.EditionReference)))
+
+		if err != nil {
+			archiver.logger.Printf("Unable to fetch existing book '%s': %v", book.EditionReference, err)
+
+			continue
+		}
+
+		if existingBook.ID != 0 {
+			ids[book.ID] = existingBook.ID
+
+			continue
+		}
+
+		workIdSlice, err := service.FetchExistenceSlice(archiver.connection, database.TableBookWorkFragments, fmt.Sprintf("reference='%s'", util.FormatPSQLString(book.WorkReference)))
+
+		if err != nil {
+			archiver.logger.Printf("Unable to fetch existing work '%s': %v", book.WorkReference, err)
+
+			continue
+		}
+
+		if len(workIdSlice) == 0 {
+			workId, err := service.StoreFragment(archiver.connection, database.TableBookWorkFragments, database.PropertiesBookWorkFragments, pgx.NamedArgs{
+				"title":       book.Title,
+				"description": book.Description,
+				"reference":   book.WorkReference,
+			})
+
+			if err != nil {
+				archiver.logger.Printf("Unable to restore work '%s': %v", book.WorkReference, err)
+
+				continue
+			}
+
+			var topicIdSlice []int
+
+			for _, topic := range book.Topics {
+				topicIdSlice = archiver.appendFragmentId(topicIdSlice, database.TableBookTopicFragments, database.PropertiesBookTopicFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(topic.Name)), pgx.NamedArgs{
+					"name": topic.Name,
+				})
+			}
+
+			archiver.storeRelationshipSlice(database.TableBookWorkTopicRelationships, database.PropertiesBookWorkTopicRelationships, workId, topicIdSlice)
+		}
+
+		if book.Languages == nil {
+			book.Languages = []string{}
+		}
+
+		bookId, err := service.StoreFragment(archiver.connection, database.TableBookFragments, database.PropertiesBookFragments, pgx.NamedArgs{
+			"title":             book.Title,
+			"subtitle":          book.Subtitle,
+			"publish_date":      book.PublishDate,
+			"pages":             book.Pages,
+			"format":            book.Format,
+			"duration":          book.Duration,
+			"languages":         book.Languages,
+			"original_language": book.OriginalLanguage,
+			"translation_of":    book.TranslationOf,
+			"isbn10":            book.ISBN10,
+			"isbn13":            book.ISBN13,
+			"image":             book.Image,
+			"edition_reference": book.EditionReference,
+			"work_reference":    book.WorkReference,
+		})
+
+		if err != nil {
+			archiver.logger.Printf("Unable to restore book '%s': %v", book.EditionReference, err)
+
+			continue
+		}
+
+		var authorIdSlice, narratorIdSlice, publisherIdSlice []int
+
+		for _, author := range book.Authors {
+			name := personname.Name{First: author.FirstName, Middle: author.MiddleName, Last: author.LastName}
+
+			if author.DisplayName == "" {
+				author.DisplayName = name.Display()
+			}
+
+			if author.SortName == "" {
+				author.SortName = name.Sort()
+			}
+
+			if author.AlternateNames == nil {
+				author.AlternateNames = []string{}
+			}
+
+			authorIdSlice = archiver.appendFragmentId(authorIdSlice, database.TableBookAuthorFragments, database.PropertiesBookAuthorFragments, fmt.Sprintf("reference='%s'", util.FormatPSQLString(author.Reference)), pgx.NamedArgs{
+				"first_name":      author.FirstName,
+				"middle_name":     author.MiddleName,
+				"last_name":       author.LastName,
+				"display_name":    author.DisplayName,
+				"sort_name":       author.SortName,
+				"alternate_names": author.AlternateNames,
+				"biography":       author.Biography,
+				"image":           author.Image,
+				"reference":       author.Reference,
+			})
+		}
+
+		for _, narrator := range book.Narrators {
+			if narrator.SortName == "" {
+				narrator.SortName = personname.Parse(narrator.Name).Sort()
+			}
+
+			narratorIdSlice = archiver.appendFragmentId(narratorIdSlice, database.TableBookNarratorFragments, database.PropertiesBookNarratorFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(narrator.Name)), pgx.NamedArgs{
+				"name":      narrator.Name,
+				"sort_name": narrator.SortName,
+			})
+		}
+
+		for _, publisher := range book.Publishers {
+			publisherIdSlice = archiver.appendFragmentId(publisherIdSlice, database.TableBookPublisherFragments, database.PropertiesBookPublisherFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(publisher.Name)), pgx.NamedArgs{
+				"name": publisher.Name,
+			})
+		}
+
+		archiver.storeRelationshipSlice(database.TableBookAuthorRelationships, database.PropertiesBookAuthorRelationships, bookId, authorIdSlice)
+		archiver.storeRelationshipSlice(database.TableBookNarratorRelationships, database.PropertiesBookNarratorRelationships, bookId, narratorIdSlice)
+		archiver.storeRelationshipSlice(database.TableBookPublisherRelationships, database.PropertiesBookPublisherRelationships, bookId, publisherIdSlice)
+
+		for _, series := range book.Series {
+			seriesIdSlice := archiver.appendFragmentId(nil, database.TableBookSeriesFragments, database.PropertiesBookSeriesFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(series.Name)), pgx.NamedArgs{
+				"name": series.Name,
+			})
+
+			if len(seriesIdSlice) == 0 {
+				continue
+			}
+
+			err = service.StoreRelationship(archiver.connection, database.TableBookSeriesRelationships, database.PropertiesBookSeriesRelationships, pgx.NamedArgs{
+				"book":     bookId,
+				"series":   seriesIdSlice[0],
+				"position": series.Position,
+			})
+
+			if err != nil {
+				archiver.logger.Printf("Unable to restore book '%s' series '%s': %v", book.EditionReference, series.Name, err)
+			}
+		}
+
+		ids[book.ID] = bookId
+		*restored++
+	}
+
+	return ids
+}
+
+// Restore games, with games, franchises, genres, platforms, and studios matched by IGDB identifier, and their releases
+// per platform and region.
+//
+// Return: archived numeric identifiers mapped to restored numeric identifiers.
+func (archiver *Archiver) restoreGames(gameSlice []gameModel.Game, restored *int) map[int]int {
+	ids := make(map[int]int)
+
+	for _, game := range gameSlice {
+		existingGame, err := service.FetchFragment[gameModel.GameFragment](archiver.connection, database.TableGameFragments, fmt.Sprintf("reference=%d", game.Reference))
+
+		if err != nil {
+			archiver.logger.Printf("Unable to fetch existing game '%d': %v", game.Reference, err)
+
+			continue
+		}
+
+		if existingGame.ID != 0 {
+			ids[game.ID] = existingGame.ID
+
+			continue
+		}
+
+		gameId, err := service.StoreFragment(archiver.connection, database.TableGameFragments, database.PropertiesGameFragments, pgx.NamedArgs{
+			"title":        game.Title,
+			"summary":      game.Summary,
+			"storyline":    game.Storyline,
+			"release_date": game.ReleaseDate,
+			"image":        game.Image,
+			"reference":    game.Reference,
+		})
+
+		if err != nil {
+			archiver.logger.Printf("Unable to restore game '%d': %v", game.Reference, err)
+
+			continue
+		}
+
+		var franchiseIdSlice, genreIdSlice, platformIdSlice, studioIdSlice []int
+
+		for _, franchise := range game.Franchises {
+			franchiseIdSlice = archiver.appendFragmentId(franchiseIdSlice, database.TableGameFranchiseFragments, database.PropertiesGameFranchiseFragments, fmt.Sprintf("reference=%d", franchise.Reference), pgx.NamedArgs{
+				"name":      franchise.Name,
+				"reference": franchise.Reference,
+			})
+		}
+
+		for _, genre := range game.Genres {
+			genreIdSlice = archiver.appendFragmentId(genreIdSlice, database.TableGameGenreFragments, database.PropertiesGameGenreFragments, fmt.Sprintf("reference=%d", genre.Reference), pgx.NamedArgs{
+				"name":      genre.Name,
+				"reference": genre.Reference,
+			})
+		}
+
+		for _, platform := range game.Platforms {
+			platformIdSlice = archiver.appendFragmentId(platformIdSlice, database.TableGamePlatformFragments, database.PropertiesGamePlatformFragments, fmt.Sprintf("reference=%d", platform.Reference), pgx.NamedArgs{
+				"name":      platform.Name,
+				"reference": platform.Reference,
+			})
+		}
+
+		for _, studio := range game.Studios {
+			studioIdSlice = archiver.appendFragmentId(studioIdSlice, database.TableGameStudioFragments, database.PropertiesGameStudioFragments, fmt.Sprintf("reference=%d", studio.Reference), pgx.NamedArgs{
+				"name":        studio.Name,
+				"description": studio.Description,
+				"reference":   studio.Reference,
+			})
+		}
+
+		archiver.storeRelationshipSlice(database.TableGameFranchiseRelationships, database.PropertiesGameFranchiseRelationships, gameId, franchiseIdSlice)
+		archiver.storeRelationshipSlice(database.TableGameGenreRelationships, database.PropertiesGameGenreRelationships, gameId, genreIdSlice)
+		archiver.storeRelationshipSlice(database.TableGamePlatformRelationships, database.PropertiesGamePlatformRelationships, gameId, platformIdSlice)
+		archiver.storeRelationshipSlice(database.TableGameStudioRelationships, database.PropertiesGameStudioRelationships, gameId, studioIdSlice)
+
+		for _, release := range game.Releases {
+			releasePlatformIdSlice := archiver.appendFragmentId(nil, database.TableGamePlatformFragments, database.PropertiesGamePlatformFragments, fmt.Sprintf("reference=%d", release.Platform.Reference), pgx.NamedArgs{
+				"name":      release.Platform.Name,
+				"reference": release.Platform.Reference,
+			})
+
+			if len(releasePlatformIdSlice) == 0 {
+				continue
+			}
+
+			_, err = service.StoreFragment(archiver.connection, database.TableGameReleaseFragments, database.PropertiesGameReleaseFragments, pgx.NamedArgs{
+				"game":         gameId,
+				"platform":     releasePlatformIdSlice[0],
+				"region":       release.Region,
+				"release_date": release.ReleaseDate,
+			})
+
+			if err != nil {
+				archiver.logger.Printf("Unable to restore game '%d' release on platform '%d': %v", game.Reference, release.Platform.Reference, err)
+			}
+		}
+
+		ids[game.ID] = gameId
+		*restored++
+	}
+
+	return ids
+}
+
+// Restore movies, with movies, genres, production companies, and credited people matched by TMDB identifier.
+//
+// Return: archived numeric identifiers mapped to restored numeric identifiers.
+func (archiver *Archiver) restoreMovies(movieSlice []movieModel.Movie, restored *int) map[int]int {
+	ids := make(map[int]int)
+
+	for _, movie := range movieSlice {
+		existingMovie, err := service.FetchFragment[movieModel.MovieFragment](archiver.connection, database.TableMovieFragments, fmt.Sprintf("reference=%d", movie.Reference))
+
+		if err != nil {
+			archiver.logger.Printf("Unable to fetch existing movie '%d': %v", movie.Reference, err)
+
+			continue
+		}
+
+		if existingMovie.ID != 0 {
+			ids[movie.ID] = existingMovie.ID
+
+			continue
+		}
+
+		movieId, err := service.StoreFragment(archiver.connection, database.TableMovieFragments, database.PropertiesMovieFragments, pgx.NamedArgs{
+			"title":        movie.Title,
+			"tagline":      movie.Tagline,
+			"description":  movie.Description,
+			"release_date": movie.ReleaseDate,
+			"runtime":      movie.Runtime,
+			"image":        movie.Image,
+			"reference":    movie.Reference,
+		})
+
+		if err != nil {
+			archiver.logger.Printf("Unable to restore movie '%d': %v", movie.Reference, err)
+
+			continue
+		}
+
+		var genreIdSlice, companyIdSlice []int
+
+		for _, genre := range movie.Genres {
+			genreIdSlice = archiver.appendFragmentId(genreIdSlice, database.TableMovieGenreFragments, database.PropertiesMovieGenreFragments, fmt.Sprintf("reference=%d", genre.Reference), pgx.NamedArgs{
+				"name":      genre.Name,
+				"reference": genre.Reference,
+			})
+		}
+
+		for _, company := range movie.ProductionCompanies {
+			companyIdSlice = archiver.appendFragmentId(companyIdSlice, database.TableMovieProductionCompanyFragments, database.PropertiesMovieProductionCompanyFragments, fmt.Sprintf("reference=%d", company.Reference), pgx.NamedArgs{
+				"name":      company.Name,
+				"image":     company.Image,
+				"reference": company.Reference,
+			})
+		}
+
+		archiver.storeRelationshipSlice(database.TableMovieGenreRelationships, database.PropertiesMovieGenreRelationships, movieId, genreIdSlice)
+		archiver.storeRelationshipSlice(database.TableMovieProductionCompanyRelationships, database.PropertiesMovieProductionCompanyRelationships, movieId, companyIdSlice)
+
+		for _, roleCredits := range []struct {
+			role    string
+			credits []movieModel.MovieCredit
+		}{{movieHelper.RoleCast, movie.Cast}, {movieHelper.RoleCrew, movie.Crew}} {
+			for _, credit := range roleCredits.credits {
+				personIdSlice := archiver.appendFragmentId(nil, database.TableMoviePersonFragments, database.PropertiesMoviePersonFragments, fmt.Sprintf("reference=%d", credit.Person.Reference), pgx.NamedArgs{
+					"name":       credit.Person.Name,
+					"image":      credit.Person.Image,
+					"department": credit.Person.Department,
+					"reference":  credit.Person.Reference,
+				})
+
+				if len(personIdSlice) == 0 {
+					continue
+				}
+
+				err = service.StoreRelationship(archiver.connection, database.TableMoviePersonRelationships, database.PropertiesMoviePersonRelationships, pgx.NamedArgs{
+					"movie":     movieId,
+					"person":    personIdSlice[0],
+					"role":      roleCredits.role,
+					"job":       credit.Job,
+					"character": credit.Character,
+					"billing":   credit.Billing,
+				})
+
+				if err != nil {
+					archiver.logger.Printf("Unable to restore credit of person '%d' in movie '%d': %v", credit.Person.Reference, movie.Reference, err)
+				}
+			}
+		}
+
+		ids[movie.ID] = movieId
+		*restored++
+	}
+
+	return ids
+}
+
+// Restore shows, with shows, genres, networks, seasons, and episodes matched by TMDB identifier.
+//
+// Return: archived numeric identifiers of shows and of their episodes, each mapped to restored numeric identifiers.
+func (archiver *Archiver) restoreShows(showSlice []showModel.Show, restored *int) (map[int]int, map[int]int) {
+	ids := make(map[int]int)
+	episodeIds := make(map[int]int)
+
+	for _, show := range showSlice {
+		existingShow, err := service.FetchFragment[showModel.ShowFragment](archiver.connection, database.TableShowFragments, fmt.Sprintf("reference=%d", show.Reference))
+
+		if err != nil {
+			archiver.logger.Printf("Unable to fetch existing show '%d': %v", show.Reference, err)
+
+			continue
+		}
+
+		if existingShow.ID != 0 {
+			ids[show.ID] = existingShow.ID
+
+			episodeSlice, err := service.FetchFragmentSlice[showModel.ShowEpisodeFragment](archiver.connection, database.TableShowEpisodeFragments, fmt.Sprintf("show=%d", existingShow.ID))
+
+			if err != nil {
+				archiver.logger.Printf("Unable to fetch existing episodes of show '%d': %v", show.Reference, err)
+
+				continue
+			}
+
+			for _, season := range show.Seasons {
+				for _, episode := range season.Episodes {
+					for _, existingEpisode := range episodeSlice {
+						if existingEpisode.Reference == episode.Reference {
+							episodeIds[episode.ID] = existingEpisode.ID
+						}
+					}
+				}
+			}
+
+			continue
+		}
+
+		showId, err := service.StoreFragment(archiver.connection, database.TableShowFragments, database.PropertiesShowFragments, pgx.NamedArgs{
+			"title":          show.Title,
+			"tagline":        show.Tagline,
+			"description":    show.Description,
+			"first_air_date": show.FirstAirDate,
+			"last_air_date":  show.LastAirDate,
+			"status":         show.Status,
+			"seasons":        len(show.Seasons),
+			"episodes":       show.Episodes,
+			"runtime":        show.Runtime,
+			"image":          show.Image,
+			"reference":      show.Reference,
+		})
+
+		if err != nil {
+			archiver.logger.Printf("Unable to restore show '%d': %v", show.Reference, err)
+
+			continue
+		}
+
+		var genreIdSlice, networkIdSlice []int
+
+		for _, genre := range show.Genres {
+			genreIdSlice = archiver.appendFragmentId(genreIdSlice, database.TableShowGenreFragments, database.PropertiesShowGenreFragments, fmt.Sprintf("reference=%d", genre.Reference), pgx.NamedArgs{
+				"name":      genre.Name,
+				"reference": genre.Reference,
+			})
+		}
+
+		for _, network := range show.Networks {
+			networkIdSlice = archiver.appendFragmentId(networkIdSlice, database.TableShowNetworkFragments, database.PropertiesShowNetworkFragments, fmt.Sprintf("reference=%d", network.Reference), pgx.NamedArgs{
+				"name":      network.Name,
+				"image":     network.Image,
+				"reference": network.Reference,
+			})
+		}
+
+		archiver.storeRelationshipSlice(database.TableShowGenreRelationships, database.PropertiesShowGenreRelationships, showId, genreIdSlice)
+		archiver.storeRelationshipSlice(database.TableShowNetworkRelationships, database.PropertiesShowNetworkRelationships, showId, networkIdSlice)
+
+		for _, season := range show.Seasons {
+			seasonId, err := service.StoreFragment(archiver.connection, database.TableShowSeasonFragments, database.PropertiesShowSeasonFragments, pgx.NamedArgs{
+				"show":        showId,
+				"number":      season.Number,
+				"title":       season.Title,
+				"description": season.Description,
+				"air_date":    season.AirDate,
+				"image":       season.Image,
+				"reference":   season.Reference,
+			})
+
+			if err != nil {
+				archiver.logger.Printf("Unable to restore show '%d' season '%d': %v", show.Reference, season.Number, err)
+
+				continue
+			}
+
+			for _, episode := range season.Episodes {
+				episodeId, err := service.StoreFragment(archiver.connection, database.TableShowEpisodeFragments, database.PropertiesShowEpisodeFragments, pgx.NamedArgs{
+					"show":        showId,
+					"season":      seasonId,
+					"number":      episode.Number,
+					"title":       episode.Title,
+					"description": episode.Description,
+					"air_date":    episode.AirDate,
+					"runtime":     episode.Runtime,
+					"image":       episode.Image,
+					"reference":   episode.Reference,
+				})
+
+				if err != nil {
+					archiver.logger.Printf("Unable to restore show '%d' season '%d' episode '%d': %v", show.Reference, season.Number, episode.Number, err)
+
+					continue
+				}
+
+				episodeIds[episode.ID] = episodeId
+			}
+		}
+
+		ids[show.ID] = showId
+		*restored++
+	}
+
+	return ids, episodeIds
+}
+
+// Restore albums, with albums, artists, and labels matched by MBID, genres matched by name, and their track lists.
+//
+// Return: archived numeric identifiers mapped to restored numeric identifiers.
+func (archiver *Archiver) restoreAlbums(albumSlice []albumModel.Album, restored *int) map[int]int {
+	ids := make(map[int]int)
+
+	for _, album := range albumSlice {
+		existingAlbum, err := service.FetchFragment[albumModel.AlbumFragment](archiver.connection, database.TableAlbumFragments, fmt.Sprintf("reference='%s'", util.FormatPSQLString(album.Reference)))
+
+		if err != nil {
+			archiver.logger.Printf("Unable to fetch existing album '%s': %v", album.Reference, err)
+
+			continue
+		}
+
+		if existingAlbum.ID != 0 {
+			ids[album.ID] = existingAlbum.ID
+
+			continue
+		}
+
+		albumId, err := service.StoreFragment(archiver.connection, database.TableAlbumFragments, database.PropertiesAlbumFragments, pgx.NamedArgs{
+			"title":           album.Title,
+			"artist_credit":   album.ArtistCredit,
+			"release_date":    album.ReleaseDate,
+			"country":         album.Country,
+			"format":          album.Format,
+			"discs":           album.Discs,
+			"tracks":          len(album.Tracks),
+			"runtime":         album.Runtime,
+			"barcode":         album.Barcode,
+			"image":           album.Image,
+			"reference":       album.Reference,
+			"group_reference": album.GroupReference,
+		})
+
+		if err != nil {
+			archiver.logger.Printf("Unable to restore album '%s': %v", album.Reference, err)
+
+			continue
+		}
+
+		for position, artist := range album.Artists {
+			artistIdSlice := archiver.appendFragmentId(nil, database.TableAlbumArtistFragments, database.PropertiesAlbumArtistFragments, fmt.Sprintf("reference='%s'", util.FormatPSQLString(artist.Reference)), pgx.NamedArgs{
+				"name":      artist.Name,
+				"sort_name": artist.SortName,
+				"reference": artist.Reference,
+			})
+
+			if len(artistIdSlice) == 0 {
+				continue
+			}
+
+			err = service.StoreRelationship(archiver.connection, database.TableAlbumArtistRelationships, database.PropertiesAlbumArtistRelationships, pgx.NamedArgs{
+				"album":    albumId,
+				"artist":   artistIdSlice[0],
+				"position": position,
+			})
+
+			if err != nil {
+				archiver.logger.Printf("Unable to restore album '%s' artist '%s': %v", album.Reference, artist.Reference, err)
+			}
+		}
+
+		for _, label := range album.Labels {
+			labelIdSlice := archiver.appendFragmentId(nil, database.TableAlbumLabelFragments, database.PropertiesAlbumLabelFragments, fmt.Sprintf("reference='%s'", util.FormatPSQLString(label.Reference)), pgx.NamedArgs{
+				"name":      label.Name,
+				"reference": label.Reference,
+			})
+
+			if len(labelIdSlice) == 0 {
+				continue
+			}
+
+			err = service.StoreRelationship(archiver.connection, database.TableAlbumLabelRelationships, database.PropertiesAlbumLabelRelationships, pgx.NamedArgs{
+				"album":          albumId,
+				"label":          labelIdSlice[0],
+				"catalog_number": label.CatalogNumber,
+			})
+
+			if err != nil {
+				archiver.logger.Printf("Unable to restore album '%s' label '%s': %v", album.Reference, label.Reference, err)
+			}
+		}
+
+		var genreIdSlice []int
+
+		for _, genre := range album.Genres {
+			genreIdSlice = archiver.appendFragmentId(genreIdSlice, database.TableAlbumGenreFragments, database.PropertiesAlbumGenreFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(genre.Name)), pgx.NamedArgs{
+				"name": genre.Name,
+			})
+		}
+
+		archiver.storeRelationshipSlice(database.TableAlbumGenreRelationships, database.PropertiesAlbumGenreRelationships, albumId, genreIdSlice)
+
+		for _, track := range album.Tracks {
+			_, err = service.StoreFragment(archiver.connection, database.TableAlbumTrackFragments, database.PropertiesAlbumTrackFragments, pgx.NamedArgs{
+				"album":     albumId,
+				"disc":      track.Disc,
+				"position":  track.Position,
+				"number":    track.Number,
+				"title":     track.Title,
+				"length":    track.Length,
+				"reference": track.Reference,
+			})
+
+			if err != nil {
+				archiver.logger.Printf("Unable to restore album '%s' track '%s': %v", album.Reference, track.Reference, err)
+			}
+		}
+
+		ids[album.ID] = albumId
+		*restored++
+	}
+
+	return ids
+}
+
+// Restore board games, with board games, designers, publishers, mechanics, and categories matched by BGG identifier.
+//
+// Return: archived numeric identifiers mapped to restored numeric identifiers.
+func (archiver *Archiver) restoreBoardGames(boardGameSlice []boardGameModel.BoardGame, restored *int) map[int]int {
+	ids := make(map[int]int)
+
+	for _, boardGame := range boardGameSlice {
+		existingBoardGame, err := service.FetchFragment[boardGameModel.BoardGameFragment](archiver.connection, database.TableBoardGameFragments, fmt.Sprintf("reference=%d", boardGame.Reference))
+
+		if err != nil {
+			archiver.logger.Printf("Unable to fetch existing board game '%d': %v", boardGame.Reference, err)
+
+			continue
+		}
+
+		if existingBoardGame.ID != 0 {
+			ids[boardGame.ID] = existingBoardGame.ID
+
+			continue
+		}
+
+		boardGameId, err := service.StoreFragment(archiver.connection, database.TableBoardGameFragments, database.PropertiesBoardGameFragments, pgx.NamedArgs{
+			"title":         boardGame.Title,
+			"description":   boardGame.Description,
+			"release_date":  boardGame.ReleaseDate,
+			"min_players":   boardGame.MinPlayers,
+			"max_players":   boardGame.MaxPlayers,
+			"playing_time":  boardGame.PlayingTime,
+			"min_play_time": boardGame.MinPlayTime,
+			"max_play_time": boardGame.MaxPlayTime,
+			"min_age":       boardGame.MinAge,
+			"image":         boardGame.Image,
+			"reference":     boardGame.Reference,
+		})
+
+		if err != nil {
+			archiver.logger.Printf("Unable to restore board game '%d': %v", boardGame.Reference, err)
+
+			continue
+		}
+
+		var designerIdSlice, publisherIdSlice, mechanicIdSlice, categoryIdSlice []int
+
+		for _, designer := range boardGame.Designers {
+			designerIdSlice = archiver.appendFragmentId(designerIdSlice, database.TableBoardGameDesignerFragments, database.PropertiesBoardGameDesignerFragments, fmt.Sprintf("reference=%d", designer.Reference), pgx.NamedArgs{
+				"name":      designer.Name,
+				"reference": designer.Reference,
+			})
+		}
+
+		for _, publisher := range boardGame.Publishers {
+			publisherIdSlice = archiver.appendFragmentId(publisherIdSlice, database.TableBoardGamePublisherFragments, database.PropertiesBoardGamePublisherFragments, fmt.Sprintf("reference=%d", publisher.Reference), pgx.NamedArgs{
+				"name":      publisher.Name,
+				"reference": publisher.Reference,
+			})
+		}
+
+		for _, mechanic := range boardGame.Mechanics {
+			mechanicIdSlice = archiver.appendFragmentId(mechanicIdSlice, database.TableBoardGameMechanicFragments, database.PropertiesBoardGameMechanicFragments, fmt.Sprintf("reference=%d", mechanic.Reference), pgx.NamedArgs{
+				"name":      mechanic.Name,
+				"reference": mechanic.Reference,
+			})
+		}
+
+		for _, category := range boardGame.Categories {
+			categoryIdSlice = archiver.appendFragmentId(categoryIdSlice, database.TableBoardGameCategoryFragments, database.PropertiesBoardGameCategoryFragments, fmt.Sprintf("reference=%d", category.Reference), pgx.NamedArgs{
+				"name":      category.Name,
+				"reference": category.Reference,
+			})
+		}
+
+		archiver.storeRelationshipSlice(database.TableBoardGameDesignerRelationships, database.PropertiesBoardGameDesignerRelationships, boardGameId, designerIdSlice)
+		archiver.storeRelationshipSlice(database.TableBoardGamePublisherRelationships, database.PropertiesBoardGamePublisherRelationships, boardGameId, publisherIdSlice)
+		archiver.storeRelationshipSlice(database.TableBoardGameMechanicRelationships, database.PropertiesBoardGameMechanicRelationships, boardGameId, mechanicIdSlice)
+		archiver.storeRelationshipSlice(database.TableBoardGameCategoryRelationships, database.PropertiesBoardGameCategoryRelationships, boardGameId, categoryIdSlice)
+
+		ids[boardGame.ID] = boardGameId
+		*restored++
+	}
+
+	return ids
+}
+
+// Restore comics, matched by the Comic Vine identifier of any of their volumes, with volumes, issues, creators, and
+// publishers matched or stored by Comic Vine identifier.
+//
+// Return: archived numeric identifiers of comics and of their issues, each mapped to restored numeric identifiers.
+func (archiver *Archiver) restoreComics(comicSlice []comicModel.Comic, restored *int) (map[int]int, map[int]int) {
+	ids := make(map[int]int)
+	issueIds := make(map[int]int)
+
+	for _, comic := range comicSlice {
+		existingComicId, err := archiver.fetchComicId(comic)
+
+		if err != nil {
+			archiver.logger.Printf("Unable to fetch existing comic '%s': %v", comic.Title, err)
+
+			continue
+		}
+
+		if existingComicId != 0 {
+			ids[comic.ID] = existingComicId
+
+			issueSlice, err := service.FetchFragmentSlice[comicModel.ComicIssueFragment](archiver.connection, database.TableComicIssueFragments, fmt.Sprintf("comic=%d", existingComicId))
+
+			if err != nil {
+				archiver.logger.Printf("Unable to fetch existing issues of comic '%s': %v", comic.Title, err)
+
+				continue
+			}
+
+			for _, volume := range comic.Volumes {
+				for _, issue := range volume.Issues {
+					for _, existingIssue := range issueSlice {
+						if existingIssue.Reference == issue.Reference {
+							issueIds[issue.ID] = existingIssue.ID
+						}
+					}
+				}
+			}
+
+			continue
+		}
+
+		comicId, err := service.StoreFragment(archiver.connection, database.TableComicFragments, database.PropertiesComicFragments, pgx.NamedArgs{
+			"title":       comic.Title,
+			"description": comic.Description,
+			"start_date":  comic.StartDate,
+			"volumes":     len(comic.Volumes),
+			"issues":      comic.Issues,
+			"image":       comic.Image,
+		})
+
+		if err != nil {
+			archiver.logger.Printf("Unable to restore comic '%s': %v", comic.Title, err)
+
+			continue
+		}
+
+		for _, volume := range comic.Volumes {
+			volumeId, err := service.StoreFragment(archiver.connection, database.TableComicVolumeFragments, database.PropertiesComicVolumeFragments, pgx.NamedArgs{
+				"comic":       comicId,
+				"title":       volume.Title,
+				"description": volume.Description,
+				"start_date":  volume.StartDate,
+				"issues":      len(volume.Issues),
+				"image":       volume.Image,
+				"reference":   volume.Reference,
+			})
+
+			if err != nil {
+				archiver.logger.Printf("Unable to restore comic '%s' volume '%d': %v", comic.Title, volume.Reference, err)
+
+				continue
+			}
+
+			for _, issue := range volume.Issues {
+				issueId, err := service.StoreFragment(archiver.connection, database.TableComicIssueFragments, database.PropertiesComicIssueFragments, pgx.NamedArgs{
+					"comic":      comicId,
+					"volume":     volumeId,
+					"number":     issue.Number,
+					"title":      issue.Title,
+					"cover_date": issue.CoverDate,
+					"image":      issue.Image,
+					"reference":  issue.Reference,
+				})
+
+				if err != nil {
+					archiver.logger.Printf("Unable to restore comic '%s' issue '%d': %v", comic.Title, issue.Reference, err)
+
+					continue
+				}
+
+				issueIds[issue.ID] = issueId
+			}
+		}
+
+		for _, creator := range comic.Creators {
+			creatorIdSlice := archiver.appendFragmentId(nil, database.TableComicCreatorFragments, database.PropertiesComicCreatorFragments, fmt.Sprintf("reference=%d", creator.Reference), pgx.NamedArgs{
+				"name":      creator.Name,
+				"reference": creator.Reference,
+			})
+
+			if len(creatorIdSlice) == 0 {
+				continue
+			}
+
+			err = service.StoreRelationship(archiver.connection, database.TableComicCreatorRelationships, database.PropertiesComicCreatorRelationships, pgx.NamedArgs{
+				"comic":   comicId,
+				"creator": creatorIdSlice[0],
+				"role":    creator.Role,
+			})
+
+			if err != nil {
+				archiver.logger.Printf("Unable to restore comic '%s' creator '%d': %v", comic.Title, creator.Reference, err)
+			}
+		}
+
+		var publisherIdSlice []int
+
+		for _, publisher := range comic.Publishers {
+			publisherIdSlice = archiver.appendFragmentId(publisherIdSlice, database.TableComicPublisherFragments, database.PropertiesComicPublisherFragments, fmt.Sprintf("reference=%d", publisher.Reference), pgx.NamedArgs{
+				"name":      publisher.Name,
+				"reference": publisher.Reference,
+			})
+		}
+
+		archiver.storeRelationshipSlice(database.TableComicPublisherRelationships, database.PropertiesComicPublisherRelationships, comicId, publisherIdSlice)
+
+		ids[comic.ID] = comicId
+		*restored++
+	}
+
+	return ids, issueIds
+}
+
+// Fetch the numeric identifier of the stored comic holding any volume of an archived comic.
+//
+// Return: comic numeric identifier and nil with success, 0 and nil when no volume is stored, or 0 and error without.
+func (archiver *Archiver) fetchComicId(comic comicModel.Comic) (int, error) {
+	for _, volume := range comic.Volumes {
+		existingVolume, err := service.FetchFragment[comicModel.ComicVolumeFragment](archiver.connection, database.TableComicVolumeFragments, fmt.Sprintf("reference=%d", volume.Reference))
+
+		if err != nil {
+			return 0, err
+		}
+
+		if existingVolume.ID != 0 {
+			return existingVolume.Comic, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// Restore podcasts, with podcasts matched by feed URL and categories matched by name, where a restored podcast is
+// marked as never refreshed so that the next refresh fetches episodes published since the archive was created.
+//
+// Return: archived numeric identifiers mapped to restored numeric identifiers.
+func (archiver *Archiver) restorePodcasts(podcastSlice []podcastModel.Podcast, restored *int) map[int]int {
+	ids := make(map[int]int)
+
+	for _, podcast := range podcastSlice {
+		existingPodcast, err := service.FetchFragment[podcastModel.PodcastFragment](archiver.connection, database.TablePodcastFragments, fmt.Sprintf("reference='%s'", util.FormatPSQLString(podcast.Reference)))
+
+		if err != nil {
+			archiver.logger.Printf("Unable to fetch existing podcast '%s': %v", podcast.Reference, err)
+
+			continue
+		}
+
+		if existingPodcast.ID != 0 {
+			ids[podcast.ID] = existingPodcast.ID
+
+			continue
+		}
+
+		podcastId, err := service.StoreFragment(archiver.connection, database.TablePodcastFragments, database.PropertiesPodcastFragments, pgx.NamedArgs{
+			"title":              podcast.Title,
+			"author":             podcast.Author,
+			"description":        podcast.Description,
+			"language":           podcast.Language,
+			"explicit":           podcast.Explicit,
+			"first_release_date": podcast.FirstReleaseDate,
+			"last_release_date":  podcast.LastReleaseDate,
+			"episodes":           len(podcast.Episodes),
+			"image":              podcast.Image,
+			"link":               podcast.Link,
+			"reference":          podcast.Reference,
+			"date_refreshed":     0,
+		})
+
+		if err != nil {
+			archiver.logger.Printf("Unable to restore podcast '%s': %v", podcast.Reference, err)
+
+			continue
+		}
+
+		var categoryIdSlice []int
+
+		for _, category := range podcast.Categories {
+			categoryIdSlice = archiver.appendFragmentId(categoryIdSlice, database.TablePodcastCategoryFragments, database.PropertiesPodcastCategoryFragments, fmt.Sprintf("name='%s'", util.FormatPSQLString(category.Name)), pgx.NamedArgs{
+				"name": category.Name,
+			})
+		}
+
+		archiver.storeRelationshipSlice(database.TablePodcastCategoryRelationships, database.PropertiesPodcastCategoryRelationships, podcastId, categoryIdSlice)
+
+		for _, episode := range podcast.Episodes {
+			_, err = service.StoreFragment(archiver.connection, database.TablePodcastEpisodeFragments, database.PropertiesPodcastEpisodeFragments, pgx.NamedArgs{
+				"podcast":      podcastId,
+				"title":        episode.Title,
+				"description":  episode.Description,
+				"release_date": episode.ReleaseDate,
+				"duration":     episode.Duration,
+				"season":       episode.Season,
+				"number":       episode.Number,
+				"audio":        episode.Audio,
+				"image":        episode.Image,
+				"reference":    episode.Reference,
+			})
+
+			if err != nil {
+				archiver.logger.Printf("Unable to restore podcast '%s' episode '%s': %v", podcast.Reference, episode.Reference, err)
+			}
+		}
+
+		ids[podcast.ID] = podcastId
+		*restored++
+	}
+
+	return ids
+}
+
+// Resolve the restored numeric identifier of a platform by the IGDB identifier of the archived platform with the
+// provided numeric identifier among the platforms of archived games.
+//
+// Return: restored numeric identifier and true with success, 0 and false without.
+func (archiver *Archiver) resolvePlatform(gameSlice []gameModel.Game, platform int) (int, bool) {
+	for _, game := range gameSlice {
+		for _, archivedPlatform := range game.Platforms {
+			if archivedPlatform.ID != platform {
+				continue
+			}
+
+			platformIdSlice, err := service.FetchExistenceSlice(archiver.connection, database.TableGamePlatformFragments, fmt.Sprintf("reference=%d", archivedPlatform.Reference))
+
+			if err != nil {
+				archiver.logger.Printf("Unable to fetch existing platform '%d': %v", archivedPlatform.Reference, err)
+
+				return 0, false
+			}
+
+			if len(platformIdSlice) == 0 {
+				return 0, false
+			}
+
+			return platformIdSlice[0], true
+		}
+	}
+
+	return 0, false
+}
+
+// Append the numeric identifier of the fragment matching the provided constraint, storing the fragment with the
+// provided named arguments when none matches, or nothing when unable to do either.
+func (archiver *Archiver) appendFragmentId(idSlice []int, table string, properties []string, constraint string, arguments pgx.NamedArgs) []int {
+	existingSlice, err := service.FetchExistenceSlice(archiver.connection, table, constraint)
+
+	if err != nil {
+		archiver.logger.Printf("Unable to fetch existing fragment in '%s' with constraint '%s': %v", table, constraint, err)
+
+		return idSlice
+	}
+
+	if len(existingSlice) > 0 {
+		return append(idSlice, existingSlice[0])
+	}
+
+	id, err := service.StoreFragment(archiver.connection, table, properties, arguments)
+
+	if err != nil {
+		archiver.logger.Printf("Unable to restore fragment in '%s' with constraint '%s': %v", table, constraint, err)
+
+		return idSlice
+	}
+
+	return append(idSlice, id)
+}
+
+// Store the relationships between a material and related fragments, where the properties name the material column
+// first (e.g., 'book', 'author').
+func (archiver *Archiver) storeRelationshipSlice(table string, properties []string, id int, destinationSlice []int) {
+	service.StoreRelationshipSlice(archiver.connection, table, properties, service.RelationshipSliceArgument{
+		SourceName:          properties[0],
+		SourceArgument:      id,
+		DestinationName:     properties[1],
+		DestinationArgument: destinationSlice,
+	})
+}
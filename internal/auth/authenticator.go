@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+var (
+	ErrMissingCredentials = errors.New("missing API key or bearer token")
+	ErrInvalidCredentials = errors.New("invalid API key or bearer token")
+)
+
+type apiKey struct {
+	key       string
+	principal Principal
+}
+
+// An authenticator, which resolves the principal of a request from an 'X-API-Key' header or an 'Authorization:
+// Bearer' JWT.
+type Authenticator struct {
+	enabled    bool
+	publicRead bool
+	keys       []apiKey
+	verifier   *verifier
+}
+
+// Create an authenticator with provided configuration, where JWKS keys are fetched with the provided timeout.
+//
+// Return: configured authenticator.
+func NewAuthenticator(configuration config.AuthConfig, timeout time.Duration) *Authenticator {
+	authenticator := &Authenticator{
+		enabled:    configuration.Enabled,
+		publicRead: configuration.PublicRead,
+	}
+
+	for _, declaration := range configuration.APIKeys {
+		parts := strings.Split(declaration, ":")
+
+		if len(parts) != 3 {
+			continue
+		}
+
+		authenticator.keys = append(authenticator.keys, apiKey{
+			key: parts[0],
+			principal: Principal{
+				Subject: parts[1],
+				Scopes:  parseScopes(strings.Split(parts[2], "|")),
+			},
+		})
+	}
+
+	if configuration.JWTSecret != "" || configuration.JWKSURL != "" {
+		authenticator.verifier = newVerifier(configuration, &http.Client{Timeout: timeout})
+	}
+
+	return authenticator
+}
+
+// Determine whether authentication is enabled.
+//
+// Return: true if enabled, false if not.
+func (authenticator *Authenticator) Enabled() bool {
+	return authenticator.enabled
+}
+
+// Determine whether read routes are public, which is always the case when authentication is disabled.
+//
+// Return: true if public, false if not.
+func (authenticator *Authenticator) PublicRead() bool {
+	return !authenticator.enabled || authenticator.publicRead
+}
+
+// Authenticate a request with an API key or JWT bearer token; when authentication is disabled, every request is
+// authenticated as the default principal with the admin scope.
+//
+// Return: principal and nil with success, zero principal and error without.
+func (authenticator *Authenticator) Authenticate(request *http.Request) (Principal, error) {
+	if !authenticator.enabled {
+		return Principal{Subject: DefaultSubject, Scopes: []Scope{ScopeAdmin}}, nil
+	}
+
+	if key := request.Header.Get("X-API-Key"); key != "" {
+		for _, candidate := range authenticator.keys {
+			if subtle.ConstantTimeCompare([]byte(candidate.key), []byte(key)) == 1 {
+				return candidate.principal, nil
+			}
+		}
+
+		return Principal{}, ErrInvalidCredentials
+	}
+
+	header := request.Header.Get("Authorization")
+
+	if header == "" {
+		return Principal{}, ErrMissingCredentials
+	}
+
+	token, found := strings.CutPrefix(header, "Bearer ")
+
+	if !found || authenticator.verifier == nil {
+		return Principal{}, ErrInvalidCredentials
+	}
+
+	return authenticator.verifier.verify(strings.TrimSpace(token))
+}
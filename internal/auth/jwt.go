@@ -0,0 +1,292 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+// The minimum interval between JWKS requests triggered by an unknown key identifier.
+const jwksMinimumRefresh = time.Minute
+
+type tokenHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+type tokenClaims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+	Scope     string   `json:"scope"`
+	Scp       []string `json:"scp"`
+}
+
+// A JWT 'aud' claim, which may be a single string or an array of strings.
+type audience []string
+
+func (aud *audience) UnmarshalJSON(data []byte) error {
+	var single string
+
+	if err := json.Unmarshal(data, &single); err == nil {
+		*aud = audience{single}
+
+		return nil
+	}
+
+	var multiple []string
+
+	err := json.Unmarshal(data, &multiple)
+
+	*aud = multiple
+
+	return err
+}
+
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// A JWT verifier for HS256 tokens signed with a shared secret and RS256 tokens signed with a key published at a
+// JWKS endpoint, which is cached and refreshed periodically or when an unknown key identifier is seen.
+type verifier struct {
+	secret       []byte
+	jwksURL      string
+	refresh      time.Duration
+	issuer       string
+	audience     string
+	defaultScope []Scope
+	client       *http.Client
+
+	mutex     sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+	now       func() time.Time
+}
+
+func newVerifier(configuration config.AuthConfig, client *http.Client) *verifier {
+	return &verifier{
+		secret:       []byte(configuration.JWTSecret),
+		jwksURL:      configuration.JWKSURL,
+		refresh:      configuration.JWKSRefresh,
+		issuer:       configuration.JWTIssuer,
+		audience:     configuration.JWTAudience,
+		defaultScope: parseScopes(configuration.DefaultScope),
+		client:       client,
+		now:          time.Now,
+	}
+}
+
+// Verify a compact JWT signature and its registered claims, and map it to a principal with scopes from the 'scope'
+// or 'scp' claim, or the configured default scope.
+//
+// Return: principal and nil with success, zero principal and error without.
+func (verifier *verifier) verify(token string) (Principal, error) {
+	segments := strings.Split(token, ".")
+
+	if len(segments) != 3 {
+		return Principal{}, ErrInvalidCredentials
+	}
+
+	var header tokenHeader
+
+	err := decodeSegment(segments[0], &header)
+
+	if err != nil {
+		return Principal{}, ErrInvalidCredentials
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(segments[2])
+
+	if err != nil {
+		return Principal{}, ErrInvalidCredentials
+	}
+
+	signed := []byte(segments[0] + "." + segments[1])
+
+	switch header.Algorithm {
+
+	case "HS256":
+		if len(verifier.secret) == 0 {
+			return Principal{}, ErrInvalidCredentials
+		}
+
+		mac := hmac.New(sha256.New, verifier.secret)
+		mac.Write(signed)
+
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return Principal{}, ErrInvalidCredentials
+		}
+
+	case "RS256":
+		key, err := verifier.key(header.KeyID)
+
+		if err != nil {
+			return Principal{}, err
+		}
+
+		digest := sha256.Sum256(signed)
+
+		if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) != nil {
+			return Principal{}, ErrInvalidCredentials
+		}
+
+	default:
+		return Principal{}, ErrInvalidCredentials
+
+	}
+
+	var claims tokenClaims
+
+	err = decodeSegment(segments[1], &claims)
+
+	if err != nil {
+		return Principal{}, ErrInvalidCredentials
+	}
+
+	return verifier.principal(claims)
+}
+
+func (verifier *verifier) principal(claims tokenClaims) (Principal, error) {
+	now := verifier.now().Unix()
+
+	if claims.Subject == "" || claims.ExpiresAt == 0 || now >= claims.ExpiresAt || now < claims.NotBefore {
+		return Principal{}, ErrInvalidCredentials
+	}
+
+	if verifier.issuer != "" && claims.Issuer != verifier.issuer {
+		return Principal{}, ErrInvalidCredentials
+	}
+
+	if verifier.audience != "" && !slices.Contains(claims.Audience, verifier.audience) {
+		return Principal{}, ErrInvalidCredentials
+	}
+
+	scopes := parseScopes(strings.Fields(claims.Scope))
+
+	if len(scopes) == 0 {
+		scopes = parseScopes(claims.Scp)
+	}
+
+	if len(scopes) == 0 {
+		scopes = verifier.defaultScope
+	}
+
+	return Principal{Subject: claims.Subject, Scopes: scopes}, nil
+}
+
+// Retrieve an RS256 public key by identifier from the cached JWKS, refreshing the cache when stale or when the
+// identifier is unknown.
+func (verifier *verifier) key(id string) (*rsa.PublicKey, error) {
+	if verifier.jwksURL == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	verifier.mutex.Lock()
+	defer verifier.mutex.Unlock()
+
+	age := verifier.now().Sub(verifier.fetchedAt)
+
+	if key, exists := verifier.keys[id]; exists && age < verifier.refresh {
+		return key, nil
+	}
+
+	if verifier.keys == nil || age >= jwksMinimumRefresh {
+		err := verifier.fetch()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	key, exists := verifier.keys[id]
+
+	if !exists {
+		return nil, ErrInvalidCredentials
+	}
+
+	return key, nil
+}
+
+func (verifier *verifier) fetch() error {
+	response, err := verifier.client.Get(verifier.jwksURL)
+
+	if err != nil {
+		return fmt.Errorf("unable to fetch JWKS: %w", err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unable to fetch JWKS: unexpected status '%d'", response.StatusCode)
+	}
+
+	var set jsonWebKeySet
+
+	err = json.NewDecoder(response.Body).Decode(&set)
+
+	if err != nil {
+		return fmt.Errorf("unable to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+
+	for _, jwk := range set.Keys {
+		if jwk.KeyType != "RSA" {
+			continue
+		}
+
+		n, errN := base64.RawURLEncoding.DecodeString(jwk.N)
+		e, errE := base64.RawURLEncoding.DecodeString(jwk.E)
+
+		if errN != nil || errE != nil {
+			continue
+		}
+
+		keys[jwk.KeyID] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	verifier.keys = keys
+	verifier.fetchedAt = verifier.now()
+
+	return nil
+}
+
+func decodeSegment(segment string, target any) error {
+	content, err := base64.RawURLEncoding.DecodeString(segment)
+
+	if err != nil {
+		return err
+	}
+
+	if !json.Valid(content) {
+		return errors.New("invalid JSON segment")
+	}
+
+	return json.Unmarshal(content, target)
+}
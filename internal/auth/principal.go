@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"slices"
+	"strings"
+)
+
+// An authorization scope granted to a principal, where admin implies every other scope.
+type Scope string
+
+const (
+	ScopeRead  Scope = "read"
+	ScopeWrite Scope = "write"
+	ScopeAdmin Scope = "admin"
+)
+
+// The subject used when authentication is disabled, which is granted every scope.
+const DefaultSubject string = "default"
+
+// An authenticated caller, identified by an API key subject or JWT 'sub' claim.
+type Principal struct {
+	Subject string  `json:"subject"`
+	Scopes  []Scope `json:"scopes"`
+}
+
+// Determine whether the principal has been granted a scope, either directly or through the admin scope.
+//
+// Return: true if granted, false if not.
+func (principal Principal) Has(scope Scope) bool {
+	return slices.Contains(principal.Scopes, scope) || slices.Contains(principal.Scopes, ScopeAdmin)
+}
+
+func parseScopes(values []string) []Scope {
+	var scopes []Scope
+
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			scopes = append(scopes, Scope(value))
+		}
+	}
+
+	return scopes
+}
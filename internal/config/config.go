@@ -0,0 +1,244 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+const redactedValue = "********"
+
+// The complete Grace Material API configuration, which is loaded once at startup and injected where needed.
+//
+// Each property declares its configuration file key ('key'), environment variable ('env'), default value
+// ('default'), and whether the value is a secret that must be redacted when dumped ('secret').
+type Config struct {
+	Server   ServerConfig
+	CORS     CORSConfig
+	Security SecurityConfig
+	Auth     AuthConfig
+	Database DatabaseConfig
+	Provider ProviderConfig
+	Feature  FeatureConfig
+}
+
+type ServerConfig struct {
+	Address      string        `key:"server.address" env:"SERVER_ADDRESS" default:":8080"`
+	ReadTimeout  time.Duration `key:"server.read_timeout" env:"SERVER_READ_TIMEOUT" default:"15s"`
+	WriteTimeout time.Duration `key:"server.write_timeout" env:"SERVER_WRITE_TIMEOUT" default:"30s"`
+	IdleTimeout  time.Duration `key:"server.idle_timeout" env:"SERVER_IDLE_TIMEOUT" default:"60s"`
+}
+
+// Cross-origin resource sharing policy, where an allowed origin of "*" allows every origin.
+type CORSConfig struct {
+	AllowedOrigins   []string      `key:"cors.allowed_origins" env:"CORS_ALLOWED_ORIGINS" default:"*"`
+	AllowedMethods   []string      `key:"cors.allowed_methods" env:"CORS_ALLOWED_METHODS" default:"GET,POST,PUT,PATCH,DELETE,HEAD,OPTIONS"`
+	AllowedHeaders   []string      `key:"cors.allowed_headers" env:"CORS_ALLOWED_HEADERS" default:"Origin,Content-Length,Content-Type,Authorization,X-API-Key"`
+	AllowCredentials bool          `key:"cors.allow_credentials" env:"CORS_ALLOW_CREDENTIALS" default:"false"`
+	MaxAge           time.Duration `key:"cors.max_age" env:"CORS_MAX_AGE" default:"12h"`
+}
+
+// Security headers and request limits applied to every route.
+type SecurityConfig struct {
+	MaxBodySize int  `key:"security.max_body_size" env:"SECURITY_MAX_BODY_SIZE" default:"1048576"`
+	HSTS        bool `key:"security.hsts" env:"SECURITY_HSTS" default:"false"`
+}
+
+// Authentication of API requests with static API keys and JWT bearer tokens, where each API key is declared as
+// 'key:subject:scope|scope' (e.g., 'abc123:grace-web:read|write') and JWTs are verified with a shared HS256 secret
+// or RS256 keys from a JWKS endpoint.
+type AuthConfig struct {
+	Enabled      bool          `key:"auth.enabled" env:"AUTH_ENABLED" default:"false"`
+	PublicRead   bool          `key:"auth.public_read" env:"AUTH_PUBLIC_READ" default:"true"`
+	APIKeys      []string      `key:"auth.api_keys" env:"AUTH_API_KEYS" secret:"true"`
+	JWTSecret    string        `key:"auth.jwt.secret" env:"AUTH_JWT_SECRET" secret:"true"`
+	JWKSURL      string        `key:"auth.jwt.jwks_url" env:"AUTH_JWKS_URL"`
+	JWKSRefresh  time.Duration `key:"auth.jwt.jwks_refresh" env:"AUTH_JWKS_REFRESH" default:"1h"`
+	JWTIssuer    string        `key:"auth.jwt.issuer" env:"AUTH_JWT_ISSUER"`
+	JWTAudience  string        `key:"auth.jwt.audience" env:"AUTH_JWT_AUDIENCE"`
+	DefaultScope []string      `key:"auth.jwt.default_scope" env:"AUTH_JWT_DEFAULT_SCOPE" default:"read"`
+}
+
+type DatabaseConfig struct {
+	URL string `key:"database.url" env:"DATABASE_URL" secret:"true"`
+}
+
+type ProviderConfig struct {
+	Timeout     time.Duration `key:"provider.timeout" env:"PROVIDER_TIMEOUT" default:"10s"`
+	OpenLibrary OpenLibraryConfig
+	TMDB        TMDBConfig
+	IGDB        IGDBConfig
+	MusicBrainz MusicBrainzConfig
+	BGG         BGGConfig
+	ComicVine   ComicVineConfig
+	Podcast     PodcastConfig
+}
+
+type OpenLibraryConfig struct {
+	Base string `key:"provider.openlibrary.base" env:"OPENLIBRARY_BASE" default:"https://openlibrary.org"`
+}
+
+type TMDBConfig struct {
+	Base   string `key:"provider.tmdb.base" env:"TMDB_BASE" default:"https://api.themoviedb.org/3"`
+	APIKey string `key:"provider.tmdb.api_key" env:"TMDB_API_KEY" secret:"true"`
+}
+
+type IGDBConfig struct {
+	Host   string `key:"provider.igdb.host" env:"AWS_PROXY_HOST"`
+	APIKey string `key:"provider.igdb.api_key" env:"AWS_PROXY_API_KEY" secret:"true"`
+}
+
+// MusicBrainz configuration, where a descriptive user agent with contact information is required by the MusicBrainz
+// rate limiting policy and cover art is served by the Cover Art Archive.
+type MusicBrainzConfig struct {
+	Base         string `key:"provider.musicbrainz.base" env:"MUSICBRAINZ_BASE" default:"https://musicbrainz.org/ws/2"`
+	UserAgent    string `key:"provider.musicbrainz.user_agent" env:"MUSICBRAINZ_USER_AGENT" default:"grace-material-api/1.0 ( https://github.com/muzzarellimj/grace-material-api )"`
+	CoverArtBase string `key:"provider.musicbrainz.cover_art_base" env:"COVER_ART_ARCHIVE_BASE" default:"https://coverartarchive.org"`
+}
+
+// BoardGameGeek configuration, where a registered application token is sent as a bearer token when provided.
+type BGGConfig struct {
+	Base  string `key:"provider.bgg.base" env:"BGG_BASE" default:"https://boardgamegeek.com/xmlapi2"`
+	Token string `key:"provider.bgg.token" env:"BGG_API_TOKEN" secret:"true"`
+}
+
+// Comic Vine configuration, where an API key is required by every request.
+type ComicVineConfig struct {
+	Base   string `key:"provider.comicvine.base" env:"COMICVINE_BASE" default:"https://comicvine.gamespot.com/api"`
+	APIKey string `key:"provider.comicvine.api_key" env:"COMICVINE_API_KEY" secret:"true"`
+}
+
+// Podcast feed configuration, where feeds are fetched directly from their publishers with an identifying user agent
+// and stored podcasts are refreshed for new episodes at the refresh interval (or never, with a zero interval).
+type PodcastConfig struct {
+	UserAgent string        `key:"provider.podcast.user_agent" env:"PODCAST_USER_AGENT" default:"grace-material-api/1.0 ( https://github.com/muzzarellimj/grace-material-api )"`
+	Refresh   time.Duration `key:"provider.podcast.refresh" env:"PODCAST_REFRESH" default:"6h"`
+}
+
+// Feature toggles, which enable or disable whole material types.
+type FeatureConfig struct {
+	Books      bool `key:"feature.books" env:"FEATURE_BOOKS" default:"true"`
+	Games      bool `key:"feature.games" env:"FEATURE_GAMES" default:"true"`
+	Movies     bool `key:"feature.movies" env:"FEATURE_MOVIES" default:"true"`
+	Shows      bool `key:"feature.shows" env:"FEATURE_SHOWS" default:"true"`
+	Albums     bool `key:"feature.albums" env:"FEATURE_ALBUMS" default:"true"`
+	BoardGames bool `key:"feature.boardgames" env:"FEATURE_BOARDGAMES" default:"true"`
+	Comics     bool `key:"feature.comics" env:"FEATURE_COMICS" default:"false"`
+	Podcasts   bool `key:"feature.podcasts" env:"FEATURE_PODCASTS" default:"true"`
+}
+
+// Load configuration from defaults, an optional YAML or TOML configuration file, an optional .env file, and the
+// environment, where each source takes precedence over the source before it.
+//
+// Return: loaded and validated configuration and nil with success, zero configuration and error without.
+func Load(path string, dotenvPath string) (Config, error) {
+	var config Config
+
+	values, err := readFile(path)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to read configuration file '%s': %v\n", path, err)
+
+		return Config{}, err
+	}
+
+	dotenv, err := readDotenv(dotenvPath)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to load .env configuration file; this relies on explicitly declared configuration values and may cause issues outside deployed environments: %v\n", err)
+	}
+
+	err = walk(&config, func(field reflect.Value, tag reflect.StructTag) error {
+		value := tag.Get("default")
+
+		if fileValue, exists := values[tag.Get("key")]; exists {
+			value = fileValue
+		}
+
+		if dotenvValue, exists := dotenv[tag.Get("env")]; exists {
+			value = dotenvValue
+		}
+
+		if envValue, exists := os.LookupEnv(tag.Get("env")); exists {
+			value = envValue
+		}
+
+		return assign(field, value)
+	})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to assign configuration values: %v\n", err)
+
+		return Config{}, err
+	}
+
+	err = config.Validate()
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to validate configuration: %v\n", err)
+
+		return Config{}, err
+	}
+
+	return config, nil
+}
+
+// Dump the configuration as sorted 'key=value' lines, with secret values redacted.
+//
+// Return: configuration dump with secrets replaced by a fixed mask.
+func (config Config) String() string {
+	var lines []string
+
+	_ = walk(&config, func(field reflect.Value, tag reflect.StructTag) error {
+		value := format(field)
+
+		if tag.Get("secret") == "true" && value != "" {
+			value = redactedValue
+		}
+
+		lines = append(lines, fmt.Sprintf("%s=%s", tag.Get("key"), value))
+
+		return nil
+	})
+
+	sort.Strings(lines)
+
+	return strings.Join(lines, "\n")
+}
+
+// Visit every tagged configuration property, depth-first, with a settable field value and its struct tag.
+func walk(config *Config, visit func(field reflect.Value, tag reflect.StructTag) error) error {
+	return walkValue(reflect.ValueOf(config).Elem(), visit)
+}
+
+func walkValue(value reflect.Value, visit func(field reflect.Value, tag reflect.StructTag) error) error {
+	for index := 0; index < value.NumField(); index++ {
+		field := value.Field(index)
+		structField := value.Type().Field(index)
+
+		if structField.Type.Kind() == reflect.Struct && structField.Type != reflect.TypeOf(time.Duration(0)) {
+			err := walkValue(field, visit)
+
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if structField.Tag.Get("key") == "" {
+			continue
+		}
+
+		err := visit(field, structField.Tag)
+
+		if err != nil {
+			return fmt.Errorf("'%s': %w", structField.Tag.Get("key"), err)
+		}
+	}
+
+	return nil
+}
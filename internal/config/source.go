@@ -0,0 +1,161 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Read a YAML (.yaml, .yml) or TOML (.toml) configuration file and flatten it to dot-delimited keys; e.g.,
+// 'server: { address: ":8080" }' becomes "server.address" => ":8080".
+//
+// Return: flattened key-value map and nil with success or with an empty path, nil and error without.
+func readFile(path string) (map[string]string, error) {
+	values := make(map[string]string)
+
+	if path == "" {
+		return values, nil
+	}
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	document := make(map[string]any)
+
+	switch extension := strings.ToLower(filepath.Ext(path)); extension {
+
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &document)
+
+	case ".toml":
+		err = toml.Unmarshal(content, &document)
+
+	default:
+		err = fmt.Errorf("unsupported configuration file extension '%s'", extension)
+
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	flatten("", document, values)
+
+	return values, nil
+}
+
+// Read a .env file without modifying the process environment, so the environment keeps precedence over it.
+//
+// Return: key-value map and nil with success, empty map and error without.
+func readDotenv(path string) (map[string]string, error) {
+	if path == "" {
+		return map[string]string{}, nil
+	}
+
+	values, err := godotenv.Read(path)
+
+	if err != nil {
+		return map[string]string{}, err
+	}
+
+	return values, nil
+}
+
+func flatten(prefix string, document map[string]any, values map[string]string) {
+	for key, value := range document {
+		if prefix != "" {
+			key = fmt.Sprint(prefix, ".", key)
+		}
+
+		switch t := value.(type) {
+		case map[string]any:
+			flatten(key, t, values)
+		case []any:
+			var items []string
+
+			for _, item := range t {
+				items = append(items, fmt.Sprint(item))
+			}
+
+			values[key] = strings.Join(items, ",")
+		default:
+			values[key] = fmt.Sprint(t)
+		}
+	}
+}
+
+// Assign a raw string value to a supported configuration field: string, comma-delimited []string, bool, int, or
+// time.Duration.
+func assign(field reflect.Value, value string) error {
+	switch field.Interface().(type) {
+	case string:
+		field.SetString(value)
+	case []string:
+		var items []string
+
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+
+		field.Set(reflect.ValueOf(items))
+	case bool:
+		parsed, err := strconv.ParseBool(valueOrDefault(value, "false"))
+
+		if err != nil {
+			return err
+		}
+
+		field.SetBool(parsed)
+	case int:
+		parsed, err := strconv.Atoi(valueOrDefault(value, "0"))
+
+		if err != nil {
+			return err
+		}
+
+		field.SetInt(int64(parsed))
+	case time.Duration:
+		parsed, err := time.ParseDuration(valueOrDefault(value, "0s"))
+
+		if err != nil {
+			return err
+		}
+
+		field.SetInt(int64(parsed))
+	default:
+		return errors.New("unsupported configuration field type")
+	}
+
+	return nil
+}
+
+func format(field reflect.Value) string {
+	switch t := field.Interface().(type) {
+	case []string:
+		return strings.Join(t, ",")
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
+func valueOrDefault(value string, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
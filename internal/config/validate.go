@@ -0,0 +1,74 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Validate required configuration keys, which may depend on the enabled features (e.g., a TMDB API key is only
+// required when movies or shows are enabled).
+//
+// Return: nil with valid configuration, joined error describing every invalid key without.
+func (config Config) Validate() error {
+	var errs []error
+
+	if config.Server.Address == "" {
+		errs = append(errs, missing("server.address", "SERVER_ADDRESS"))
+	}
+
+	if config.Database.URL == "" {
+		errs = append(errs, missing("database.url", "DATABASE_URL"))
+	}
+
+	if config.Server.ReadTimeout <= 0 || config.Server.WriteTimeout <= 0 || config.Server.IdleTimeout <= 0 || config.Provider.Timeout <= 0 {
+		errs = append(errs, errors.New("server and provider timeouts must be positive durations"))
+	}
+
+	if config.CORS.AllowCredentials && slices.Contains(config.CORS.AllowedOrigins, "*") {
+		errs = append(errs, errors.New("cors credentials cannot be allowed with a wildcard '*' origin"))
+	}
+
+	if config.Security.MaxBodySize <= 0 {
+		errs = append(errs, errors.New("security maximum body size must be a positive number of bytes"))
+	}
+
+	if config.Auth.Enabled && len(config.Auth.APIKeys) == 0 && config.Auth.JWTSecret == "" && config.Auth.JWKSURL == "" {
+		errs = append(errs, errors.New("auth requires at least one API key, JWT secret, or JWKS URL when enabled"))
+	}
+
+	for _, key := range config.Auth.APIKeys {
+		if len(strings.Split(key, ":")) != 3 {
+			errs = append(errs, errors.New("auth API keys must be declared as 'key:subject:scope|scope'"))
+
+			break
+		}
+	}
+
+	if (config.Feature.Movies || config.Feature.Shows) && config.Provider.TMDB.APIKey == "" {
+		errs = append(errs, missing("provider.tmdb.api_key", "TMDB_API_KEY"))
+	}
+
+	if config.Feature.Games && config.Provider.IGDB.Host == "" {
+		errs = append(errs, missing("provider.igdb.host", "AWS_PROXY_HOST"))
+	}
+
+	if config.Feature.Games && config.Provider.IGDB.APIKey == "" {
+		errs = append(errs, missing("provider.igdb.api_key", "AWS_PROXY_API_KEY"))
+	}
+
+	if config.Provider.Podcast.Refresh < 0 {
+		errs = append(errs, errors.New("podcast refresh interval must be zero (disabled) or a positive duration"))
+	}
+
+	if config.Feature.Comics && config.Provider.ComicVine.APIKey == "" {
+		errs = append(errs, missing("provider.comicvine.api_key", "COMICVINE_API_KEY"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func missing(key string, env string) error {
+	return fmt.Errorf("missing required configuration key '%s' (environment variable '%s')", key, env)
+}
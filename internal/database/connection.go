@@ -2,26 +2,27 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-var Connection PgxPool
-
 // A wrapper to mask pgxpool.Pool as a local interface.
 type PgxPool interface {
 	Begin(context context.Context) (pgx.Tx, error)
 	Close()
+	Exec(context context.Context, sql string, args ...any) (pgconn.CommandTag, error)
 	Query(context context.Context, swl string, args ...any) (pgx.Rows, error)
 }
 
-// Connect the Grace database pool and persist the connections as accessible variables.
+// Connect the Grace database pool, which is injected into each repository by the application container.
 //
-// Return: error with error occurrence, nil without.
-func Connect(url string) error {
+// Return: connected pool and nil with success, nil and error without.
+func Connect(url string) (PgxPool, error) {
 	fmt.Fprint(os.Stdout, "Connect to Grace database pool...\n")
 
 	connection, err := pgxpool.New(context.Background(), url)
@@ -29,21 +30,16 @@ func Connect(url string) error {
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to connect to Grace database pool: %v\n", err)
 
-		return err
+		return nil, err
 	}
 
 	if connection == nil {
+		err := errors.New("unable to persist connection")
+
 		fmt.Fprint(os.Stderr, "Unable to persist connection to Grace database pool.")
 
-		return err
+		return nil, err
 	}
 
-	Connection = connection
-
-	return nil
-}
-
-// Close the Grace database pool connection.
-func Disconnect() {
-	Connection.Close()
+	return connection, nil
 }
@@ -4,17 +4,24 @@ package database
 const (
 	TableBookFragments              = "books"
 	TableBookAuthorFragments        = "authors"
+	TableBookNarratorFragments      = "narrators"
 	TableBookPublisherFragments     = "publishers"
 	TableBookTopicFragments         = "topics"
+	TableBookSeriesFragments        = "series"
+	TableBookWorkFragments          = "works"
 	TableBookAuthorRelationships    = "books_authors"
+	TableBookNarratorRelationships  = "books_narrators"
 	TableBookPublisherRelationships = "books_publishers"
-	TableBookTopicRelationships     = "books_topics"
+	TableBookTopicRelationships     = "books_topics" // a view of work topics per edition, which may only be read
+	TableBookSeriesRelationships    = "books_series"
+	TableBookWorkTopicRelationships = "works_topics"
 
 	TableGameFragments              = "games"
 	TableGameFranchiseFragments     = "franchises"
 	TableGameGenreFragments         = "ggenres"
 	TableGamePlatformFragments      = "platforms"
 	TableGameStudioFragments        = "studios"
+	TableGameReleaseFragments       = "greleases"
 	TableGameFranchiseRelationships = "games_franchises"
 	TableGameGenreRelationships     = "games_genres"
 	TableGamePlatformRelationships  = "games_platforms"
@@ -23,19 +30,114 @@ const (
 	TableMovieFragments                      = "movies"
 	TableMovieGenreFragments                 = "mgenres"
 	TableMovieProductionCompanyFragments     = "production_companies"
+	TableMoviePersonFragments                = "people"
 	TableMovieGenreRelationships             = "movies_genres"
 	TableMovieProductionCompanyRelationships = "movies_production_companies"
+	TableMoviePersonRelationships            = "movies_people"
+
+	TableShowFragments            = "shows"
+	TableShowSeasonFragments      = "seasons"
+	TableShowEpisodeFragments     = "episodes"
+	TableShowGenreFragments       = "sgenres"
+	TableShowNetworkFragments     = "networks"
+	TableShowGenreRelationships   = "shows_genres"
+	TableShowNetworkRelationships = "shows_networks"
+
+	TableAlbumFragments           = "albums"
+	TableAlbumArtistFragments     = "artists"
+	TableAlbumLabelFragments      = "labels"
+	TableAlbumGenreFragments      = "agenres"
+	TableAlbumTrackFragments      = "tracks"
+	TableAlbumArtistRelationships = "albums_artists"
+	TableAlbumLabelRelationships  = "albums_labels"
+	TableAlbumGenreRelationships  = "albums_genres"
+
+	TableBoardGameFragments              = "boardgames"
+	TableBoardGameDesignerFragments      = "designers"
+	TableBoardGamePublisherFragments     = "bpublishers"
+	TableBoardGameMechanicFragments      = "mechanics"
+	TableBoardGameCategoryFragments      = "bcategories"
+	TableBoardGameDesignerRelationships  = "boardgames_designers"
+	TableBoardGamePublisherRelationships = "boardgames_publishers"
+	TableBoardGameMechanicRelationships  = "boardgames_mechanics"
+	TableBoardGameCategoryRelationships  = "boardgames_categories"
+
+	TableComicFragments              = "comics"
+	TableComicVolumeFragments        = "volumes"
+	TableComicIssueFragments         = "issues"
+	TableComicCreatorFragments       = "creators"
+	TableComicPublisherFragments     = "cpublishers"
+	TableComicCreatorRelationships   = "comics_creators"
+	TableComicPublisherRelationships = "comics_publishers"
+
+	TablePodcastFragments             = "podcasts"
+	TablePodcastEpisodeFragments      = "pepisodes"
+	TablePodcastCategoryFragments     = "pcategories"
+	TablePodcastCategoryRelationships = "podcasts_categories"
+
+	TableUserFragments                    = "users"
+	TableCollectionFragments              = "collections"
+	TableCollectionBookRelationships      = "collections_books"
+	TableCollectionGameRelationships      = "collections_games"
+	TableCollectionMovieRelationships     = "collections_movies"
+	TableCollectionShowRelationships      = "collections_shows"
+	TableCollectionAlbumRelationships     = "collections_albums"
+	TableCollectionBoardGameRelationships = "collections_boardgames"
+	TableCollectionComicRelationships     = "collections_comics"
+	TableCollectionPodcastRelationships   = "collections_podcasts"
+
+	TableCollectionIssueRelationships = "collections_issues"
+
+	TableBookProgressFragments      = "books_progress"
+	TableGameProgressFragments      = "games_progress"
+	TableMovieProgressFragments     = "movies_progress"
+	TableShowProgressFragments      = "shows_progress"
+	TableAlbumProgressFragments     = "albums_progress"
+	TableBoardGameProgressFragments = "boardgames_progress"
+	TableComicProgressFragments     = "comics_progress"
+	TablePodcastProgressFragments   = "podcasts_progress"
+
+	TableEpisodeProgressFragments = "episodes_progress"
+	TableIssueProgressFragments   = "issues_progress"
+
+	TableBookReviewFragments      = "books_reviews"
+	TableGameReviewFragments      = "games_reviews"
+	TableMovieReviewFragments     = "movies_reviews"
+	TableShowReviewFragments      = "shows_reviews"
+	TableAlbumReviewFragments     = "albums_reviews"
+	TableBoardGameReviewFragments = "boardgames_reviews"
+	TableComicReviewFragments     = "comics_reviews"
+	TablePodcastReviewFragments   = "podcasts_reviews"
+
+	TableTagFragments              = "tags"
+	TableBookTagRelationships      = "books_tags"
+	TableGameTagRelationships      = "games_tags"
+	TableMovieTagRelationships     = "movies_tags"
+	TableShowTagRelationships      = "shows_tags"
+	TableAlbumTagRelationships     = "albums_tags"
+	TableBoardGameTagRelationships = "boardgames_tags"
+	TableComicTagRelationships     = "comics_tags"
+	TablePodcastTagRelationships   = "podcasts_tags"
+
+	TableListFragments     = "lists"
+	TableListItemFragments = "lists_items"
 )
 
 // Properties (or columns names) per database table.
 var (
-	PropertiesBookFragments              = []string{"title", "subtitle", "description", "publish_date", "pages", "isbn10", "isbn13", "image", "edition_reference", "work_reference"}
-	PropertiesBookAuthorFragments        = []string{"first_name", "middle_name", "last_name", "biography", "image", "reference"}
+	PropertiesBookFragments              = []string{"title", "subtitle", "publish_date", "pages", "format", "duration", "languages", "original_language", "translation_of", "isbn10", "isbn13", "image", "edition_reference", "work_reference"}
+	PropertiesBookAuthorFragments        = []string{"first_name", "middle_name", "last_name", "display_name", "sort_name", "alternate_names", "biography", "image", "reference"}
+	PropertiesBookNarratorFragments      = []string{"name", "sort_name"}
 	PropertiesBookPublisherFragments     = []string{"name"}
 	PropertiesBookTopicFragments         = []string{"name"}
+	PropertiesBookSeriesFragments        = []string{"name"}
+	PropertiesBookWorkFragments          = []string{"title", "description", "reference"}
 	PropertiesBookAuthorRelationships    = []string{"book", "author"}
+	PropertiesBookNarratorRelationships  = []string{"book", "narrator"}
 	PropertiesBookPublisherRelationships = []string{"book", "publisher"}
 	PropertiesBookTopicRelationships     = []string{"book", "topic"}
+	PropertiesBookSeriesRelationships    = []string{"book", "series", "position"}
+	PropertiesBookWorkTopicRelationships = []string{"work", "topic"}
 
 	PropertiesGameFragments              = []string{"title", "summary", "storyline", "release_date", "image", "reference"}
 	PropertiesGameFranchiseFragments     = []string{"name", "reference"}
@@ -46,10 +148,101 @@ var (
 	PropertiesGameGenreRelationships     = []string{"game", "genre"}
 	PropertiesGamePlatformRelationships  = []string{"game", "platform"}
 	PropertiesGameStudioRelationships    = []string{"game", "studio"}
+	PropertiesGameReleaseFragments       = []string{"game", "platform", "region", "release_date"}
 
 	PropertiesMovieFragments                      = []string{"title", "tagline", "description", "release_date", "runtime", "image", "reference"}
 	PropertiesMovieGenreFragments                 = []string{"name", "reference"}
 	PropertiesMovieProductionCompanyFragments     = []string{"name", "image", "reference"}
+	PropertiesMoviePersonFragments                = []string{"name", "image", "department", "reference"}
 	PropertiesMovieGenreRelationships             = []string{"movie", "genre"}
 	PropertiesMovieProductionCompanyRelationships = []string{"movie", "production_company"}
+	PropertiesMoviePersonRelationships            = []string{"movie", "person", "role", "job", "character", "billing"}
+
+	PropertiesShowFragments            = []string{"title", "tagline", "description", "first_air_date", "last_air_date", "status", "seasons", "episodes", "runtime", "image", "reference"}
+	PropertiesShowSeasonFragments      = []string{"show", "number", "title", "description", "air_date", "image", "reference"}
+	PropertiesShowEpisodeFragments     = []string{"show", "season", "number", "title", "description", "air_date", "runtime", "image", "reference"}
+	PropertiesShowGenreFragments       = []string{"name", "reference"}
+	PropertiesShowNetworkFragments     = []string{"name", "image", "reference"}
+	PropertiesShowGenreRelationships   = []string{"show", "genre"}
+	PropertiesShowNetworkRelationships = []string{"show", "network"}
+
+	PropertiesAlbumFragments           = []string{"title", "artist_credit", "release_date", "country", "format", "discs", "tracks", "runtime", "barcode", "image", "reference", "group_reference"}
+	PropertiesAlbumArtistFragments     = []string{"name", "sort_name", "reference"}
+	PropertiesAlbumLabelFragments      = []string{"name", "reference"}
+	PropertiesAlbumGenreFragments      = []string{"name"}
+	PropertiesAlbumTrackFragments      = []string{"album", "disc", "position", "number", "title", "length", "reference"}
+	PropertiesAlbumArtistRelationships = []string{"album", "artist", "position"}
+	PropertiesAlbumLabelRelationships  = []string{"album", "label", "catalog_number"}
+	PropertiesAlbumGenreRelationships  = []string{"album", "genre"}
+
+	PropertiesBoardGameFragments              = []string{"title", "description", "release_date", "min_players", "max_players", "playing_time", "min_play_time", "max_play_time", "min_age", "image", "reference"}
+	PropertiesBoardGameDesignerFragments      = []string{"name", "reference"}
+	PropertiesBoardGamePublisherFragments     = []string{"name", "reference"}
+	PropertiesBoardGameMechanicFragments      = []string{"name", "reference"}
+	PropertiesBoardGameCategoryFragments      = []string{"name", "reference"}
+	PropertiesBoardGameDesignerRelationships  = []string{"boardgame", "designer"}
+	PropertiesBoardGamePublisherRelationships = []string{"boardgame", "publisher"}
+	PropertiesBoardGameMechanicRelationships  = []string{"boardgame", "mechanic"}
+	PropertiesBoardGameCategoryRelationships  = []string{"boardgame", "category"}
+
+	PropertiesComicFragments              = []string{"title", "description", "start_date", "volumes", "issues", "image"}
+	PropertiesComicVolumeFragments        = []string{"comic", "title", "description", "start_date", "issues", "image", "reference"}
+	PropertiesComicIssueFragments         = []string{"comic", "volume", "number", "title", "cover_date", "image", "reference"}
+	PropertiesComicCreatorFragments       = []string{"name", "reference"}
+	PropertiesComicPublisherFragments     = []string{"name", "reference"}
+	PropertiesComicCreatorRelationships   = []string{"comic", "creator", "role"}
+	PropertiesComicPublisherRelationships = []string{"comic", "publisher"}
+
+	PropertiesPodcastFragments             = []string{"title", "author", "description", "language", "explicit", "first_release_date", "last_release_date", "episodes", "image", "link", "reference", "date_refreshed"}
+	PropertiesPodcastEpisodeFragments      = []string{"podcast", "title", "description", "release_date", "duration", "season", "number", "audio", "image", "reference"}
+	PropertiesPodcastCategoryFragments     = []string{"name"}
+	PropertiesPodcastCategoryRelationships = []string{"podcast", "category"}
+
+	PropertiesUserFragments                    = []string{"reference", "date_created"}
+	PropertiesCollectionFragments              = []string{"owner", "name", "date_created"}
+	PropertiesCollectionBookRelationships      = []string{"collection", "book", "date_added"}
+	PropertiesCollectionGameRelationships      = []string{"collection", "game", "date_added", "platform"}
+	PropertiesCollectionMovieRelationships     = []string{"collection", "movie", "date_added"}
+	PropertiesCollectionShowRelationships      = []string{"collection", "show", "date_added"}
+	PropertiesCollectionAlbumRelationships     = []string{"collection", "album", "date_added"}
+	PropertiesCollectionBoardGameRelationships = []string{"collection", "boardgame", "date_added"}
+	PropertiesCollectionComicRelationships     = []string{"collection", "comic", "date_added"}
+	PropertiesCollectionPodcastRelationships   = []string{"collection", "podcast", "date_added"}
+
+	PropertiesCollectionIssueRelationships = []string{"collection", "issue", "date_added"}
+	PropertiesCollectionGamePlatform       = []string{"platform"}
+
+	PropertiesBookProgressFragments      = []string{"owner", "book", "status", "progress", "date_recorded"}
+	PropertiesGameProgressFragments      = []string{"owner", "game", "status", "progress", "date_recorded"}
+	PropertiesMovieProgressFragments     = []string{"owner", "movie", "status", "progress", "date_recorded"}
+	PropertiesShowProgressFragments      = []string{"owner", "show", "status", "progress", "date_recorded"}
+	PropertiesAlbumProgressFragments     = []string{"owner", "album", "status", "progress", "date_recorded"}
+	PropertiesBoardGameProgressFragments = []string{"owner", "boardgame", "status", "progress", "date_recorded"}
+	PropertiesComicProgressFragments     = []string{"owner", "comic", "status", "progress", "date_recorded"}
+	PropertiesPodcastProgressFragments   = []string{"owner", "podcast", "status", "progress", "date_recorded"}
+
+	PropertiesEpisodeProgressFragments = []string{"owner", "episode", "date_watched"}
+	PropertiesIssueProgressFragments   = []string{"owner", "issue", "date_read"}
+
+	PropertiesBookReviewFragments      = []string{"owner", "book", "rating", "review", "date_created", "date_updated"}
+	PropertiesGameReviewFragments      = []string{"owner", "game", "rating", "review", "date_created", "date_updated"}
+	PropertiesMovieReviewFragments     = []string{"owner", "movie", "rating", "review", "date_created", "date_updated"}
+	PropertiesShowReviewFragments      = []string{"owner", "show", "rating", "review", "date_created", "date_updated"}
+	PropertiesAlbumReviewFragments     = []string{"owner", "album", "rating", "review", "date_created", "date_updated"}
+	PropertiesBoardGameReviewFragments = []string{"owner", "boardgame", "rating", "review", "date_created", "date_updated"}
+	PropertiesComicReviewFragments     = []string{"owner", "comic", "rating", "review", "date_created", "date_updated"}
+	PropertiesPodcastReviewFragments   = []string{"owner", "podcast", "rating", "review", "date_created", "date_updated"}
+
+	PropertiesTagFragments              = []string{"owner", "name"}
+	PropertiesBookTagRelationships      = []string{"book", "tag"}
+	PropertiesGameTagRelationships      = []string{"game", "tag"}
+	PropertiesMovieTagRelationships     = []string{"movie", "tag"}
+	PropertiesShowTagRelationships      = []string{"show", "tag"}
+	PropertiesAlbumTagRelationships     = []string{"album", "tag"}
+	PropertiesBoardGameTagRelationships = []string{"boardgame", "tag"}
+	PropertiesComicTagRelationships     = []string{"comic", "tag"}
+	PropertiesPodcastTagRelationships   = []string{"podcast", "tag"}
+
+	PropertiesListFragments     = []string{"owner", "name", "description", "date_created"}
+	PropertiesListItemFragments = []string{"list", "position", "type", "material"}
 )
@@ -9,8 +9,12 @@ import (
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// The PostgreSQL error code of a statement violating a unique constraint.
+const codeUniqueViolation = "23505"
+
 // Create a PostgreSQL query statement with given selection, from, where, and group statements,
 // as well as optional directives (e.g., join statements).
 //
@@ -84,3 +88,12 @@ func MapQueryResponse[M interface{}](rows pgx.Rows) ([]M, error) {
 
 	return response, nil
 }
+
+// Determine whether an error is a PostgreSQL unique constraint violation (e.g., a row stored concurrently).
+//
+// Return: true if a unique constraint violation, false if not.
+func IsUniqueViolation(err error) bool {
+	var pgError *pgconn.PgError
+
+	return errors.As(err, &pgError) && pgError.Code == codeUniqueViolation
+}
@@ -7,10 +7,14 @@ import (
 	"github.com/muzzarellimj/grace-material-api/internal/database"
 )
 
-func FetchExistenceSlice(connection database.PgxPool, table string) ([]int, error) {
+// Fetch the numeric identifier of every fragment in the provided table matching the provided constraint, which may
+// be empty to match every fragment.
+//
+// Return: numeric identifier slice and nil with success, nil and error without.
+func FetchExistenceSlice(connection database.PgxPool, table string, constraint string) ([]int, error) {
 	var zero []int
 
-	statement, err := database.CreateQuery("id", table, "", "")
+	statement, err := database.CreateQuery("id", table, constraint, "")
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to create existence slice selection statement: %v\n", err)
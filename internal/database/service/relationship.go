@@ -111,3 +111,84 @@ func StoreRelationshipSlice(connection database.PgxPool, table string, propertie
 		}
 	}
 }
+
+// Delete every relationship matching the provided constraint from the provided table.
+//
+// Return: the number of deleted relationships and nil with success, or 0 and error without.
+func DeleteRelationship(connection database.PgxPool, table string, constraint string) (int64, error) {
+	if constraint == "" {
+		err := errors.New("unable to delete relationship without 'constraint' arg")
+
+		fmt.Fprintf(os.Stderr, "Unable to delete relationship without constraint: %v\n", err)
+
+		return 0, err
+	}
+
+	statement := fmt.Sprintf("DELETE FROM %s WHERE %s", table, constraint)
+
+	tx, err := connection.Begin(context.Background())
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to begin transaction to delete relationship: %v\n", err)
+
+		return 0, err
+	}
+
+	defer func() {
+		err = tx.Rollback(context.Background())
+
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			fmt.Fprintf(os.Stderr, "Unable to rollback relationship deletion transaction: %v\n", err)
+		}
+	}()
+
+	tag, err := tx.Exec(context.Background(), statement)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to execute relationship deletion statement: %v\n", err)
+
+		return 0, err
+	}
+
+	err = tx.Commit(context.Background())
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to commit relationship deletion transaction: %v\n", err)
+
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// Update every relationship matching the provided constraint in the provided table, setting the provided properties
+// (column names) to the provided named arguments.
+//
+// Return: the number of updated relationships and nil with success, or 0 and error without.
+func UpdateRelationship(connection database.PgxPool, table string, properties []string, constraint string, arguments pgx.NamedArgs) (int64, error) {
+	if constraint == "" {
+		err := errors.New("unable to update relationship without 'constraint' arg")
+
+		fmt.Fprintf(os.Stderr, "Unable to update relationship without constraint: %v\n", err)
+
+		return 0, err
+	}
+
+	var assignments []string
+
+	for _, property := range properties {
+		assignments = append(assignments, fmt.Sprintf("%s=@%s", property, property))
+	}
+
+	statement := fmt.Sprintf("UPDATE %s SET %s WHERE %s", table, strings.Join(assignments, ","), constraint)
+
+	tag, err := connection.Exec(context.Background(), statement, arguments)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to execute relationship update statement: %v\n", err)
+
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
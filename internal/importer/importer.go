@@ -0,0 +1,422 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/isbn"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/importer"
+	movieModel "github.com/muzzarellimj/grace-material-api/internal/model/movie"
+)
+
+// Import sources, as used in import routes (e.g., '/api/import/goodreads').
+const (
+	SourceGoodreads  = "goodreads"
+	SourceLetterboxd = "letterboxd"
+	SourceSteam      = "steam"
+)
+
+// Import job and row statuses.
+const (
+	JobPending   = "pending"
+	JobRunning   = "running"
+	JobCompleted = "completed"
+
+	RowMatched   = "matched"
+	RowAmbiguous = "ambiguous"
+	RowFailed    = "failed"
+)
+
+// The maximum number of rows per import and of jobs retained in memory, beyond which the oldest completed jobs are
+// forgotten.
+const (
+	MaximumImportRows   = 5000
+	MaximumRetainedJobs = 100
+)
+
+var ErrTooManyRows = fmt.Errorf("file must contain at most %d rows", MaximumImportRows)
+
+// The book operations required by the importer, which are satisfied by a book repository.
+type BookStorage interface {
+	StoreBook(id string) (int, bool, error)
+}
+
+// The game operations required by the importer, which are satisfied by a game repository.
+type GameStorage interface {
+	ResolveSteamGame(appid string) ([]int, error)
+	StoreGame(id string) (int, bool, error)
+}
+
+// The movie operations required by the importer, which are satisfied by a movie repository.
+type MovieStorage interface {
+	SearchMovies(query string) ([]movieModel.MovieSearchResult, error)
+	StoreMovie(id string) (int, bool, error)
+}
+
+// The collection operations required by the importer, which are satisfied by a collection repository.
+type Collector interface {
+	AddCollectionItem(reference string, materialType string, id int) (bool, bool, error)
+}
+
+// An importer, which resolves rows of export files to provider identifiers, stores them through the material
+// repositories, adds them to the importing user's collection, and tracks each import as a job in memory.
+type Importer struct {
+	books     BookStorage
+	games     GameStorage
+	movies    MovieStorage
+	collector Collector
+	logger    *log.Logger
+
+	mutex sync.Mutex
+	jobs  map[int]*model.ImportJob
+	next  int
+}
+
+// Create an importer with the material repositories of enabled material types (nil when disabled), a collection
+// repository, and logger.
+//
+// Return: configured importer.
+func NewImporter(books BookStorage, games GameStorage, movies MovieStorage, collector Collector, logger *log.Logger) *Importer {
+	return &Importer{
+		books:     books,
+		games:     games,
+		movies:    movies,
+		collector: collector,
+		logger:    logger,
+		jobs:      make(map[int]*model.ImportJob),
+	}
+}
+
+// Determine whether an import source is known and its material type enabled.
+//
+// Return: true if supported, false if not.
+func (importer *Importer) Supports(source string) bool {
+	switch source {
+	case SourceGoodreads:
+		return importer.books != nil
+	case SourceLetterboxd:
+		return importer.movies != nil
+	case SourceSteam:
+		return importer.games != nil
+	default:
+		return false
+	}
+}
+
+// Parse an export file of a supported import source.
+//
+// Return: import row slice and nil with success, empty slice and ErrInvalidFile or ErrTooManyRows without.
+func (importer *Importer) Parse(source string, reader io.Reader) ([]model.ImportRow, error) {
+	var rowSlice []model.ImportRow
+	var err error
+
+	switch source {
+	case SourceGoodreads:
+		rowSlice, err = ParseGoodreads(reader)
+	case SourceLetterboxd:
+		rowSlice, err = ParseLetterboxd(reader)
+	case SourceSteam:
+		rowSlice, err = ParseSteam(reader)
+	default:
+		err = fmt.Errorf("unsupported import source '%s'", source)
+	}
+
+	if err != nil {
+		return []model.ImportRow{}, err
+	}
+
+	if len(rowSlice) > MaximumImportRows {
+		return []model.ImportRow{}, ErrTooManyRows
+	}
+
+	return rowSlice, nil
+}
+
+// Submit rows for import by the user with the provided reference, processed in the background.
+//
+// Return: pending job.
+func (importer *Importer) Submit(reference string, source string, rowSlice []model.ImportRow) model.ImportJob {
+	job := importer.createJob(reference, source, len(rowSlice))
+
+	go importer.process(job, rowSlice)
+
+	return importer.snapshot(job)
+}
+
+// Import rows for the user with the provided reference, waiting until every row is processed.
+//
+// Return: completed job.
+func (importer *Importer) Run(reference string, source string, rowSlice []model.ImportRow) model.ImportJob {
+	job := importer.createJob(reference, source, len(rowSlice))
+
+	importer.process(job, rowSlice)
+
+	return importer.snapshot(job)
+}
+
+// Fetch an import job of the user with the provided reference.
+//
+// Return: job and true when the user has such a job, empty job and false when not.
+func (importer *Importer) Job(reference string, id int) (model.ImportJob, bool) {
+	importer.mutex.Lock()
+	job, exists := importer.jobs[id]
+	importer.mutex.Unlock()
+
+	if !exists || job.Owner != reference {
+		return model.ImportJob{}, false
+	}
+
+	return importer.snapshot(job), true
+}
+
+func (importer *Importer) createJob(reference string, source string, total int) *model.ImportJob {
+	importer.mutex.Lock()
+	defer importer.mutex.Unlock()
+
+	importer.next++
+
+	job := &model.ImportJob{
+		ID:          importer.next,
+		Owner:       reference,
+		Source:      source,
+		Status:      JobPending,
+		Summary:     model.ImportSummary{Total: total},
+		Rows:        []model.ImportRowResult{},
+		DateCreated: time.Now().Unix(),
+	}
+
+	importer.jobs[job.ID] = job
+
+	for id := job.ID - MaximumRetainedJobs; len(importer.jobs) > MaximumRetainedJobs && id > 0; id-- {
+		if retainedJob, exists := importer.jobs[id]; exists && retainedJob.Status == JobCompleted {
+			delete(importer.jobs, id)
+		}
+	}
+
+	return job
+}
+
+// Copy a job, so that it may be read while rows are processed.
+func (importer *Importer) snapshot(job *model.ImportJob) model.ImportJob {
+	importer.mutex.Lock()
+	defer importer.mutex.Unlock()
+
+	copiedJob := *job
+	copiedJob.Rows = slices.Clone(job.Rows)
+
+	return copiedJob
+}
+
+func (importer *Importer) process(job *model.ImportJob, rowSlice []model.ImportRow) {
+	importer.mutex.Lock()
+	job.Status = JobRunning
+	importer.mutex.Unlock()
+
+	for _, row := range rowSlice {
+		result := importer.importRow(job.Owner, row)
+
+		importer.mutex.Lock()
+
+		job.Rows = append(job.Rows, result)
+
+		switch result.Status {
+		case RowMatched:
+			job.Summary.Matched++
+		case RowAmbiguous:
+			job.Summary.Ambiguous++
+		default:
+			job.Summary.Failed++
+		}
+
+		importer.mutex.Unlock()
+	}
+
+	importer.mutex.Lock()
+	job.Status = JobCompleted
+	job.DateCompleted = time.Now().Unix()
+	importer.mutex.Unlock()
+}
+
+// Resolve, store, and collect one row.
+func (importer *Importer) importRow(reference string, row model.ImportRow) model.ImportRowResult {
+	result := model.ImportRowResult{
+		Line:  row.Line,
+		Type:  row.Type,
+		Query: row.Identifier,
+	}
+
+	if result.Query == "" {
+		result.Query = row.Title
+	}
+
+	var id int
+	var err error
+
+	switch row.Type {
+	case material.TypeBook:
+		id, err = importer.importBook(row)
+	case material.TypeGame:
+		id, result.Candidates, err = importer.importGame(row)
+	case material.TypeMovie:
+		id, result.Candidates, err = importer.importMovie(row)
+	default:
+		err = fmt.Errorf("unsupported material type '%s'", row.Type)
+	}
+
+	switch {
+	case err != nil:
+		result.Status = RowFailed
+		result.Message = err.Error()
+	case id == 0:
+		result.Status = RowAmbiguous
+		result.Message = fmt.Sprintf("%d candidates match", len(result.Candidates))
+	default:
+		result.Status = RowMatched
+		result.Material = id
+		result.Candidates = nil
+	}
+
+	if result.Status != RowMatched || importer.collector == nil {
+		return result
+	}
+
+	_, _, err = importer.collector.AddCollectionItem(reference, row.Type, id)
+
+	if err != nil {
+		importer.logger.Printf("Unable to add imported %s '%d' to collection of user '%s': %v", row.Type, id, reference, err)
+
+		result.Message = "stored, but unable to add to collection"
+	}
+
+	return result
+}
+
+func (importer *Importer) importBook(row model.ImportRow) (int, error) {
+	if row.Identifier == "" {
+		return 0, errors.New("no ISBN provided")
+	}
+
+	id, _, err := importer.books.StoreBook(row.Identifier)
+
+	if errors.Is(err, isbn.ErrInvalidISBN) {
+		return 0, fmt.Errorf("invalid ISBN '%s'", row.Identifier)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("unable to store book with ISBN '%s'", row.Identifier)
+	}
+
+	return id, nil
+}
+
+func (importer *Importer) importGame(row model.ImportRow) (int, []int, error) {
+	if row.Identifier == "" {
+		return 0, nil, errors.New("no Steam application identifier provided")
+	}
+
+	referenceSlice, err := importer.games.ResolveSteamGame(row.Identifier)
+
+	if err != nil {
+		return 0, nil, fmt.Errorf("unable to resolve Steam application '%s'", row.Identifier)
+	}
+
+	switch len(referenceSlice) {
+	case 0:
+		return 0, nil, fmt.Errorf("no game matches Steam application '%s'", row.Identifier)
+	case 1:
+		id, err := importer.storeGame(referenceSlice[0])
+
+		return id, nil, err
+	default:
+		return 0, referenceSlice, nil
+	}
+}
+
+func (importer *Importer) storeGame(reference int) (int, error) {
+	id, _, err := importer.games.StoreGame(strconv.Itoa(reference))
+
+	if err != nil {
+		return 0, fmt.Errorf("unable to store game '%d'", reference)
+	}
+
+	if id == 0 {
+		return 0, fmt.Errorf("no game matches IGDB identifier '%d'", reference)
+	}
+
+	return id, nil
+}
+
+func (importer *Importer) importMovie(row model.ImportRow) (int, []int, error) {
+	if row.Title == "" {
+		return 0, nil, errors.New("no title provided")
+	}
+
+	resultSlice, err := importer.movies.SearchMovies(row.Title)
+
+	if err != nil {
+		return 0, nil, fmt.Errorf("unable to search movies titled '%s'", row.Title)
+	}
+
+	candidateSlice := MatchMovies(resultSlice, row.Title, row.Year)
+
+	switch len(candidateSlice) {
+	case 0:
+		return 0, nil, fmt.Errorf("no movie matches '%s' (%d)", row.Title, row.Year)
+	case 1:
+		id, _, err := importer.movies.StoreMovie(strconv.Itoa(candidateSlice[0]))
+
+		if err != nil {
+			return 0, nil, fmt.Errorf("unable to store movie '%d'", candidateSlice[0])
+		}
+
+		if id == 0 {
+			return 0, nil, fmt.Errorf("no movie matches TMDB identifier '%d'", candidateSlice[0])
+		}
+
+		return id, nil, nil
+	default:
+		return 0, candidateSlice, nil
+	}
+}
+
+// Match movie search results against a title and release year (UTC), ignoring the year when zero. When several
+// results share the year, only those with the exact title (case-insensitive) are kept, if any.
+//
+// Return: matching TMDB numeric identifier slice.
+func MatchMovies(resultSlice []movieModel.MovieSearchResult, title string, year int) []int {
+	var yearSlice []movieModel.MovieSearchResult
+
+	for _, result := range resultSlice {
+		if year == 0 || (result.ReleaseDate != 0 && time.Unix(result.ReleaseDate, 0).UTC().Year() == year) {
+			yearSlice = append(yearSlice, result)
+		}
+	}
+
+	var titleSlice []movieModel.MovieSearchResult
+
+	for _, result := range yearSlice {
+		if strings.EqualFold(strings.TrimSpace(result.Title), strings.TrimSpace(title)) {
+			titleSlice = append(titleSlice, result)
+		}
+	}
+
+	if len(yearSlice) > 1 && len(titleSlice) > 0 {
+		yearSlice = titleSlice
+	}
+
+	candidateSlice := []int{}
+
+	for _, result := range yearSlice {
+		candidateSlice = append(candidateSlice, result.ID)
+	}
+
+	return candidateSlice
+}
@@ -0,0 +1,164 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/importer"
+)
+
+var ErrInvalidFile = errors.New("file does not match the expected export format")
+
+// Parse a Goodreads library export (CSV), where each book is identified by its ISBN-13 or, failing that, its ISBN-10.
+// Goodreads wraps ISBNs in a formula (e.g., '="9780441172719"'), which is removed.
+//
+// Return: import row slice and nil with success, empty slice and error without.
+func ParseGoodreads(reader io.Reader) ([]model.ImportRow, error) {
+	return parseCSV(reader, []string{"title", "isbn13", "isbn"}, func(line int, record map[string]string) model.ImportRow {
+		identifier := trimISBN(record["isbn13"])
+
+		if identifier == "" {
+			identifier = trimISBN(record["isbn"])
+		}
+
+		return model.ImportRow{
+			Line:       line,
+			Type:       material.TypeBook,
+			Identifier: identifier,
+			Title:      record["title"],
+		}
+	})
+}
+
+// Parse a Letterboxd export (CSV, e.g., 'watched.csv' or 'diary.csv'), where each movie is identified by its title and
+// release year.
+//
+// Return: import row slice and nil with success, empty slice and error without.
+func ParseLetterboxd(reader io.Reader) ([]model.ImportRow, error) {
+	return parseCSV(reader, []string{"name", "year"}, func(line int, record map[string]string) model.ImportRow {
+		year, _ := strconv.Atoi(record["year"])
+
+		return model.ImportRow{
+			Line:  line,
+			Type:  material.TypeMovie,
+			Title: record["name"],
+			Year:  year,
+		}
+	})
+}
+
+// A Steam library export, as returned by the Steam Web API 'GetOwnedGames' method, either complete or without the
+// 'response' wrapper.
+type steamLibrary struct {
+	Response struct {
+		Games []steamGame `json:"games"`
+	} `json:"response"`
+	Games []steamGame `json:"games"`
+}
+
+type steamGame struct {
+	AppID int    `json:"appid"`
+	Name  string `json:"name"`
+}
+
+// Parse a Steam library export (JSON), where each game is identified by its Steam application identifier.
+//
+// Return: import row slice and nil with success, empty slice and error without.
+func ParseSteam(reader io.Reader) ([]model.ImportRow, error) {
+	var library steamLibrary
+
+	err := json.NewDecoder(reader).Decode(&library)
+
+	if err != nil {
+		return []model.ImportRow{}, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+
+	games := library.Response.Games
+
+	if len(games) == 0 {
+		games = library.Games
+	}
+
+	rowSlice := []model.ImportRow{}
+
+	for index, game := range games {
+		identifier := ""
+
+		if game.AppID > 0 {
+			identifier = strconv.Itoa(game.AppID)
+		}
+
+		rowSlice = append(rowSlice, model.ImportRow{
+			Line:       index + 1,
+			Type:       material.TypeGame,
+			Identifier: identifier,
+			Title:      game.Name,
+		})
+	}
+
+	return rowSlice, nil
+}
+
+// Parse a CSV file with a header row, which must contain the required columns (case-insensitive), mapping each
+// subsequent record to an import row with its line number.
+func parseCSV(reader io.Reader, required []string, mapRecord func(int, map[string]string) model.ImportRow) ([]model.ImportRow, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+
+	header, err := csvReader.Read()
+
+	if err != nil {
+		return []model.ImportRow{}, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+
+	for index := range header {
+		header[index] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(header[index], "\ufeff")))
+	}
+
+	for _, column := range required {
+		if !slices.Contains(header, column) {
+			return []model.ImportRow{}, fmt.Errorf("%w: missing column '%s'", ErrInvalidFile, column)
+		}
+	}
+
+	rowSlice := []model.ImportRow{}
+
+	for line := 2; ; line++ {
+		fields, err := csvReader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return []model.ImportRow{}, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+		}
+
+		record := make(map[string]string)
+
+		for index, field := range fields {
+			if index < len(header) {
+				record[header[index]] = strings.TrimSpace(field)
+			}
+		}
+
+		rowSlice = append(rowSlice, mapRecord(line, record))
+	}
+
+	return rowSlice, nil
+}
+
+// Remove the formula wrapper and dashes from a Goodreads ISBN (e.g., '="978-0441172719"' becomes '9780441172719').
+func trimISBN(isbn string) string {
+	isbn = strings.TrimPrefix(isbn, "=")
+	isbn = strings.Trim(isbn, `"`)
+
+	return strings.ReplaceAll(isbn, "-", "")
+}
@@ -0,0 +1,168 @@
+package isbn
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidISBN = errors.New("ISBN must be a valid ISBN-10, ISBN-13, or EAN-13 barcode with an optional add-on")
+
+// Bookland EAN-13 prefixes, of which only the first has an ISBN-10 equivalent.
+const (
+	prefix978 = "978"
+	prefix979 = "979"
+)
+
+// Remove separators (dashes and whitespace) from an ISBN and upper-case an ISBN-10 check character; e.g.,
+// "0-8044-2957-x" becomes "080442957X".
+//
+// Return: cleaned ISBN, which may remain invalid.
+func Clean(isbn string) string {
+	var builder strings.Builder
+
+	for _, character := range isbn {
+		switch {
+		case character == '-' || character == ' ' || character == '\t':
+			continue
+		case character == 'x':
+			builder.WriteRune('X')
+		default:
+			builder.WriteRune(character)
+		}
+	}
+
+	return builder.String()
+}
+
+// Determine whether a cleaned ISBN-10 has nine digits and a valid check character (a digit or 'X').
+//
+// Return: true if valid, false if not.
+func Valid10(isbn string) bool {
+	if len(isbn) != 10 || !digits(isbn[:9]) {
+		return false
+	}
+
+	return checkCharacter10(isbn[:9]) == isbn[9]
+}
+
+// Determine whether a cleaned ISBN-13 has a Bookland prefix ('978' or '979') and a valid check digit.
+//
+// Return: true if valid, false if not.
+func Valid13(isbn string) bool {
+	if len(isbn) != 13 || !digits(isbn) || !(strings.HasPrefix(isbn, prefix978) || strings.HasPrefix(isbn, prefix979)) {
+		return false
+	}
+
+	return checkDigit13(isbn[:12]) == isbn[12]
+}
+
+// Convert a valid ISBN-10 to its ISBN-13 equivalent; e.g., "0316452467" becomes "9780316452465".
+//
+// Return: ISBN-13 and nil with success, empty string and ErrInvalidISBN without.
+func To13(isbn string) (string, error) {
+	isbn = Clean(isbn)
+
+	if !Valid10(isbn) {
+		return "", ErrInvalidISBN
+	}
+
+	body := prefix978 + isbn[:9]
+
+	return body + string(checkDigit13(body)), nil
+}
+
+// Convert a valid ISBN-13 with the '978' prefix to its ISBN-10 equivalent; e.g., "9780316452465" becomes
+// "0316452467". ISBN-13s with the '979' prefix have no ISBN-10 equivalent.
+//
+// Return: ISBN-10 and nil with success, empty string and ErrInvalidISBN without.
+func To10(isbn string) (string, error) {
+	isbn = Clean(isbn)
+
+	if !Valid13(isbn) || !strings.HasPrefix(isbn, prefix978) {
+		return "", ErrInvalidISBN
+	}
+
+	body := isbn[3:12]
+
+	return body + string(checkCharacter10(body)), nil
+}
+
+// Normalize an ISBN-10, ISBN-13, or scanned EAN-13 barcode, which may carry a 2- or 5-digit price or issue add-on
+// (e.g., "978031645246551599"), to a valid ISBN-13.
+//
+// Return: ISBN-13 and nil with success, empty string and ErrInvalidISBN without.
+func Normalize(isbn string) (string, error) {
+	isbn = Clean(isbn)
+
+	switch len(isbn) {
+	case 10:
+		return To13(isbn)
+	case 13, 15, 18:
+		if !digits(isbn) || !Valid13(isbn[:13]) {
+			return "", ErrInvalidISBN
+		}
+
+		return isbn[:13], nil
+	default:
+		return "", ErrInvalidISBN
+	}
+}
+
+// Determine both forms of an ISBN, normalizing it first, where the ISBN-10 is empty for a '979' ISBN-13.
+//
+// Return: ISBN-10, ISBN-13, and nil with success; empty strings and ErrInvalidISBN without.
+func Forms(isbn string) (string, string, error) {
+	isbn13, err := Normalize(isbn)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	isbn10, _ := To10(isbn13)
+
+	return isbn10, isbn13, nil
+}
+
+func digits(value string) bool {
+	for _, character := range value {
+		if character < '0' || character > '9' {
+			return false
+		}
+	}
+
+	return value != ""
+}
+
+// Calculate the ISBN-10 check character of nine digits, weighted 10 to 2, modulo 11.
+func checkCharacter10(body string) byte {
+	sum := 0
+
+	for index := 0; index < 9; index++ {
+		sum += int(body[index]-'0') * (10 - index)
+	}
+
+	check := (11 - sum%11) % 11
+
+	if check == 10 {
+		return 'X'
+	}
+
+	return byte('0' + check)
+}
+
+// Calculate the EAN-13 check digit of twelve digits, weighted alternately 1 and 3, modulo 10.
+func checkDigit13(body string) byte {
+	sum := 0
+
+	for index := 0; index < 12; index++ {
+		weight := 1
+
+		if index%2 == 1 {
+			weight = 3
+		}
+
+		sum += int(body[index]-'0') * weight
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
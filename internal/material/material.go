@@ -0,0 +1,42 @@
+package material
+
+import "github.com/muzzarellimj/grace-material-api/internal/database"
+
+// Material type names, as used in per-user routes (e.g., '/api/collection/book').
+const (
+	TypeBook      = "book"
+	TypeGame      = "game"
+	TypeMovie     = "movie"
+	TypeShow      = "show"
+	TypeAlbum     = "album"
+	TypeBoardGame = "boardgame"
+	TypeComic     = "comic"
+	TypePodcast   = "podcast"
+)
+
+// A material type, described by its fragment table and the column name used to reference it from bridge tables.
+type Material struct {
+	Type   string
+	Table  string
+	Column string
+}
+
+var materials = map[string]Material{
+	TypeBook:      {Type: TypeBook, Table: database.TableBookFragments, Column: "book"},
+	TypeGame:      {Type: TypeGame, Table: database.TableGameFragments, Column: "game"},
+	TypeMovie:     {Type: TypeMovie, Table: database.TableMovieFragments, Column: "movie"},
+	TypeShow:      {Type: TypeShow, Table: database.TableShowFragments, Column: "show"},
+	TypeAlbum:     {Type: TypeAlbum, Table: database.TableAlbumFragments, Column: "album"},
+	TypeBoardGame: {Type: TypeBoardGame, Table: database.TableBoardGameFragments, Column: "boardgame"},
+	TypeComic:     {Type: TypeComic, Table: database.TableComicFragments, Column: "comic"},
+	TypePodcast:   {Type: TypePodcast, Table: database.TablePodcastFragments, Column: "podcast"},
+}
+
+// Look up a material type by name.
+//
+// Return: material and true with a known type, zero material and false without.
+func Lookup(materialType string) (Material, bool) {
+	material, exists := materials[materialType]
+
+	return material, exists
+}
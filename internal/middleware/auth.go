@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/auth"
+)
+
+const principalKey string = "principal"
+
+// Create a middleware to authenticate every request and require a scope, which responds with 401 for missing or
+// invalid credentials and 403 for a principal without the required scope.
+//
+// Return: authorization middleware.
+func Authorize(authenticator *auth.Authenticator, scope auth.Scope) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		principal, err := authenticator.Authenticate(context.Request)
+
+		if err != nil {
+			context.Header("WWW-Authenticate", `Bearer realm="grace"`)
+			context.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"status":  http.StatusUnauthorized,
+				"message": fmt.Sprintf("Unable to authenticate request: %v.", err),
+			})
+
+			return
+		}
+
+		if !principal.Has(scope) {
+			context.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"status":  http.StatusForbidden,
+				"message": fmt.Sprintf("Principal '%s' is missing required scope '%s'.", principal.Subject, scope),
+			})
+
+			return
+		}
+
+		context.Set(principalKey, principal)
+		context.Next()
+	}
+}
+
+// Create a middleware to authenticate read requests, which only requires the read scope when read routes are not
+// public; a public request is still attributed to its principal when valid credentials are provided.
+//
+// Return: read authorization middleware.
+func AuthorizeRead(authenticator *auth.Authenticator) gin.HandlerFunc {
+	if !authenticator.PublicRead() {
+		return Authorize(authenticator, auth.ScopeRead)
+	}
+
+	return func(context *gin.Context) {
+		principal, err := authenticator.Authenticate(context.Request)
+
+		if err == nil {
+			context.Set(principalKey, principal)
+		}
+
+		context.Next()
+	}
+}
+
+// Retrieve the principal authenticated for a request.
+//
+// Return: principal and true with an authenticated request, zero principal and false without.
+func Principal(context *gin.Context) (auth.Principal, bool) {
+	value, exists := context.Get(principalKey)
+
+	if !exists {
+		return auth.Principal{}, false
+	}
+
+	principal, ok := value.(auth.Principal)
+
+	return principal, ok
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"slices"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+// Create a CORS middleware with the configured origins, methods, headers, and credentials; an allowed origin of "*"
+// allows every origin.
+//
+// Return: CORS middleware.
+func CORS(configuration config.CORSConfig) gin.HandlerFunc {
+	origins := slices.DeleteFunc(slices.Clone(configuration.AllowedOrigins), func(origin string) bool {
+		return origin == "*"
+	})
+
+	return cors.New(cors.Config{
+		AllowAllOrigins:  slices.Contains(configuration.AllowedOrigins, "*"),
+		AllowOrigins:     origins,
+		AllowMethods:     configuration.AllowedMethods,
+		AllowHeaders:     configuration.AllowedHeaders,
+		AllowCredentials: configuration.AllowCredentials,
+		MaxAge:           configuration.MaxAge,
+	})
+}
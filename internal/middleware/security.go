@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+// Create a middleware to set standard security headers on every response; the Strict-Transport-Security header is
+// only set when enabled, since it should only be served over HTTPS.
+//
+// Return: security header middleware.
+func SecurityHeaders(configuration config.SecurityConfig) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		header := context.Writer.Header()
+
+		header.Set("X-Content-Type-Options", "nosniff")
+		header.Set("X-Frame-Options", "DENY")
+		header.Set("Referrer-Policy", "no-referrer")
+		header.Set("Content-Security-Policy", "default-src 'none'; frame-ancestors 'none'")
+		header.Set("Cross-Origin-Resource-Policy", "same-site")
+
+		if configuration.HSTS {
+			header.Set("Strict-Transport-Security", "max-age=63072000; includeSubDomains")
+		}
+
+		context.Next()
+	}
+}
+
+// Create a middleware to limit request body size on POST, PUT, and PATCH requests. A request with a declared
+// Content-Length beyond the limit is rejected immediately, and any other body is capped so that reads beyond the limit
+// fail (e.g., when bound with BindJSON).
+//
+// Return: body limit middleware.
+func BodyLimit(configuration config.SecurityConfig) gin.HandlerFunc {
+	limit := int64(configuration.MaxBodySize)
+
+	return func(context *gin.Context) {
+		switch context.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+		default:
+			context.Next()
+
+			return
+		}
+
+		if context.Request.ContentLength > limit {
+			context.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{
+				"status":  http.StatusRequestEntityTooLarge,
+				"message": fmt.Sprintf("Request body exceeds maximum size of %d bytes.", limit),
+			})
+
+			return
+		}
+
+		if context.Request.Body != nil {
+			context.Request.Body = http.MaxBytesReader(context.Writer, context.Request.Body, limit)
+		}
+
+		context.Next()
+	}
+}
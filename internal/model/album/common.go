@@ -0,0 +1,30 @@
+package model
+
+import reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+
+type Album struct {
+	ID             int                          `json:"id"`
+	Title          string                       `json:"title"`
+	ArtistCredit   string                       `json:"artist_credit"`
+	Artists        []AlbumArtistFragment        `json:"artists"`
+	Labels         []AlbumLabel                 `json:"labels"`
+	Genres         []AlbumGenreFragment         `json:"genres"`
+	Tracks         []AlbumTrackFragment         `json:"tracks"`
+	ReleaseDate    int64                        `json:"release_date"`
+	Country        string                       `json:"country"`
+	Format         string                       `json:"format"`
+	Discs          int                          `json:"discs"`
+	Runtime        int                          `json:"runtime"`
+	Barcode        string                       `json:"barcode"`
+	Image          string                       `json:"image"`
+	Reference      string                       `json:"reference"`
+	GroupReference string                       `json:"group_reference"`
+	Rating         *reviewModel.RatingAggregate `json:"rating,omitempty"`
+}
+
+// A label of an album with the catalog number of the album on that label.
+type AlbumLabel struct {
+	AlbumLabelFragment
+
+	CatalogNumber string `json:"catalog_number"`
+}
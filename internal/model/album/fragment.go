@@ -0,0 +1,47 @@
+package model
+
+type AlbumFragment struct {
+	ID             int    `json:"id"`
+	Title          string `json:"title"`
+	ArtistCredit   string `json:"artist_credit"`
+	ReleaseDate    int64  `json:"release_date"`
+	Country        string `json:"country"`
+	Format         string `json:"format"`
+	Discs          int    `json:"discs"`
+	Tracks         int    `json:"tracks"`
+	Runtime        int    `json:"runtime"`
+	Barcode        string `json:"barcode"`
+	Image          string `json:"image"`
+	Reference      string `json:"reference"`
+	GroupReference string `json:"group_reference"`
+}
+
+type AlbumArtistFragment struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	SortName  string `json:"sort_name"`
+	Reference string `json:"reference"`
+}
+
+type AlbumLabelFragment struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Reference string `json:"reference"`
+}
+
+type AlbumGenreFragment struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// A track of an album, where length is in seconds and number is as printed (e.g., "A1" on a vinyl record).
+type AlbumTrackFragment struct {
+	ID        int    `json:"id"`
+	Album     int    `json:"album"`
+	Disc      int    `json:"disc"`
+	Position  int    `json:"position"`
+	Number    string `json:"number"`
+	Title     string `json:"title"`
+	Length    int    `json:"length"`
+	Reference string `json:"reference"`
+}
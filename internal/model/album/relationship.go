@@ -0,0 +1,18 @@
+package model
+
+type AlbumArtistRelationship struct {
+	Album    int `json:"album"`
+	Artist   int `json:"artist"`
+	Position int `json:"position"`
+}
+
+type AlbumLabelRelationship struct {
+	Album         int    `json:"album"`
+	Label         int    `json:"label"`
+	CatalogNumber string `json:"catalog_number"`
+}
+
+type AlbumGenreRelationship struct {
+	Album int `json:"album"`
+	Genre int `json:"genre"`
+}
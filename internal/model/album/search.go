@@ -0,0 +1,9 @@
+package model
+
+type AlbumSearchResult struct {
+	ID           string `json:"id"`
+	Title        string `json:"title"`
+	ArtistCredit string `json:"artist_credit"`
+	Type         string `json:"type"`
+	ReleaseDate  int64  `json:"release_date"`
+}
@@ -0,0 +1,131 @@
+package model
+
+import (
+	albumModel "github.com/muzzarellimj/grace-material-api/internal/model/album"
+	boardGameModel "github.com/muzzarellimj/grace-material-api/internal/model/boardgame"
+	bookModel "github.com/muzzarellimj/grace-material-api/internal/model/book"
+	comicModel "github.com/muzzarellimj/grace-material-api/internal/model/comic"
+	gameModel "github.com/muzzarellimj/grace-material-api/internal/model/game"
+	movieModel "github.com/muzzarellimj/grace-material-api/internal/model/movie"
+	podcastModel "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+	showModel "github.com/muzzarellimj/grace-material-api/internal/model/show"
+)
+
+type Archive struct {
+	Version          int                        `json:"version"`
+	DateCreated      int64                      `json:"date_created"`
+	Books            []bookModel.Book           `json:"books"`
+	Games            []gameModel.Game           `json:"games"`
+	Movies           []movieModel.Movie         `json:"movies"`
+	Shows            []showModel.Show           `json:"shows"`
+	Albums           []albumModel.Album         `json:"albums"`
+	BoardGames       []boardGameModel.BoardGame `json:"boardgames"`
+	Comics           []comicModel.Comic         `json:"comics"`
+	Podcasts         []podcastModel.Podcast     `json:"podcasts"`
+	Collection       []ArchiveCollectionItem    `json:"collection"`
+	CollectionIssues []ArchiveCollectionIssue   `json:"collection_issues"`
+	Progress         []ArchiveProgressEntry     `json:"progress"`
+	EpisodeProgress  []ArchiveEpisodeProgress   `json:"episode_progress"`
+	IssueProgress    []ArchiveIssueProgress     `json:"issue_progress"`
+	Reviews          []ArchiveReview            `json:"reviews"`
+	Tags             []ArchiveTag               `json:"tags"`
+	Lists            []ArchiveList              `json:"lists"`
+}
+
+// A collected material, where the platform is the archived numeric identifier of one of the platforms of a game.
+type ArchiveCollectionItem struct {
+	Type      string `json:"type"`
+	Material  int    `json:"material"`
+	DateAdded int64  `json:"date_added"`
+	Platform  *int   `json:"platform,omitempty"`
+}
+
+// An owned issue, by the archived numeric identifiers of the comic and of an issue in its volumes.
+type ArchiveCollectionIssue struct {
+	Comic     int   `json:"comic"`
+	Issue     int   `json:"issue"`
+	DateAdded int64 `json:"date_added"`
+}
+
+type ArchiveProgressEntry struct {
+	Type         string `json:"type"`
+	Material     int    `json:"material"`
+	Status       string `json:"status"`
+	Progress     int    `json:"progress"`
+	DateRecorded int64  `json:"date_recorded"`
+}
+
+// A watch of an episode, by the archived numeric identifiers of the show and of an episode in its seasons.
+type ArchiveEpisodeProgress struct {
+	Show        int   `json:"show"`
+	Episode     int   `json:"episode"`
+	DateWatched int64 `json:"date_watched"`
+}
+
+// A read of an issue, by the archived numeric identifiers of the comic and of an issue in its volumes.
+type ArchiveIssueProgress struct {
+	Comic    int   `json:"comic"`
+	Issue    int   `json:"issue"`
+	DateRead int64 `json:"date_read"`
+}
+
+type ArchiveReview struct {
+	Type        string `json:"type"`
+	Material    int    `json:"material"`
+	Rating      int    `json:"rating"`
+	Review      string `json:"review"`
+	DateCreated int64  `json:"date_created"`
+	DateUpdated int64  `json:"date_updated"`
+}
+
+type ArchiveTag struct {
+	Type     string `json:"type"`
+	Material int    `json:"material"`
+	Name     string `json:"name"`
+}
+
+type ArchiveList struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	DateCreated int64             `json:"date_created"`
+	Items       []ArchiveListItem `json:"items"`
+}
+
+type ArchiveListItem struct {
+	Position int    `json:"position"`
+	Type     string `json:"type"`
+	Material int    `json:"material"`
+}
+
+type RestoreReport struct {
+	Books      int `json:"books"`
+	Games      int `json:"games"`
+	Movies     int `json:"movies"`
+	Shows      int `json:"shows"`
+	Albums     int `json:"albums"`
+	BoardGames int `json:"boardgames"`
+	Comics     int `json:"comics"`
+	Podcasts   int `json:"podcasts"`
+	Collection int `json:"collection"`
+	Progress   int `json:"progress"`
+	Reviews    int `json:"reviews"`
+	Tags       int `json:"tags"`
+	Lists      int `json:"lists"`
+	Skipped    int `json:"skipped"`
+}
+
+type MaterialExport struct {
+	Books      []bookModel.Book           `json:"books"`
+	Games      []gameModel.Game           `json:"games"`
+	Movies     []movieModel.Movie         `json:"movies"`
+	Shows      []showModel.Show           `json:"shows"`
+	Albums     []albumModel.Album         `json:"albums"`
+	BoardGames []boardGameModel.BoardGame `json:"boardgames"`
+	Comics     []comicModel.Comic         `json:"comics"`
+	Podcasts   []podcastModel.Podcast     `json:"podcasts"`
+}
+
+type MaterialExportLine struct {
+	Type     string      `json:"type"`
+	Material interface{} `json:"material"`
+}
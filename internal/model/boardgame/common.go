@@ -0,0 +1,23 @@
+package model
+
+import reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+
+type BoardGame struct {
+	ID          int                          `json:"id"`
+	Title       string                       `json:"title"`
+	Description string                       `json:"description"`
+	Designers   []BoardGameDesignerFragment  `json:"designers"`
+	Publishers  []BoardGamePublisherFragment `json:"publishers"`
+	Mechanics   []BoardGameMechanicFragment  `json:"mechanics"`
+	Categories  []BoardGameCategoryFragment  `json:"categories"`
+	ReleaseDate int64                        `json:"release_date"`
+	MinPlayers  int                          `json:"min_players"`
+	MaxPlayers  int                          `json:"max_players"`
+	PlayingTime int                          `json:"playing_time"`
+	MinPlayTime int                          `json:"min_play_time"`
+	MaxPlayTime int                          `json:"max_play_time"`
+	MinAge      int                          `json:"min_age"`
+	Image       string                       `json:"image"`
+	Reference   int                          `json:"reference"`
+	Rating      *reviewModel.RatingAggregate `json:"rating,omitempty"`
+}
@@ -0,0 +1,41 @@
+package model
+
+// A board game, where play times are in minutes and the release date is the start of the year published.
+type BoardGameFragment struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ReleaseDate int64  `json:"release_date"`
+	MinPlayers  int    `json:"min_players"`
+	MaxPlayers  int    `json:"max_players"`
+	PlayingTime int    `json:"playing_time"`
+	MinPlayTime int    `json:"min_play_time"`
+	MaxPlayTime int    `json:"max_play_time"`
+	MinAge      int    `json:"min_age"`
+	Image       string `json:"image"`
+	Reference   int    `json:"reference"`
+}
+
+type BoardGameDesignerFragment struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Reference int    `json:"reference"`
+}
+
+type BoardGamePublisherFragment struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Reference int    `json:"reference"`
+}
+
+type BoardGameMechanicFragment struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Reference int    `json:"reference"`
+}
+
+type BoardGameCategoryFragment struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Reference int    `json:"reference"`
+}
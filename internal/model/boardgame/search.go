@@ -0,0 +1,7 @@
+package model
+
+type BoardGameSearchResult struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	ReleaseDate int64  `json:"release_date"`
+}
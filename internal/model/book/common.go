@@ -1,18 +1,59 @@
 package model
 
+import reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+
 type Book struct {
-	ID               int                     `json:"id"`
-	Title            string                  `json:"title"`
-	Subtitle         string                  `json:"subtitle"`
-	Description      string                  `json:"description"`
-	Authors          []BookAuthorFragment    `json:"authors"`
-	Publishers       []BookPublisherFragment `json:"publishers"`
-	Topics           []BookTopicFragment     `json:"topics"`
-	PublishDate      int64                   `json:"publish_date"`
-	Pages            int                     `json:"pages"`
-	ISBN10           string                  `json:"isbn10"`
-	ISBN13           string                  `json:"isbn13"`
-	Image            string                  `json:"image"`
-	EditionReference string                  `json:"edition_reference"`
-	WorkReference    string                  `json:"work_reference"`
+	ID               int                          `json:"id"`
+	Title            string                       `json:"title"`
+	Subtitle         string                       `json:"subtitle"`
+	Description      string                       `json:"description"`
+	Authors          []BookAuthorFragment         `json:"authors"`
+	Narrators        []BookNarratorFragment       `json:"narrators"`
+	Publishers       []BookPublisherFragment      `json:"publishers"`
+	Topics           []BookTopicFragment          `json:"topics"`
+	Series           []BookSeries                 `json:"series"`
+	PublishDate      int64                        `json:"publish_date"`
+	Pages            int                          `json:"pages"`
+	Format           string                       `json:"format"`
+	Duration         int                          `json:"duration"`
+	Languages        []string                     `json:"languages"`
+	OriginalLanguage string                       `json:"original_language"`
+	TranslationOf    string                       `json:"translation_of"`
+	ISBN10           string                       `json:"isbn10"`
+	ISBN13           string                       `json:"isbn13"`
+	Image            string                       `json:"image"`
+	EditionReference string                       `json:"edition_reference"`
+	WorkReference    string                       `json:"work_reference"`
+	Rating           *reviewModel.RatingAggregate `json:"rating,omitempty"`
+}
+
+type Work struct {
+	ID          int                 `json:"id"`
+	Title       string              `json:"title"`
+	Description string              `json:"description"`
+	Topics      []BookTopicFragment `json:"topics"`
+	Reference   string              `json:"reference"`
+	Editions    []Book              `json:"editions"`
+}
+
+type BookSeries struct {
+	ID       int     `json:"id"`
+	Name     string  `json:"name"`
+	Position float64 `json:"position"`
+}
+
+type Series struct {
+	ID    int          `json:"id"`
+	Name  string       `json:"name"`
+	Books []SeriesBook `json:"books"`
+}
+
+type SeriesBook struct {
+	Position float64 `json:"position"`
+	Book     Book    `json:"book"`
+}
+
+type SeriesUpdate struct {
+	Name     string  `json:"name"`
+	Position float64 `json:"position"`
 }
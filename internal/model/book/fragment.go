@@ -1,27 +1,43 @@
 package model
 
+// A book edition, where an audiobook (i.e., format 'audiobook') has a duration in minutes in place of or in addition to
+// its pages. Languages are MARC language codes (e.g., 'eng'), and a translation holds the language and title it was
+// translated from.
 type BookFragment struct {
-	ID               int    `json:"id"`
-	Title            string `json:"title"`
-	Subtitle         string `json:"subtitle"`
-	Description      string `json:"description"`
-	PublishDate      int64  `json:"publish_date"`
-	Pages            int    `json:"pages"`
-	ISBN10           string `json:"isbn10"`
-	ISBN13           string `json:"isbn13"`
-	Image            string `json:"image"`
-	EditionReference string `json:"edition_reference"`
-	WorkReference    string `json:"work_reference"`
+	ID               int      `json:"id"`
+	Title            string   `json:"title"`
+	Subtitle         string   `json:"subtitle"`
+	PublishDate      int64    `json:"publish_date"`
+	Pages            int      `json:"pages"`
+	Format           string   `json:"format"`
+	Duration         int      `json:"duration"`
+	Languages        []string `json:"languages"`
+	OriginalLanguage string   `json:"original_language"`
+	TranslationOf    string   `json:"translation_of"`
+	ISBN10           string   `json:"isbn10"`
+	ISBN13           string   `json:"isbn13"`
+	Image            string   `json:"image"`
+	EditionReference string   `json:"edition_reference"`
+	WorkReference    string   `json:"work_reference"`
 }
 
 type BookAuthorFragment struct {
-	ID         int    `json:"id"`
-	FirstName  string `json:"first_name"`
-	MiddleName string `json:"middle_name"`
-	LastName   string `json:"last_name"`
-	Biography  string `json:"biography"`
-	Image      string `json:"image"`
-	Reference  string `json:"reference"`
+	ID             int      `json:"id"`
+	FirstName      string   `json:"first_name"`
+	MiddleName     string   `json:"middle_name"`
+	LastName       string   `json:"last_name"`
+	DisplayName    string   `json:"display_name"`
+	SortName       string   `json:"sort_name"`
+	AlternateNames []string `json:"alternate_names"`
+	Biography      string   `json:"biography"`
+	Image          string   `json:"image"`
+	Reference      string   `json:"reference"`
+}
+
+type BookNarratorFragment struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	SortName string `json:"sort_name"`
 }
 
 type BookPublisherFragment struct {
@@ -33,3 +49,15 @@ type BookTopicFragment struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
 }
+
+type BookSeriesFragment struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type BookWorkFragment struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Reference   string `json:"reference"`
+}
@@ -5,6 +5,11 @@ type BookAuthorRelationship struct {
 	Author int `json:"author"`
 }
 
+type BookNarratorRelationship struct {
+	Book     int `json:"book"`
+	Narrator int `json:"narrator"`
+}
+
 type BookPublisherRelationship struct {
 	Book      int `json:"book"`
 	Publisher int `json:"publisher"`
@@ -14,3 +19,14 @@ type BookTopicRelationship struct {
 	Book  int `json:"book"`
 	Topic int `json:"topic"`
 }
+
+type BookSeriesRelationship struct {
+	Book     int     `json:"book"`
+	Series   int     `json:"series"`
+	Position float64 `json:"position"`
+}
+
+type BookWorkTopicRelationship struct {
+	Work  int `json:"work"`
+	Topic int `json:"topic"`
+}
@@ -6,4 +6,7 @@ type BookSearchResult struct {
 	Authors     []string `json:"authors"`
 	PublishDate int64    `json:"publish_date"`
 	Image       string   `json:"image"`
+	Work        string   `json:"work"`
+	Editions    int      `json:"editions"`
+	Languages   []string `json:"languages"`
 }
@@ -0,0 +1,49 @@
+package model
+
+type Collection struct {
+	ID          int              `json:"id"`
+	Name        string           `json:"name"`
+	Books       []CollectionItem `json:"books"`
+	Games       []CollectionItem `json:"games"`
+	Movies      []CollectionItem `json:"movies"`
+	Shows       []CollectionItem `json:"shows"`
+	Albums      []CollectionItem `json:"albums"`
+	BoardGames  []CollectionItem `json:"boardgames"`
+	Comics      []CollectionItem `json:"comics"`
+	Podcasts    []CollectionItem `json:"podcasts"`
+	DateCreated int64            `json:"date_created"`
+}
+
+// Determine whether a collection holds no items of any material type.
+//
+// Return: true if empty, false if not.
+func (collection Collection) IsEmpty() bool {
+	for _, itemSlice := range [][]CollectionItem{collection.Books, collection.Games, collection.Movies, collection.Shows, collection.Albums, collection.BoardGames, collection.Comics, collection.Podcasts} {
+		if len(itemSlice) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// A material in a collection, where a game may hold the numeric identifier of the platform it is owned on.
+type CollectionItem struct {
+	ID        int    `json:"id"`
+	Title     string `json:"title"`
+	Image     string `json:"image"`
+	DateAdded int64  `json:"date_added"`
+	Platform  *int   `json:"platform,omitempty"`
+}
+
+// An issue of a comic owned by a user, identified by its volume and issue number.
+type CollectionIssue struct {
+	ID              int    `json:"id"`
+	Volume          int    `json:"volume"`
+	VolumeTitle     string `json:"volume_title"`
+	VolumeStartDate int64  `json:"-"`
+	Number          string `json:"number"`
+	Title           string `json:"title"`
+	Image           string `json:"image"`
+	DateAdded       int64  `json:"date_added"`
+}
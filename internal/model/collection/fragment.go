@@ -0,0 +1,8 @@
+package model
+
+type CollectionFragment struct {
+	ID          int    `json:"id"`
+	Owner       int    `json:"owner"`
+	Name        string `json:"name"`
+	DateCreated int64  `json:"date_created"`
+}
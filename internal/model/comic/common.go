@@ -0,0 +1,36 @@
+package model
+
+import reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+
+type Comic struct {
+	ID          int                          `json:"id"`
+	Title       string                       `json:"title"`
+	Description string                       `json:"description"`
+	Creators    []ComicCreator               `json:"creators"`
+	Publishers  []ComicPublisherFragment     `json:"publishers"`
+	Volumes     []ComicVolume                `json:"volumes"`
+	StartDate   int64                        `json:"start_date"`
+	Issues      int                          `json:"issues"`
+	Image       string                       `json:"image"`
+	Rating      *reviewModel.RatingAggregate `json:"rating,omitempty"`
+}
+
+// A creator of a comic with their role (e.g., 'writer' or 'artist'), where a creator with several roles appears once
+// per role.
+type ComicCreator struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	Reference int    `json:"reference"`
+}
+
+// A volume of a comic with its issues, in issue number order.
+type ComicVolume struct {
+	ID          int                  `json:"id"`
+	Title       string               `json:"title"`
+	Description string               `json:"description"`
+	StartDate   int64                `json:"start_date"`
+	Image       string               `json:"image"`
+	Reference   int                  `json:"reference"`
+	Issues      []ComicIssueFragment `json:"issues"`
+}
@@ -0,0 +1,47 @@
+package model
+
+// A comic series, which holds one or more volumes (i.e., runs) of issues.
+type ComicFragment struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	StartDate   int64  `json:"start_date"`
+	Volumes     int    `json:"volumes"`
+	Issues      int    `json:"issues"`
+	Image       string `json:"image"`
+}
+
+type ComicVolumeFragment struct {
+	ID          int    `json:"id"`
+	Comic       int    `json:"comic"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	StartDate   int64  `json:"start_date"`
+	Issues      int    `json:"issues"`
+	Image       string `json:"image"`
+	Reference   int    `json:"reference"`
+}
+
+// An issue of a volume, where the number is kept as published (e.g., '1', '½', or '1.MU').
+type ComicIssueFragment struct {
+	ID        int    `json:"id"`
+	Comic     int    `json:"comic"`
+	Volume    int    `json:"volume"`
+	Number    string `json:"number"`
+	Title     string `json:"title"`
+	CoverDate int64  `json:"cover_date"`
+	Image     string `json:"image"`
+	Reference int    `json:"reference"`
+}
+
+type ComicCreatorFragment struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Reference int    `json:"reference"`
+}
+
+type ComicPublisherFragment struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Reference int    `json:"reference"`
+}
@@ -0,0 +1,14 @@
+package model
+
+type ComicCreatorRelationship struct {
+	ID      int    `json:"id"`
+	Comic   int    `json:"comic"`
+	Creator int    `json:"creator"`
+	Role    string `json:"role"`
+}
+
+type ComicPublisherRelationship struct {
+	ID        int `json:"id"`
+	Comic     int `json:"comic"`
+	Publisher int `json:"publisher"`
+}
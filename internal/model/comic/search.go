@@ -0,0 +1,10 @@
+package model
+
+type ComicSearchResult struct {
+	ID        int    `json:"id"`
+	Title     string `json:"title"`
+	Publisher string `json:"publisher"`
+	StartDate int64  `json:"start_date"`
+	Issues    int    `json:"issues"`
+	Image     string `json:"image"`
+}
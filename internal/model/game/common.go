@@ -1,15 +1,26 @@
 package model
 
+import reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+
 type Game struct {
-	ID          int                     `json:"id"`
-	Title       string                  `json:"title"`
-	Summary     string                  `json:"summary"`
-	Storyline   string                  `json:"storyline"`
-	Franchises  []GameFranchiseFragment `json:"franchises"`
-	Genres      []GameGenreFragment     `json:"genres"`
-	Platforms   []GamePlatformFragment  `json:"platforms"`
-	Studios     []GameStudioFragment    `json:"studios"`
-	ReleaseDate int                     `json:"release_date"`
-	Image       string                  `json:"image"`
-	Reference   int                     `json:"reference"`
+	ID          int                          `json:"id"`
+	Title       string                       `json:"title"`
+	Summary     string                       `json:"summary"`
+	Storyline   string                       `json:"storyline"`
+	Franchises  []GameFranchiseFragment      `json:"franchises"`
+	Genres      []GameGenreFragment          `json:"genres"`
+	Platforms   []GamePlatformFragment       `json:"platforms"`
+	Studios     []GameStudioFragment         `json:"studios"`
+	ReleaseDate int                          `json:"release_date"`
+	Releases    []GameRelease                `json:"releases"`
+	Image       string                       `json:"image"`
+	Reference   int                          `json:"reference"`
+	Rating      *reviewModel.RatingAggregate `json:"rating,omitempty"`
+}
+
+// A release of a game on a platform in a region (e.g., "north_america" or "worldwide").
+type GameRelease struct {
+	Platform    GamePlatformFragment `json:"platform"`
+	Region      string               `json:"region"`
+	ReleaseDate int                  `json:"release_date"`
 }
@@ -34,3 +34,13 @@ type GameStudioFragment struct {
 	Description string `json:"description"`
 	Reference   int    `json:"reference"`
 }
+
+// A release of a game on a platform in a region, where the region is a name (e.g., "japan") and the release date is
+// the earliest date of the platform and region.
+type GameReleaseFragment struct {
+	ID          int    `json:"id"`
+	Game        int    `json:"game"`
+	Platform    int    `json:"platform"`
+	Region      string `json:"region"`
+	ReleaseDate int    `json:"release_date"`
+}
@@ -0,0 +1,29 @@
+package model
+
+type ImportJob struct {
+	ID            int               `json:"id"`
+	Owner         string            `json:"-"`
+	Source        string            `json:"source"`
+	Status        string            `json:"status"`
+	Summary       ImportSummary     `json:"summary"`
+	Rows          []ImportRowResult `json:"rows"`
+	DateCreated   int64             `json:"date_created"`
+	DateCompleted int64             `json:"date_completed,omitempty"`
+}
+
+type ImportSummary struct {
+	Total     int `json:"total"`
+	Matched   int `json:"matched"`
+	Ambiguous int `json:"ambiguous"`
+	Failed    int `json:"failed"`
+}
+
+type ImportRowResult struct {
+	Line       int    `json:"line"`
+	Type       string `json:"type"`
+	Query      string `json:"query"`
+	Status     string `json:"status"`
+	Material   int    `json:"material,omitempty"`
+	Candidates []int  `json:"candidates,omitempty"`
+	Message    string `json:"message,omitempty"`
+}
@@ -0,0 +1,9 @@
+package model
+
+type ImportRow struct {
+	Line       int    `json:"line"`
+	Type       string `json:"type"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	Year       int    `json:"year"`
+}
@@ -0,0 +1,32 @@
+package model
+
+type List struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Items       []ListItem `json:"items"`
+	DateCreated int64      `json:"date_created"`
+}
+
+type ListItem struct {
+	ID       int    `json:"id"`
+	Position int    `json:"position"`
+	Type     string `json:"type"`
+	Material int    `json:"material"`
+	Title    string `json:"title"`
+	Image    string `json:"image"`
+}
+
+type ListUpdate struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type ListItemUpdate struct {
+	Type     string `json:"type"`
+	Material int    `json:"material"`
+}
+
+type ListOrderUpdate struct {
+	Items []int `json:"items"`
+}
@@ -0,0 +1,17 @@
+package model
+
+type ListFragment struct {
+	ID          int    `json:"id"`
+	Owner       int    `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	DateCreated int64  `json:"date_created"`
+}
+
+type ListItemFragment struct {
+	ID       int    `json:"id"`
+	List     int    `json:"list"`
+	Position int    `json:"position"`
+	Type     string `json:"type"`
+	Material int    `json:"material"`
+}
@@ -1,5 +1,7 @@
 package model
 
+import reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+
 type Movie struct {
 	ID                  int                              `json:"id"`
 	Title               string                           `json:"title"`
@@ -7,8 +9,37 @@ type Movie struct {
 	Description         string                           `json:"description"`
 	Genres              []MovieGenreFragment             `json:"genres"`
 	ProductionCompanies []MovieProductionCompanyFragment `json:"production_companies"`
+	Cast                []MovieCredit                    `json:"cast"`
+	Crew                []MovieCredit                    `json:"crew"`
 	ReleaseDate         int64                            `json:"release_date"`
 	Runtime             int                              `json:"runtime"`
 	Image               string                           `json:"image"`
 	Reference           int                              `json:"reference"`
+	Rating              *reviewModel.RatingAggregate     `json:"rating,omitempty"`
+}
+
+// A credit of a person in a movie, where a cast credit holds a character and billing order (lowest first) and a crew
+// credit holds a job (e.g., "Director").
+type MovieCredit struct {
+	Person    MoviePersonFragment `json:"person"`
+	Job       string              `json:"job,omitempty"`
+	Character string              `json:"character,omitempty"`
+	Billing   int                 `json:"billing"`
+}
+
+type MoviePerson struct {
+	ID         int            `json:"id"`
+	Name       string         `json:"name"`
+	Image      string         `json:"image"`
+	Department string         `json:"department"`
+	Reference  int            `json:"reference"`
+	Credits    []PersonCredit `json:"credits"`
+}
+
+type PersonCredit struct {
+	Movie     MovieFragment `json:"movie"`
+	Role      string        `json:"role"`
+	Job       string        `json:"job,omitempty"`
+	Character string        `json:"character,omitempty"`
+	Billing   int           `json:"billing"`
 }
@@ -23,3 +23,11 @@ type MovieProductionCompanyFragment struct {
 	Image     string `json:"image"`
 	Reference int    `json:"reference"`
 }
+
+type MoviePersonFragment struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Image      string `json:"image"`
+	Department string `json:"department"`
+	Reference  int    `json:"reference"`
+}
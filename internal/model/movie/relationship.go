@@ -11,3 +11,12 @@ type MovieProductionCompanyRelationship struct {
 	Movie             int `json:"movie"`
 	ProductionCompany int `json:"production_company"`
 }
+
+type MoviePersonRelationship struct {
+	Movie     int    `json:"movie"`
+	Person    int    `json:"person"`
+	Role      string `json:"role"`
+	Job       string `json:"job"`
+	Character string `json:"character"`
+	Billing   int    `json:"billing"`
+}
@@ -0,0 +1,29 @@
+package model
+
+import reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+
+// A podcast, where the reference is its feed URL and episodes are newest first.
+type Podcast struct {
+	ID               int                          `json:"id"`
+	Title            string                       `json:"title"`
+	Author           string                       `json:"author"`
+	Description      string                       `json:"description"`
+	Categories       []PodcastCategoryFragment    `json:"categories"`
+	Language         string                       `json:"language"`
+	Explicit         bool                         `json:"explicit"`
+	FirstReleaseDate int64                        `json:"first_release_date"`
+	LastReleaseDate  int64                        `json:"last_release_date"`
+	Episodes         []PodcastEpisodeFragment     `json:"episodes"`
+	Image            string                       `json:"image"`
+	Link             string                       `json:"link"`
+	Reference        string                       `json:"reference"`
+	DateRefreshed    int64                        `json:"date_refreshed"`
+	Rating           *reviewModel.RatingAggregate `json:"rating,omitempty"`
+}
+
+// A parsed podcast feed, which holds the podcast, its episodes, and its category names before storage.
+type PodcastFeed struct {
+	Podcast    PodcastFragment
+	Episodes   []PodcastEpisodeFragment
+	Categories []string
+}
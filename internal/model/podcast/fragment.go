@@ -0,0 +1,38 @@
+package model
+
+type PodcastFragment struct {
+	ID               int    `json:"id"`
+	Title            string `json:"title"`
+	Author           string `json:"author"`
+	Description      string `json:"description"`
+	Language         string `json:"language"`
+	Explicit         bool   `json:"explicit"`
+	FirstReleaseDate int64  `json:"first_release_date"`
+	LastReleaseDate  int64  `json:"last_release_date"`
+	Episodes         int    `json:"episodes"`
+	Image            string `json:"image"`
+	Link             string `json:"link"`
+	Reference        string `json:"reference"`
+	DateRefreshed    int64  `json:"date_refreshed"`
+}
+
+// An episode of a podcast, where the duration is in seconds, season and number are 0 when not provided by the feed,
+// and the reference is the feed's unique identifier of the episode (e.g., an RSS '<guid>').
+type PodcastEpisodeFragment struct {
+	ID          int    `json:"id"`
+	Podcast     int    `json:"podcast"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ReleaseDate int64  `json:"release_date"`
+	Duration    int    `json:"duration"`
+	Season      int    `json:"season"`
+	Number      int    `json:"number"`
+	Audio       string `json:"audio"`
+	Image       string `json:"image"`
+	Reference   string `json:"reference"`
+}
+
+type PodcastCategoryFragment struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
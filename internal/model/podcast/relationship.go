@@ -0,0 +1,7 @@
+package model
+
+type PodcastCategoryRelationship struct {
+	ID       int `json:"id"`
+	Podcast  int `json:"podcast"`
+	Category int `json:"category"`
+}
@@ -0,0 +1,50 @@
+package model
+
+type ProgressEntry struct {
+	ID           int    `json:"id"`
+	Type         string `json:"type"`
+	Material     int    `json:"material"`
+	Title        string `json:"title"`
+	Image        string `json:"image"`
+	Status       string `json:"status"`
+	Progress     int    `json:"progress"`
+	Total        int    `json:"total"`
+	Unit         string `json:"unit"`
+	DateRecorded int64  `json:"date_recorded"`
+}
+
+type ProgressUpdate struct {
+	Status       string `json:"status"`
+	Progress     int    `json:"progress"`
+	DateRecorded int64  `json:"date_recorded"`
+}
+
+// A watch of an episode of a show, identified by its season and episode numbers.
+type EpisodeProgressEntry struct {
+	ID          int    `json:"id"`
+	Episode     int    `json:"episode"`
+	Season      int    `json:"season"`
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	DateWatched int64  `json:"date_watched"`
+}
+
+type EpisodeProgressUpdate struct {
+	DateWatched int64 `json:"date_watched"`
+}
+
+// A read of an issue of a comic, identified by its volume and issue number.
+type IssueProgressEntry struct {
+	ID              int    `json:"id"`
+	Issue           int    `json:"issue"`
+	Volume          int    `json:"volume"`
+	VolumeTitle     string `json:"volume_title"`
+	VolumeStartDate int64  `json:"-"`
+	Number          string `json:"number"`
+	Title           string `json:"title"`
+	DateRead        int64  `json:"date_read"`
+}
+
+type IssueProgressUpdate struct {
+	DateRead int64 `json:"date_read"`
+}
@@ -0,0 +1,9 @@
+package model
+
+type Recommendation struct {
+	Type     string  `json:"type"`
+	Material int     `json:"material"`
+	Title    string  `json:"title"`
+	Image    string  `json:"image"`
+	Score    float64 `json:"score"`
+}
@@ -0,0 +1,6 @@
+package model
+
+type CandidateFragment struct {
+	ID    int `json:"id"`
+	Count int `json:"count"`
+}
@@ -0,0 +1,23 @@
+package model
+
+type Review struct {
+	ID          int    `json:"id"`
+	Type        string `json:"type"`
+	Material    int    `json:"material"`
+	Title       string `json:"title"`
+	Image       string `json:"image"`
+	Rating      int    `json:"rating"`
+	Review      string `json:"review"`
+	DateCreated int64  `json:"date_created"`
+	DateUpdated int64  `json:"date_updated"`
+}
+
+type ReviewUpdate struct {
+	Rating int    `json:"rating"`
+	Review string `json:"review"`
+}
+
+type RatingAggregate struct {
+	Count   int     `json:"count"`
+	Average float64 `json:"average"`
+}
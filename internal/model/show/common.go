@@ -0,0 +1,33 @@
+package model
+
+import reviewModel "github.com/muzzarellimj/grace-material-api/internal/model/review"
+
+type Show struct {
+	ID           int                          `json:"id"`
+	Title        string                       `json:"title"`
+	Tagline      string                       `json:"tagline"`
+	Description  string                       `json:"description"`
+	Genres       []ShowGenreFragment          `json:"genres"`
+	Networks     []ShowNetworkFragment        `json:"networks"`
+	Seasons      []ShowSeason                 `json:"seasons"`
+	FirstAirDate int64                        `json:"first_air_date"`
+	LastAirDate  int64                        `json:"last_air_date"`
+	Status       string                       `json:"status"`
+	Episodes     int                          `json:"episodes"`
+	Runtime      int                          `json:"runtime"`
+	Image        string                       `json:"image"`
+	Reference    int                          `json:"reference"`
+	Rating       *reviewModel.RatingAggregate `json:"rating,omitempty"`
+}
+
+// A season of a show with its episodes, in episode number order.
+type ShowSeason struct {
+	ID          int                   `json:"id"`
+	Number      int                   `json:"number"`
+	Title       string                `json:"title"`
+	Description string                `json:"description"`
+	AirDate     int64                 `json:"air_date"`
+	Image       string                `json:"image"`
+	Reference   int                   `json:"reference"`
+	Episodes    []ShowEpisodeFragment `json:"episodes"`
+}
@@ -0,0 +1,53 @@
+package model
+
+type ShowFragment struct {
+	ID           int    `json:"id"`
+	Title        string `json:"title"`
+	Tagline      string `json:"tagline"`
+	Description  string `json:"description"`
+	FirstAirDate int64  `json:"first_air_date"`
+	LastAirDate  int64  `json:"last_air_date"`
+	Status       string `json:"status"`
+	Seasons      int    `json:"seasons"`
+	Episodes     int    `json:"episodes"`
+	Runtime      int    `json:"runtime"`
+	Image        string `json:"image"`
+	Reference    int    `json:"reference"`
+}
+
+type ShowSeasonFragment struct {
+	ID          int    `json:"id"`
+	Show        int    `json:"show"`
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	AirDate     int64  `json:"air_date"`
+	Image       string `json:"image"`
+	Reference   int    `json:"reference"`
+}
+
+type ShowEpisodeFragment struct {
+	ID          int    `json:"id"`
+	Show        int    `json:"show"`
+	Season      int    `json:"season"`
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	AirDate     int64  `json:"air_date"`
+	Runtime     int    `json:"runtime"`
+	Image       string `json:"image"`
+	Reference   int    `json:"reference"`
+}
+
+type ShowGenreFragment struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Reference int    `json:"reference"`
+}
+
+type ShowNetworkFragment struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Image     string `json:"image"`
+	Reference int    `json:"reference"`
+}
@@ -0,0 +1,13 @@
+package model
+
+type ShowGenreRelationship struct {
+	ID    int `json:"id"`
+	Show  int `json:"show"`
+	Genre int `json:"genre"`
+}
+
+type ShowNetworkRelationship struct {
+	ID      int `json:"id"`
+	Show    int `json:"show"`
+	Network int `json:"network"`
+}
@@ -0,0 +1,8 @@
+package model
+
+type ShowSearchResult struct {
+	ID           int    `json:"id"`
+	Title        string `json:"title"`
+	FirstAirDate int64  `json:"first_air_date"`
+	Image        string `json:"image"`
+}
@@ -0,0 +1,27 @@
+package model
+
+type Statistics struct {
+	Year            int                           `json:"year,omitempty"`
+	Materials       map[string]MaterialStatistics `json:"materials"`
+	PagesRead       int                           `json:"pages_read"`
+	MinutesListened int                           `json:"minutes_listened"`
+	RuntimeWatched  int                           `json:"runtime_watched"`
+}
+
+type MaterialStatistics struct {
+	Collected    int                          `json:"collected"`
+	Finished     int                          `json:"finished"`
+	Top          map[string][]StatisticsEntry `json:"top"`
+	ReleaseYears []ReleaseYearCount           `json:"release_years"`
+}
+
+type StatisticsEntry struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type ReleaseYearCount struct {
+	Year  int `json:"year"`
+	Count int `json:"count"`
+}
@@ -0,0 +1,8 @@
+package model
+
+type MaterialFragment struct {
+	ID          int    `json:"id"`
+	ReleaseDate int64  `json:"release_date"`
+	Total       int    `json:"total"`
+	Unit        string `json:"unit"`
+}
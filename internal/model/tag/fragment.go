@@ -0,0 +1,7 @@
+package model
+
+type TagFragment struct {
+	ID    int    `json:"id"`
+	Owner int    `json:"-"`
+	Name  string `json:"name"`
+}
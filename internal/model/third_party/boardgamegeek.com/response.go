@@ -0,0 +1,53 @@
+package model
+
+// A BoardGameGeek thing response, which holds every item matching the requested identifiers (i.e., one or none).
+type BGGThingResponse struct {
+	Items []BGGThing `xml:"item"`
+}
+
+type BGGThing struct {
+	ID            int       `xml:"id,attr"`
+	Type          string    `xml:"type,attr"`
+	Thumbnail     string    `xml:"thumbnail"`
+	Image         string    `xml:"image"`
+	Names         []BGGName `xml:"name"`
+	Description   string    `xml:"description"`
+	YearPublished BGGValue  `xml:"yearpublished"`
+	MinPlayers    BGGValue  `xml:"minplayers"`
+	MaxPlayers    BGGValue  `xml:"maxplayers"`
+	PlayingTime   BGGValue  `xml:"playingtime"`
+	MinPlayTime   BGGValue  `xml:"minplaytime"`
+	MaxPlayTime   BGGValue  `xml:"maxplaytime"`
+	MinAge        BGGValue  `xml:"minage"`
+	Links         []BGGLink `xml:"link"`
+}
+
+type BGGSearchResponse struct {
+	Total int             `xml:"total,attr"`
+	Items []BGGSearchItem `xml:"item"`
+}
+
+type BGGSearchItem struct {
+	ID            int      `xml:"id,attr"`
+	Type          string   `xml:"type,attr"`
+	Name          BGGName  `xml:"name"`
+	YearPublished BGGValue `xml:"yearpublished"`
+}
+
+// A name of a thing, where the type is "primary" or "alternate" (e.g., a translated title).
+type BGGName struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// A value held in the 'value' attribute of an element (e.g., <minplayers value="3" />).
+type BGGValue struct {
+	Value int `xml:"value,attr"`
+}
+
+// A link of a thing to another (e.g., its designers, publishers, mechanics, and categories), by link type.
+type BGGLink struct {
+	Type  string `xml:"type,attr"`
+	ID    int    `xml:"id,attr"`
+	Value string `xml:"value,attr"`
+}
@@ -0,0 +1,70 @@
+package model
+
+// A Comic Vine response envelope, where a status code of 1 indicates success and results hold a single resource (a
+// detail request) or a page of resources (a list or search request).
+type CVResponse[R interface{}] struct {
+	Error                string `json:"error"`
+	StatusCode           int    `json:"status_code"`
+	Limit                int    `json:"limit"`
+	Offset               int    `json:"offset"`
+	NumberOfPageResults  int    `json:"number_of_page_results"`
+	NumberOfTotalResults int    `json:"number_of_total_results"`
+	Results              R      `json:"results"`
+}
+
+// A Comic Vine volume, which is a single run of a series (e.g., 'The Amazing Spider-Man' of 2018).
+type CVVolume struct {
+	ID            int            `json:"id"`
+	Name          string         `json:"name"`
+	Deck          string         `json:"deck"`
+	Description   string         `json:"description"`
+	StartYear     string         `json:"start_year"`
+	CountOfIssues int            `json:"count_of_issues"`
+	Image         CVImage        `json:"image"`
+	Publisher     CVResource     `json:"publisher"`
+	FirstIssue    CVIssueSummary `json:"first_issue"`
+}
+
+type CVIssue struct {
+	ID            int               `json:"id"`
+	Name          string            `json:"name"`
+	IssueNumber   string            `json:"issue_number"`
+	CoverDate     string            `json:"cover_date"`
+	Image         CVImage           `json:"image"`
+	Volume        CVResource        `json:"volume"`
+	PersonCredits []CVPersonCredits `json:"person_credits"`
+}
+
+type CVIssueSummary struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	IssueNumber string `json:"issue_number"`
+}
+
+// A person credited on an issue, where the role holds every role of the person as a comma-separated list (e.g.,
+// 'writer, artist').
+type CVPersonCredits struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+// A reference to another Comic Vine resource (e.g., the publisher of a volume).
+type CVResource struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type CVImage struct {
+	OriginalURL string `json:"original_url"`
+	MediumURL   string `json:"medium_url"`
+}
+
+type CVSearchResult struct {
+	ID            int        `json:"id"`
+	Name          string     `json:"name"`
+	StartYear     string     `json:"start_year"`
+	CountOfIssues int        `json:"count_of_issues"`
+	Image         CVImage    `json:"image"`
+	Publisher     CVResource `json:"publisher"`
+}
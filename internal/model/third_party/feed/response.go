@@ -0,0 +1,102 @@
+package model
+
+import "encoding/xml"
+
+// A podcast feed document, which is either an RSS 2.0 document (root '<rss>', with a channel) or an Atom document (root
+// '<feed>', with entries), so that either may be decoded without knowing its format beforehand.
+//
+// Namespaced properties (e.g., those of the iTunes podcast extension) are declared before their unqualified RSS
+// counterparts, since an unqualified property matches an element of the same name in any namespace (e.g.,
+// '<itunes:title>' and '<title>').
+type FeedDocument struct {
+	XMLName xml.Name
+	Channel RSSChannel `xml:"channel"`
+
+	Title      string         `xml:"title"`
+	Subtitle   string         `xml:"subtitle"`
+	Authors    []AtomPerson   `xml:"author"`
+	Links      []AtomLink     `xml:"link"`
+	Logo       string         `xml:"logo"`
+	Icon       string         `xml:"icon"`
+	Categories []AtomCategory `xml:"category"`
+	Entries    []AtomEntry    `xml:"entry"`
+}
+
+type RSSChannel struct {
+	ITunesAuthor     string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd author"`
+	ITunesSummary    string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd summary"`
+	ITunesImage      ITunesImage      `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd image"`
+	ITunesExplicit   string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd explicit"`
+	ITunesCategories []ITunesCategory `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd category"`
+	AtomLinks        []AtomLink       `xml:"http://www.w3.org/2005/Atom link"`
+	Title            string           `xml:"title"`
+	Description      string           `xml:"description"`
+	Link             string           `xml:"link"`
+	Language         string           `xml:"language"`
+	Image            RSSImage         `xml:"image"`
+	Categories       []string         `xml:"category"`
+	Items            []RSSItem        `xml:"item"`
+}
+
+type RSSItem struct {
+	ITunesTitle    string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd title"`
+	ITunesSummary  string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd summary"`
+	ITunesImage    ITunesImage  `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd image"`
+	ITunesDuration string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd duration"`
+	ITunesSeason   string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd season"`
+	ITunesEpisode  string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd episode"`
+	Title          string       `xml:"title"`
+	Description    string       `xml:"description"`
+	Link           string       `xml:"link"`
+	GUID           string       `xml:"guid"`
+	PubDate        string       `xml:"pubDate"`
+	Enclosure      RSSEnclosure `xml:"enclosure"`
+}
+
+type RSSImage struct {
+	URL string `xml:"url"`
+}
+
+// The media file of an RSS item (i.e., the audio of a podcast episode).
+type RSSEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Type   string `xml:"type,attr"`
+	Length string `xml:"length,attr"`
+}
+
+type ITunesImage struct {
+	Href string `xml:"href,attr"`
+}
+
+// An iTunes category, which may hold a subcategory (e.g., 'Society & Culture' holding 'Documentary').
+type ITunesCategory struct {
+	Text          string           `xml:"text,attr"`
+	Subcategories []ITunesCategory `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd category"`
+}
+
+type AtomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Summary    string         `xml:"summary"`
+	Content    string         `xml:"content"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Links      []AtomLink     `xml:"link"`
+	Categories []AtomCategory `xml:"category"`
+}
+
+type AtomPerson struct {
+	Name string `xml:"name"`
+}
+
+// An Atom link, where the relation distinguishes a website ('alternate', or empty) from a media file ('enclosure').
+type AtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type AtomCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
+}
@@ -10,6 +10,7 @@ type IGDBGameResponse struct {
 	InvolvedCompanies []IGDBNestedInvolvedCompany `json:"involved_companies"`
 	Platforms         []IGDBNestedNamedResource   `json:"platforms"`
 	ReleaseDate       int                         `json:"first_release_date"`
+	ReleaseDates      []IGDBNestedReleaseDate     `json:"release_dates"`
 	Cover             IGDBNestedCover             `json:"cover"`
 }
 
@@ -20,6 +21,17 @@ type IGDBGameSearchResponse struct {
 	Cover       IGDBNestedCover `json:"cover"`
 }
 
+type IGDBSimilarGamesResponse struct {
+	ID           int                      `json:"id"`
+	SimilarGames []IGDBGameSearchResponse `json:"similar_games"`
+}
+
+type IGDBExternalGameResponse struct {
+	ID   int    `json:"id"`
+	Game int    `json:"game"`
+	UID  string `json:"uid"`
+}
+
 type IGDBCompanyResponse struct {
 	ID          int    `json:"id"`
 	Name        string `json:"name"`
@@ -35,6 +47,14 @@ type IGDBNestedInvolvedCompany struct {
 	Developer bool `json:"developer"`
 }
 
+// A release of a game on a platform in a region, where the region is an IGDB region enumeration (e.g., 2 for North
+// America) and the date is a Unix timestamp, or absent for an unknown date.
+type IGDBNestedReleaseDate struct {
+	Date     int `json:"date"`
+	Platform int `json:"platform"`
+	Region   int `json:"region"`
+}
+
 type IGDBNestedNamedResource struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
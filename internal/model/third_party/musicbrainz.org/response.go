@@ -0,0 +1,101 @@
+package model
+
+type MBReleaseResponse struct {
+	ID              string            `json:"id"`
+	Title           string            `json:"title"`
+	Status          string            `json:"status"`
+	Date            string            `json:"date"`
+	Country         string            `json:"country"`
+	Barcode         string            `json:"barcode"`
+	ArtistCredit    []MBArtistCredit  `json:"artist-credit"`
+	LabelInfo       []MBLabelInfo     `json:"label-info"`
+	Media           []MBMedium        `json:"media"`
+	ReleaseGroup    MBReleaseGroup    `json:"release-group"`
+	Genres          []MBGenre         `json:"genres"`
+	Tags            []MBTag           `json:"tags"`
+	CoverArtArchive MBCoverArtArchive `json:"cover-art-archive"`
+}
+
+type MBReleaseGroupResponse struct {
+	MBReleaseGroup
+
+	Releases []MBReleaseResponse `json:"releases"`
+}
+
+type MBReleaseSearchResponse struct {
+	Count    int                 `json:"count"`
+	Releases []MBReleaseResponse `json:"releases"`
+}
+
+type MBReleaseGroupSearchResponse struct {
+	Count         int              `json:"count"`
+	ReleaseGroups []MBReleaseGroup `json:"release-groups"`
+}
+
+type MBReleaseGroup struct {
+	ID               string           `json:"id"`
+	Title            string           `json:"title"`
+	PrimaryType      string           `json:"primary-type"`
+	FirstReleaseDate string           `json:"first-release-date"`
+	ArtistCredit     []MBArtistCredit `json:"artist-credit"`
+}
+
+type MBArtistCredit struct {
+	Name       string   `json:"name"`
+	JoinPhrase string   `json:"joinphrase"`
+	Artist     MBArtist `json:"artist"`
+}
+
+type MBArtist struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	SortName string `json:"sort-name"`
+}
+
+type MBLabelInfo struct {
+	CatalogNumber string  `json:"catalog-number"`
+	Label         MBLabel `json:"label"`
+}
+
+type MBLabel struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type MBMedium struct {
+	Position   int       `json:"position"`
+	Format     string    `json:"format"`
+	TrackCount int       `json:"track-count"`
+	Tracks     []MBTrack `json:"tracks"`
+}
+
+type MBTrack struct {
+	ID        string      `json:"id"`
+	Position  int         `json:"position"`
+	Number    string      `json:"number"`
+	Title     string      `json:"title"`
+	Length    int         `json:"length"`
+	Recording MBRecording `json:"recording"`
+}
+
+type MBRecording struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Length int    `json:"length"`
+}
+
+type MBGenre struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type MBTag struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type MBCoverArtArchive struct {
+	Artwork bool `json:"artwork"`
+	Front   bool `json:"front"`
+}
@@ -1,25 +1,40 @@
 package model
 
 type OLAuthorResponse struct {
-	ID        string `json:"key"`
-	Name      string `json:"name"`
-	Biography string `json:"bio"`
-	Images    []int  `json:"photos"`
+	ID             string   `json:"key"`
+	Name           string   `json:"name"`
+	AlternateNames []string `json:"alternate_names"`
+	Biography      string   `json:"bio"`
+	Images         []int    `json:"photos"`
 }
 
 type OLEditionResponse struct {
-	ID          string                `json:"key"`
-	Title       string                `json:"title"`
-	Subtitle    string                `json:"subtitle"`
-	Authors     []OLResourceReference `json:"authors"`
-	Publishers  []string              `json:"publishers"`
-	PublishDate string                `json:"publish_date"`
-	Format      string                `json:"physical_format"`
-	Pages       int                   `json:"number_of_pages"`
-	Images      []int                 `json:"covers"`
-	ISBN10      []string              `json:"isbn_10"`
-	ISBN13      []string              `json:"isbn_13"`
-	Works       []OLResourceReference `json:"works"`
+	ID             string                `json:"key"`
+	Title          string                `json:"title"`
+	Subtitle       string                `json:"subtitle"`
+	Authors        []OLResourceReference `json:"authors"`
+	Publishers     []string              `json:"publishers"`
+	PublishDate    string                `json:"publish_date"`
+	Format         string                `json:"physical_format"`
+	Pages          int                   `json:"number_of_pages"`
+	Pagination     string                `json:"pagination"`
+	ByStatement    string                `json:"by_statement"`
+	Images         []int                 `json:"covers"`
+	ISBN10         []string              `json:"isbn_10"`
+	ISBN13         []string              `json:"isbn_13"`
+	Series         []string              `json:"series"`
+	Works          []OLResourceReference `json:"works"`
+	Contributors   []OLContributor       `json:"contributors"`
+	Contributions  []string              `json:"contributions"`
+	Languages      []OLResourceReference `json:"languages"`
+	TranslatedFrom []OLResourceReference `json:"translated_from"`
+	TranslationOf  string                `json:"translation_of"`
+}
+
+// A contributor to an edition other than its authors, where the role is free text (e.g., "Narrator" or "Translator").
+type OLContributor struct {
+	Role string `json:"role"`
+	Name string `json:"name"`
 }
 
 type OLWorkResponse struct {
@@ -27,6 +42,7 @@ type OLWorkResponse struct {
 	Title       string      `json:"title"`
 	Description interface{} `json:"description"`
 	Subjects    []string    `json:"subjects"`
+	Series      []string    `json:"series"`
 }
 
 type OLBookSearchResponse struct {
@@ -35,10 +51,13 @@ type OLBookSearchResponse struct {
 }
 
 type OLBookSearchResult struct {
-	ID          []string `json:"edition_key"`
-	Title       string   `json:"title"`
-	Authors     []string `json:"author_name"`
-	PublishDate []string `json:"publish_date"`
+	ID           []string `json:"edition_key"`
+	WorkID       string   `json:"key"`
+	EditionCount int      `json:"edition_count"`
+	Title        string   `json:"title"`
+	Authors      []string `json:"author_name"`
+	PublishDate  []string `json:"publish_date"`
+	Languages    []string `json:"language"`
 }
 
 type OLResourceReference struct {
@@ -10,3 +10,52 @@ type TMDBProductionCompany struct {
 	Name  string `json:"name"`
 	Image string `json:"logo_path"`
 }
+
+type TMDBCredits struct {
+	Cast []TMDBCastCredit `json:"cast"`
+	Crew []TMDBCrewCredit `json:"crew"`
+}
+
+type TMDBCastCredit struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Image      string `json:"profile_path"`
+	Department string `json:"known_for_department"`
+	Character  string `json:"character"`
+	Order      int    `json:"order"`
+}
+
+type TMDBCrewCredit struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Image      string `json:"profile_path"`
+	Department string `json:"department"`
+	Job        string `json:"job"`
+}
+
+type TMDBNetwork struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Image string `json:"logo_path"`
+}
+
+type TMDBSeason struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Overview     string `json:"overview"`
+	SeasonNumber int    `json:"season_number"`
+	AirDate      string `json:"air_date"`
+	EpisodeCount int    `json:"episode_count"`
+	Image        string `json:"poster_path"`
+}
+
+type TMDBEpisode struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	Overview      string `json:"overview"`
+	SeasonNumber  int    `json:"season_number"`
+	EpisodeNumber int    `json:"episode_number"`
+	AirDate       string `json:"air_date"`
+	Runtime       int    `json:"runtime"`
+	Image         string `json:"still_path"`
+}
@@ -10,6 +10,7 @@ type TMDBMovieDetailResponse struct {
 	ReleaseDate         string                  `json:"release_date"`
 	Runtime             int                     `json:"runtime"`
 	Image               string                  `json:"poster_path"`
+	Credits             TMDBCredits             `json:"credits"`
 }
 
 type TMDBMovieSearchResponse struct {
@@ -25,3 +26,44 @@ type TMDBMovieSearchResult struct {
 	ReleaseDate string `json:"release_date"`
 	Image       string `json:"poster_path"`
 }
+
+type TMDBShowDetailResponse struct {
+	ID               int           `json:"id"`
+	Name             string        `json:"name"`
+	Tagline          string        `json:"tagline"`
+	Overview         string        `json:"overview"`
+	Genres           []TMDBGenre   `json:"genres"`
+	Networks         []TMDBNetwork `json:"networks"`
+	Seasons          []TMDBSeason  `json:"seasons"`
+	FirstAirDate     string        `json:"first_air_date"`
+	LastAirDate      string        `json:"last_air_date"`
+	Status           string        `json:"status"`
+	NumberOfSeasons  int           `json:"number_of_seasons"`
+	NumberOfEpisodes int           `json:"number_of_episodes"`
+	EpisodeRunTime   []int         `json:"episode_run_time"`
+	Image            string        `json:"poster_path"`
+}
+
+type TMDBSeasonDetailResponse struct {
+	ID           int           `json:"id"`
+	Name         string        `json:"name"`
+	Overview     string        `json:"overview"`
+	SeasonNumber int           `json:"season_number"`
+	AirDate      string        `json:"air_date"`
+	Image        string        `json:"poster_path"`
+	Episodes     []TMDBEpisode `json:"episodes"`
+}
+
+type TMDBShowSearchResponse struct {
+	Page         int                    `json:"page"`
+	Results      []TMDBShowSearchResult `json:"results"`
+	TotalPages   int                    `json:"total_pages"`
+	TotalResults int                    `json:"total_results"`
+}
+
+type TMDBShowSearchResult struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	FirstAirDate string `json:"first_air_date"`
+	Image        string `json:"poster_path"`
+}
@@ -0,0 +1,7 @@
+package model
+
+type UserFragment struct {
+	ID          int    `json:"id"`
+	Reference   string `json:"reference"`
+	DateCreated int64  `json:"date_created"`
+}
@@ -0,0 +1,220 @@
+package personname
+
+import (
+	"strings"
+	"unicode"
+)
+
+// A personal name, split into given (first and middle), family (last), and generational or honorific (suffix) parts.
+type Name struct {
+	First  string
+	Middle string
+	Last   string
+	Suffix string
+}
+
+// Surname particles (compared case-insensitively), which begin a family name when not the first token; e.g., "van" in
+// "Ludwig van Beethoven" and "Le" in "Ursula K. Le Guin".
+var particles = map[string]bool{
+	"al": true, "bin": true, "d'": true, "da": true, "das": true, "de": true, "del": true, "della": true, "den": true,
+	"der": true, "des": true, "di": true, "do": true, "dos": true, "du": true, "el": true, "ibn": true, "la": true,
+	"le": true, "lo": true, "st.": true, "ten": true, "ter": true, "van": true, "von": true, "zu": true,
+}
+
+// Conjunctions which join two family names; e.g., "y" in "José Ortega y Gasset".
+var conjunctions = map[string]bool{
+	"e": true, "i": true, "y": true,
+}
+
+// Suffixes (compared case-insensitively and without periods), which end a name; e.g., "Jr." and "III".
+var suffixes = map[string]bool{
+	"jr": true, "sr": true, "ii": true, "iii": true, "iv": true, "v": true, "phd": true, "md": true, "esq": true,
+}
+
+// Parse a full personal name into its parts, accepting either display order ("Ursula K. Le Guin") or sort order
+// ("Le Guin, Ursula K."), where initials are normalized ("J.R.R." becomes "J. R. R."), a single name (e.g.,
+// "Confucius") is a first name, and a sort-order alternate name of the same tokens (e.g., "García Márquez, Gabriel")
+// determines where the family name begins.
+//
+// Return: parsed name, which is empty when the full name is empty.
+func Parse(full string, alternates ...string) Name {
+	tokens, suffix := tokenize(full)
+
+	if len(tokens) == 0 {
+		return Name{Suffix: suffix}
+	}
+
+	if index := strings.Index(full, ","); index > 0 && !isSuffix(strings.TrimSpace(full[index+1:])) {
+		familyTokens, _ := tokenize(full[:index])
+		givenTokens, suffix := tokenize(full[index+1:])
+
+		return compose(givenTokens, familyTokens, suffix)
+	}
+
+	if len(tokens) == 1 {
+		return Name{First: tokens[0], Suffix: suffix}
+	}
+
+	familyIndex := len(tokens) - 1
+
+	for _, alternate := range alternates {
+		if index, ok := alternateFamilyIndex(tokens, alternate); ok {
+			return compose(tokens[:index], tokens[index:], suffix)
+		}
+	}
+
+	for index := 1; index < len(tokens)-1; index++ {
+		if isParticle(tokens[index]) {
+			familyIndex = index
+
+			break
+		}
+	}
+
+	if familyIndex == len(tokens)-1 && len(tokens) > 2 {
+		previous := tokens[familyIndex-1]
+
+		switch {
+		case conjunctions[strings.ToLower(previous)] && familyIndex > 2:
+			familyIndex -= 2
+		case !isInitial(previous) && isIberian(previous):
+			familyIndex--
+		}
+	}
+
+	return compose(tokens[:familyIndex], tokens[familyIndex:], suffix)
+}
+
+// Format a name in display order; e.g., "Martin Luther King Jr.".
+//
+// Return: display name.
+func (name Name) Display() string {
+	return strings.Join(strings.Fields(strings.Join([]string{name.First, name.Middle, name.Last, name.Suffix}, " ")), " ")
+}
+
+// Format a name in sort order, where a lower-case particle follows the given names and a capitalized particle remains
+// part of the family name; e.g., "Le Guin, Ursula K.", "Beethoven, Ludwig van", and "King, Martin Luther, Jr.".
+//
+// Return: sort name, or the display name of a single name.
+func (name Name) Sort() string {
+	if name.Last == "" {
+		return name.Display()
+	}
+
+	family := name.Last
+	given := strings.Join(strings.Fields(name.First+" "+name.Middle), " ")
+
+	for {
+		particle, rest, found := strings.Cut(family, " ")
+
+		if !found || !isParticle(particle) || particle != strings.ToLower(particle) {
+			break
+		}
+
+		family = rest
+		given = strings.TrimSpace(given + " " + particle)
+	}
+
+	sortName := family
+
+	if given != "" {
+		sortName += ", " + given
+	}
+
+	if name.Suffix != "" {
+		sortName += ", " + name.Suffix
+	}
+
+	return sortName
+}
+
+// Split a name into tokens, separating run-together initials and removing a trailing suffix.
+func tokenize(full string) ([]string, string) {
+	var tokens []string
+
+	for _, field := range strings.Fields(strings.ReplaceAll(full, ",", " ")) {
+		tokens = append(tokens, splitInitials(field)...)
+	}
+
+	if len(tokens) > 1 && isSuffix(tokens[len(tokens)-1]) {
+		return tokens[:len(tokens)-1], tokens[len(tokens)-1]
+	}
+
+	return tokens, ""
+}
+
+// Split run-together initials; e.g., "J.R.R." becomes "J.", "R.", and "R.".
+func splitInitials(field string) []string {
+	parts := strings.SplitAfter(field, ".")
+
+	if len(parts) < 3 {
+		return []string{field}
+	}
+
+	var initials []string
+
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+
+		if !isInitial(part) {
+			return []string{field}
+		}
+
+		initials = append(initials, part)
+	}
+
+	return initials
+}
+
+// Determine the token index where the family name begins from a sort-order alternate name of the same tokens.
+func alternateFamilyIndex(tokens []string, alternate string) (int, bool) {
+	family, given, found := strings.Cut(alternate, ",")
+
+	if !found || isSuffix(strings.TrimSpace(given)) {
+		return 0, false
+	}
+
+	familyTokens, _ := tokenize(family)
+	givenTokens, _ := tokenize(given)
+
+	if len(familyTokens) == 0 || len(givenTokens) == 0 || !strings.EqualFold(strings.Join(append(givenTokens, familyTokens...), " "), strings.Join(tokens, " ")) {
+		return 0, false
+	}
+
+	return len(givenTokens), true
+}
+
+func compose(givenTokens []string, familyTokens []string, suffix string) Name {
+	name := Name{Last: strings.Join(familyTokens, " "), Suffix: suffix}
+
+	if len(givenTokens) > 0 {
+		name.First = givenTokens[0]
+		name.Middle = strings.Join(givenTokens[1:], " ")
+	}
+
+	return name
+}
+
+func isParticle(token string) bool {
+	return particles[strings.ToLower(token)]
+}
+
+func isSuffix(token string) bool {
+	return suffixes[strings.ToLower(strings.ReplaceAll(token, ".", ""))]
+}
+
+// Determine whether a token is an initial; e.g., "K." or "K".
+func isInitial(token string) bool {
+	runes := []rune(token)
+
+	return (len(runes) == 1 && unicode.IsUpper(runes[0])) || (len(runes) == 2 && runes[1] == '.')
+}
+
+// Determine whether a token appears to be an Iberian family name (i.e., it holds an acute accent, tilde, or cedilla, or
+// ends in a patronymic '-ez'), which is the first of a compound family name when it precedes the last token; e.g.,
+// "García" in "Gabriel García Márquez".
+func isIberian(token string) bool {
+	return strings.ContainsAny(token, "áéíóúñãõç") || (len(token) > 3 && strings.HasSuffix(token, "ez"))
+}
@@ -95,8 +95,13 @@ func CreateRequest(method string, path string, body []byte, headers map[string]s
 //
 // Return: response and nil with success, nil and error without.
 func ExecuteRequest(request *http.Request) (*http.Response, error) {
-	client := &http.Client{}
+	return ExecuteClientRequest(&http.Client{}, request)
+}
 
+// Execute an HTTP request on a provided client, which may carry a timeout or transport shared between requests.
+//
+// Return: response and nil with success, nil and error without.
+func ExecuteClientRequest(client *http.Client, request *http.Request) (*http.Response, error) {
 	response, err := client.Do(request)
 
 	if err != nil {
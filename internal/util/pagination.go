@@ -0,0 +1,63 @@
+package util
+
+import (
+	"errors"
+	"strconv"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaximumPageLimit = 100
+)
+
+// A page of a larger slice, which describes the 1-based page number, page size limit, and total item count.
+type Pagination struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+	Total int `json:"total"`
+}
+
+// Parse 1-based page and page size limit query parameter values, where empty values fall back to the first page and
+// the default limit.
+//
+// Return: pagination and nil with success, zero pagination and error without.
+func ParsePagination(pageArg string, limitArg string) (Pagination, error) {
+	pagination := Pagination{Page: 1, Limit: DefaultPageLimit}
+
+	var err error
+
+	if pageArg != "" {
+		pagination.Page, err = strconv.Atoi(pageArg)
+
+		if err != nil || pagination.Page < 1 {
+			return Pagination{}, errors.New("invalid 'page' argument provided")
+		}
+	}
+
+	if limitArg != "" {
+		pagination.Limit, err = strconv.Atoi(limitArg)
+
+		if err != nil || pagination.Limit < 1 || pagination.Limit > MaximumPageLimit {
+			return Pagination{}, errors.New("invalid 'limit' argument provided")
+		}
+	}
+
+	return pagination, nil
+}
+
+// Slice a page from a slice and record the total item count.
+//
+// Return: page slice, which is empty beyond the last page, and updated pagination.
+func Paginate[M interface{}](slice []M, pagination Pagination) ([]M, Pagination) {
+	pagination.Total = len(slice)
+
+	start := (pagination.Page - 1) * pagination.Limit
+
+	if start >= len(slice) {
+		return []M{}, pagination
+	}
+
+	end := min(start+pagination.Limit, len(slice))
+
+	return slice[start:end], pagination
+}
@@ -36,3 +36,15 @@ func FormatPSQLString(value string) string {
 
 	return value
 }
+
+// Format a string value to be matched literally within a PSQL LIKE or ILIKE pattern with escape character '\', by
+// escaping backslashes and the '%' and '_' wildcards before quotes are formatted as with FormatPSQLString.
+//
+// Return: formatted PSQL-safe pattern fragment when an input string is provided, an empty string when one is not.
+func FormatPSQLPattern(value string) string {
+	value = strings.ReplaceAll(value, "\\", "\\\\")
+	value = strings.ReplaceAll(value, "%", "\\%")
+	value = strings.ReplaceAll(value, "_", "\\_")
+
+	return FormatPSQLString(value)
+}
@@ -0,0 +1,231 @@
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/album"
+	"github.com/muzzarellimj/grace-material-api/internal/api/album/helper"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/album"
+	MBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/musicbrainz.org"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+const (
+	releaseId = "0b6b4ba0-d36f-47bd-b4ea-6a5b91842d29"
+	groupId   = "b1392450-e666-3926-a536-22c65f834433"
+	artistId  = "a74b1b7f-71a5-4011-9441-d0b5e4122711"
+)
+
+type fakeProvider struct{}
+
+func (provider fakeProvider) MBGetRelease(id string) (MBModel.MBReleaseResponse, error) {
+	return MBModel.MBReleaseResponse{
+		ID:      releaseId,
+		Title:   "OK Computer",
+		Status:  "Official",
+		Date:    "1997-06-16",
+		Country: "GB",
+		Barcode: "724385522925",
+		ArtistCredit: []MBModel.MBArtistCredit{
+			{Name: "Radiohead", Artist: MBModel.MBArtist{ID: artistId, Name: "Radiohead", SortName: "Radiohead"}},
+		},
+		Media: []MBModel.MBMedium{
+			{Position: 1, Format: "CD", TrackCount: 2, Tracks: []MBModel.MBTrack{
+				{ID: "track-1", Position: 1, Number: "1", Title: "Airbag", Length: 284000},
+				{ID: "track-2", Position: 2, Number: "2", Title: "Paranoid Android", Recording: MBModel.MBRecording{Length: 383000}},
+			}},
+		},
+		ReleaseGroup:    MBModel.MBReleaseGroup{ID: groupId},
+		CoverArtArchive: MBModel.MBCoverArtArchive{Artwork: true, Front: true},
+	}, nil
+}
+
+func (provider fakeProvider) MBGetReleaseGroup(id string) (MBModel.MBReleaseGroupResponse, error) {
+	return MBModel.MBReleaseGroupResponse{}, nil
+}
+
+func (provider fakeProvider) MBSearchReleaseGroup(query string) (MBModel.MBReleaseGroupSearchResponse, error) {
+	return MBModel.MBReleaseGroupSearchResponse{}, nil
+}
+
+func (provider fakeProvider) MBSearchBarcode(barcode string) (MBModel.MBReleaseSearchResponse, error) {
+	return MBModel.MBReleaseSearchResponse{}, nil
+}
+
+func (provider fakeProvider) CAAFrontCover(id string) string {
+	return fmt.Sprintf("https://coverartarchive.org/release/%s/front", id)
+}
+
+func TestHandlePostAlbumStoresArtistsAndTracks(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT * FROM albums WHERE reference='%s'", releaseId))).
+		WillReturnRows(pgxmock.NewRows(albumColumns))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO albums (title,artist_credit,release_date,country,format,discs,tracks,runtime,barcode,image,reference,group_reference)")).
+		WithArgs("OK Computer", "Radiohead", int64(866419200), "GB", "CD", 1, 2, 11, "724385522925", fmt.Sprintf("https://coverartarchive.org/release/%s/front", releaseId), releaseId, groupId).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT * FROM artists WHERE reference='%s'", artistId))).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "sort_name", "reference"}))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO artists (name,sort_name,reference)")).
+		WithArgs("Radiohead", "Radiohead", artistId).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO albums_artists (album,artist,position)")).
+		WithArgs(1, 1, 0).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO tracks (album,disc,position,number,title,length,reference)")).
+		WithArgs(1, 1, 1, "1", "Airbag", 284, "track-1").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO tracks (album,disc,position,number,title,length,reference)")).
+		WithArgs(1, 1, 2, "2", "Paranoid Android", 383, "track-2").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostAlbum, fmt.Sprintf("/api/album?id=%s", releaseId))
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostAlbumRejectsInvalidIdentifier(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostAlbum, "/api/album?id=1")
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlePostAlbumScanReturnsExistingAlbum(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM albums WHERE barcode='724385522925'")).
+		WillReturnRows(pgxmock.NewRows(albumColumns).
+			AddRow(1, "OK Computer", "Radiohead", int64(866419200), "GB", "CD", 1, 12, 53, "724385522925", "", releaseId, groupId))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostAlbumScan, "/api/album/scan?barcode=7-24385-52292-5")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+}
+
+func TestHandlePostAlbumScanRejectsInvalidBarcode(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostAlbumScan, "/api/album/scan?barcode=724385522926")
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleGetAlbumReturnsTracksInOrder(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM albums WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows(albumColumns).
+			AddRow(1, "OK Computer", "Radiohead", int64(866419200), "GB", "2×CD", 2, 3, 16, "724385522925", "", releaseId, groupId))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM albums_artists WHERE album=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"album", "artist", "position"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM albums_labels WHERE album=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"album", "label", "catalog_number"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM albums_genres WHERE album=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"album", "genre"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM tracks WHERE album=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "album", "disc", "position", "number", "title", "length", "reference"}).
+			AddRow(3, 1, 2, 1, "1", "Lull", 119, "").
+			AddRow(2, 1, 1, 2, "2", "Paranoid Android", 383, "").
+			AddRow(1, 1, 1, 1, "1", "Airbag", 284, ""))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, handler.HandleGetAlbum, "/api/album?id=1")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data []model.Album `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	tracks := response.Data[0].Tracks
+
+	if len(tracks) != 3 || tracks[0].Title != "Airbag" || tracks[1].Title != "Paranoid Android" || tracks[2].Title != "Lull" {
+		t.Fatalf("Actual tracks '%+v' do not match expected tracks in disc and position order.", tracks)
+	}
+}
+
+var albumColumns = []string{"id", "title", "artist_credit", "release_date", "country", "format", "discs", "tracks", "runtime", "barcode", "image", "reference", "group_reference"}
+
+func createHandler(mock pgxmock.PgxPoolIface) *api.Handler {
+	return api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+}
+
+func serve(method string, handle gin.HandlerFunc, target string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(method, target, nil)
+
+	handle(context)
+
+	context.Writer.WriteHeaderNow()
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
@@ -0,0 +1,96 @@
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/muzzarellimj/grace-material-api/internal/api/album/helper"
+	MBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/musicbrainz.org"
+)
+
+func TestCleanBarcodeReturnsUPC(t *testing.T) {
+	expected := "724385522925"
+	actual, ok := helper.CleanBarcode("7 24385 52292 5")
+
+	if !ok || actual != expected {
+		t.Fatalf("Actual barcode '%s' does not match expected barcode '%s'.", actual, expected)
+	}
+}
+
+func TestCleanBarcodeReturnsEAN(t *testing.T) {
+	expected := "5099902895529"
+	actual, ok := helper.CleanBarcode("5099902895529")
+
+	if !ok || actual != expected {
+		t.Fatalf("Actual barcode '%s' does not match expected barcode '%s'.", actual, expected)
+	}
+}
+
+func TestCleanBarcodeRejectsCheckDigit(t *testing.T) {
+	actual, ok := helper.CleanBarcode("5099902895528")
+
+	if ok || actual != "" {
+		t.Fatalf("Actual barcode '%s' does not match expected empty barcode.", actual)
+	}
+}
+
+func TestFormatArtistCreditJoinsNames(t *testing.T) {
+	credits := []MBModel.MBArtistCredit{
+		{Name: "Simon", JoinPhrase: " & "},
+		{Name: "Garfunkel"},
+	}
+
+	expected := "Simon & Garfunkel"
+	actual := helper.FormatArtistCredit(credits)
+
+	if actual != expected {
+		t.Fatalf("Actual artist credit '%s' does not match expected artist credit '%s'.", actual, expected)
+	}
+}
+
+func TestFormatMediaCountsFormats(t *testing.T) {
+	media := []MBModel.MBMedium{{Format: "CD"}, {Format: "CD"}, {Format: "DVD-Video"}}
+
+	expected := "2×CD + DVD-Video"
+	actual := helper.FormatMedia(media)
+
+	if actual != expected {
+		t.Fatalf("Actual format '%s' does not match expected format '%s'.", actual, expected)
+	}
+}
+
+func TestParseReleaseDateReturnsPartialDate(t *testing.T) {
+	var expected int64 = 852076800
+	actual := helper.ParseReleaseDate("1997")
+
+	if actual != expected {
+		t.Fatalf("Actual release date '%d' does not match expected release date '%d'.", actual, expected)
+	}
+}
+
+func TestSelectReleasePrefersEarliestOfficialRelease(t *testing.T) {
+	releases := []MBModel.MBReleaseResponse{
+		{ID: "bootleg", Status: "Bootleg", Date: "1996"},
+		{ID: "undated", Status: "Official"},
+		{ID: "reissue", Status: "Official", Date: "2009-03-24"},
+		{ID: "original", Status: "Official", Date: "1997-06-16"},
+	}
+
+	expected := "original"
+	actual := helper.SelectRelease(releases)
+
+	if actual != expected {
+		t.Fatalf("Actual release '%s' does not match expected release '%s'.", actual, expected)
+	}
+}
+
+func TestExtractGenreSliceFallsBackToTags(t *testing.T) {
+	release := MBModel.MBReleaseResponse{
+		Tags: []MBModel.MBTag{{Name: "Britpop", Count: 1}, {Name: "Alternative Rock", Count: 4}, {Name: "seen live", Count: 0}},
+	}
+
+	actual := helper.ExtractGenreSlice(release)
+
+	if len(actual) != 2 || actual[0] != "alternative rock" || actual[1] != "britpop" {
+		t.Fatalf("Actual genres '%v' do not match expected genres from tags.", actual)
+	}
+}
@@ -0,0 +1,192 @@
+package api_test
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/boardgame"
+	"github.com/muzzarellimj/grace-material-api/internal/api/boardgame/helper"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/boardgame"
+	BGGModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/boardgamegeek.com"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+type fakeProvider struct{}
+
+func (provider fakeProvider) BGGGetBoardGame(id string) (BGGModel.BGGThing, error) {
+	return BGGModel.BGGThing{
+		ID:            13,
+		Names:         []BGGModel.BGGName{{Type: "alternate", Value: "Die Siedler von Catan"}, {Type: "primary", Value: "CATAN"}},
+		Description:   "Trade &amp; build.&#10;",
+		YearPublished: BGGModel.BGGValue{Value: 1995},
+		MinPlayers:    BGGModel.BGGValue{Value: 3},
+		MaxPlayers:    BGGModel.BGGValue{Value: 4},
+		PlayingTime:   BGGModel.BGGValue{Value: 120},
+		MinPlayTime:   BGGModel.BGGValue{Value: 60},
+		MaxPlayTime:   BGGModel.BGGValue{Value: 120},
+		MinAge:        BGGModel.BGGValue{Value: 10},
+		Image:         "https://cf.geekdo-images.com/original.jpg",
+		Links: []BGGModel.BGGLink{
+			{Type: "boardgamedesigner", ID: 11, Value: "Klaus Teuber"},
+			{Type: "boardgamepublisher", ID: 3, Value: "(Unknown)"},
+			{Type: "boardgamemechanic", ID: 2072, Value: "Dice Rolling"},
+		},
+	}, nil
+}
+
+func (provider fakeProvider) BGGSearchBoardGame(query string) (BGGModel.BGGSearchResponse, error) {
+	return BGGModel.BGGSearchResponse{
+		Total: 1,
+		Items: []BGGModel.BGGSearchItem{{ID: 13, Type: "boardgame", Name: BGGModel.BGGName{Type: "primary", Value: "CATAN"}, YearPublished: BGGModel.BGGValue{Value: 1995}}},
+	}, nil
+}
+
+func TestHandlePostBoardGameStoresLinks(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM boardgames WHERE reference=13")).
+		WillReturnRows(pgxmock.NewRows(boardGameColumns))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO boardgames (title,description,release_date,min_players,max_players,playing_time,min_play_time,max_play_time,min_age,image,reference)")).
+		WithArgs("CATAN", "Trade & build.", int64(788918400), 3, 4, 120, 60, 120, 10, "https://cf.geekdo-images.com/original.jpg", 13).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM designers WHERE reference=11")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "reference"}))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO designers (name,reference)")).
+		WithArgs("Klaus Teuber", 11).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO boardgames_designers (boardgame,designer)")).
+		WithArgs(1, 1).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM mechanics WHERE reference=2072")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "reference"}).AddRow(4, "Dice Rolling", 2072))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO boardgames_mechanics (boardgame,mechanic)")).
+		WithArgs(1, 4).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostBoardGame, "/api/boardgame?id=13")
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleGetBoardGameReturnsLinks(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM boardgames WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows(boardGameColumns).
+			AddRow(1, "CATAN", "", int64(788918400), 3, 4, 120, 60, 120, 10, "", 13))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT f.* FROM designers f JOIN boardgames_designers r ON r.designer = f.id WHERE r.boardgame=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "reference"}).AddRow(1, "Klaus Teuber", 11))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT f.* FROM bpublishers f JOIN boardgames_publishers r ON r.publisher = f.id WHERE r.boardgame=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "reference"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT f.* FROM mechanics f JOIN boardgames_mechanics r ON r.mechanic = f.id WHERE r.boardgame=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "reference"}).AddRow(4, "Dice Rolling", 2072))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT f.* FROM bcategories f JOIN boardgames_categories r ON r.category = f.id WHERE r.boardgame=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "reference"}))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, handler.HandleGetBoardGame, "/api/boardgame?id=1")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data []model.BoardGame `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	boardGame := response.Data[0]
+
+	if len(boardGame.Designers) != 1 || boardGame.Designers[0].Name != "Klaus Teuber" || len(boardGame.Mechanics) != 1 || boardGame.Publishers == nil || len(boardGame.Publishers) != 0 {
+		t.Fatalf("Actual board game '%+v' does not match expected board game with links.", boardGame)
+	}
+}
+
+func TestHandleGetBoardGameSearchReturnsResults(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, handler.HandleGetBoardGameSearch, "/api/boardgame/search?query=catan")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data []model.BoardGameSearchResult `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	if len(response.Data) != 1 || response.Data[0].Title != "CATAN" || response.Data[0].ReleaseDate != 788918400 {
+		t.Fatalf("Actual search results '%+v' do not match expected search results.", response.Data)
+	}
+}
+
+var boardGameColumns = []string{"id", "title", "description", "release_date", "min_players", "max_players", "playing_time", "min_play_time", "max_play_time", "min_age", "image", "reference"}
+
+func createHandler(mock pgxmock.PgxPoolIface) *api.Handler {
+	return api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+}
+
+func serve(method string, handle gin.HandlerFunc, target string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(method, target, nil)
+
+	handle(context)
+
+	context.Writer.WriteHeaderNow()
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
@@ -0,0 +1,449 @@
+package api_test
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/book"
+	"github.com/muzzarellimj/grace-material-api/internal/api/book/helper"
+	OLModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/openlibrary.org"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+type fakeProvider struct {
+	search   OLModel.OLBookSearchResponse
+	language string
+}
+
+func (provider fakeProvider) OLGetAuthor(id string) (OLModel.OLAuthorResponse, error) {
+	return OLModel.OLAuthorResponse{}, nil
+}
+
+func (provider fakeProvider) OLGetEdition(id string) (OLModel.OLEditionResponse, error) {
+	return OLModel.OLEditionResponse{}, nil
+}
+
+func (provider fakeProvider) OLGetWork(id string) (OLModel.OLWorkResponse, error) {
+	return OLModel.OLWorkResponse{}, nil
+}
+
+func (provider fakeProvider) OLSearchBook(query string, language string) (OLModel.OLBookSearchResponse, error) {
+	if language != provider.language {
+		return OLModel.OLBookSearchResponse{}, nil
+	}
+
+	return provider.search, nil
+}
+
+func TestHandleGetBookReturnsStatusOk(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectBook(mock, "id=1")
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetBook, http.MethodGet, "/api/book?id=1")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleGetBookReturnsRating(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectBook(mock, "id=1")
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(rating) AS count, COALESCE(AVG(rating), 0)::FLOAT8 AS average FROM books_reviews WHERE book=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"count", "average"}).AddRow(2, 8.5))
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetBook, http.MethodGet, "/api/book?id=1&include=rating")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	if !strings.Contains(recorder.Body.String(), `"average": 8.5`) {
+		t.Fatalf("Actual response '%s' does not contain expected rating aggregate.", recorder.Body.String())
+	}
+}
+
+func TestHandleGetBookHandlesEmptyIdArg(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetBook, http.MethodGet, "/api/book")
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlePostBookReturnsExistingBook(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectBook(mock, "isbn13='9780316452465' OR isbn10='0316452467'")
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandlePostBook, http.MethodPost, "/api/book?id=978-0316452465")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+}
+
+func TestHandlePostBookHandlesInvalidISBN(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandlePostBook, http.MethodPost, "/api/book?id=978-0316452466")
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostBookScanReturnsExistingBook(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectBook(mock, "isbn13='9780316452465' OR isbn10='0316452467'")
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandlePostBookScan, http.MethodPost, "/api/book/scan?barcode=978031645246551599")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	if !strings.Contains(recorder.Body.String(), `"isbn10": "0316452467"`) {
+		t.Fatalf("Actual response '%s' does not contain expected ISBN-10.", recorder.Body.String())
+	}
+}
+
+func TestHandleGetBookSearchReturnsStatusOk(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	provider := fakeProvider{
+		search: OLModel.OLBookSearchResponse{
+			Results: []OLModel.OLBookSearchResult{
+				{ID: []string{"OL37765857M"}, Title: "The Last Wish", Authors: []string{"Andrzej Sapkowski"}, PublishDate: []string{"2022-11-10"}},
+			},
+		},
+	}
+
+	handler := api.NewHandler(helper.NewRepository(mock, provider, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetBookSearch, http.MethodGet, "/api/book/search?query=last%20wish")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+}
+
+func TestHandleGetBookSearchReturnsStatusNoContent(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetBookSearch, http.MethodGet, "/api/book/search?query=last%20wish")
+
+	if recorder.Code != http.StatusNoContent {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusNoContent)
+	}
+}
+
+func TestHandleGetBookSearchFiltersLanguage(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	provider := fakeProvider{
+		search: OLModel.OLBookSearchResponse{
+			Results: []OLModel.OLBookSearchResult{
+				{ID: []string{"OL25418914M"}, Title: "Ostatnie życzenie", Authors: []string{"Andrzej Sapkowski"}, PublishDate: []string{"2014-01-01"}, Languages: []string{"pol"}},
+			},
+		},
+		language: "pol",
+	}
+
+	handler := api.NewHandler(helper.NewRepository(mock, provider, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetBookSearch, http.MethodGet, "/api/book/search?query=ostatnie&language=POL")
+
+	if recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), `"pol"`) {
+		t.Fatalf("Actual response '%s' does not contain expected search result in language 'pol'.", recorder.Body.String())
+	}
+}
+
+func TestHandleGetBookSearchHandlesInvalidLanguage(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetBookSearch, http.MethodGet, "/api/book/search?query=last%20wish&language=english")
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleGetBookSearchCollapsesEditionsOfWork(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	provider := fakeProvider{
+		search: OLModel.OLBookSearchResponse{
+			Results: []OLModel.OLBookSearchResult{
+				{ID: []string{"OL37765857M"}, WorkID: "/works/OL2577482W", EditionCount: 3, Title: "The Last Wish", Authors: []string{"Andrzej Sapkowski"}, PublishDate: []string{"2022-11-10"}},
+				{ID: []string{"OL10426195M"}, WorkID: "/works/OL2577482W", EditionCount: 1, Title: "The Last Wish", Authors: []string{"Andrzej Sapkowski"}, PublishDate: []string{"2008-05-01"}},
+			},
+		},
+	}
+
+	handler := api.NewHandler(helper.NewRepository(mock, provider, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetBookSearch, http.MethodGet, "/api/book/search?query=last%20wish")
+
+	if strings.Count(recorder.Body.String(), `"work": "OL2577482W"`) != 1 || !strings.Contains(recorder.Body.String(), `"editions": 4`) {
+		t.Fatalf("Actual response '%s' does not contain expected collapsed work.", recorder.Body.String())
+	}
+}
+
+func TestHandleGetWorkReturnsEditions(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM works WHERE reference='OL2577482W'")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "title", "description", "reference"}).AddRow(1, "The Last Wish", "Geralt of Rivia is a witcher.", "OL2577482W"))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM works_topics WHERE work=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"work", "topic"}).AddRow(1, 1))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM topics WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name"}).AddRow(1, "Fantasy"))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM books WHERE work_reference='OL2577482W'")).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+
+	expectBook(mock, "id=1")
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetWork, http.MethodGet, "/api/book/work?id=OL2577482W")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	if !strings.Contains(recorder.Body.String(), `"name": "Fantasy"`) || !strings.Contains(recorder.Body.String(), `"edition_reference": "OL37765857M"`) {
+		t.Fatalf("Actual response '%s' does not contain expected work topic and edition.", recorder.Body.String())
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleGetWorkHandlesOwnedWithoutPrincipal(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetWork, http.MethodGet, "/api/book/work?id=1&owned=true")
+
+	if recorder.Code != http.StatusUnauthorized {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestHandleGetAuthorSliceOrdersBySortName(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	columns := []string{"id", "first_name", "middle_name", "last_name", "display_name", "sort_name", "alternate_names", "biography", "image", "reference"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM authors WHERE display_name ILIKE '%u%' ESCAPE '\' OR EXISTS (SELECT 1 FROM UNNEST(alternate_names) a WHERE a ILIKE '%u%' ESCAPE '\')`)).
+		WillReturnRows(pgxmock.NewRows(columns).
+			AddRow(1, "Andrzej", "", "Sapkowski", "Andrzej Sapkowski", "Sapkowski, Andrzej", []string{}, "", "", "OL368638A").
+			AddRow(2, "Ursula", "K.", "Le Guin", "Ursula K. Le Guin", "Le Guin, Ursula K.", []string{"Ursula Kroeber Le Guin"}, "", "", "OL31353A"))
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetAuthorSlice, http.MethodGet, "/api/book/author?query=u")
+
+	body := recorder.Body.String()
+
+	if recorder.Code != http.StatusOK || strings.Index(body, "Le Guin, Ursula K.") > strings.Index(body, "Sapkowski, Andrzej") {
+		t.Fatalf("Actual response '%s' does not contain authors ordered by sort name.", body)
+	}
+}
+
+func TestHandleGetAuthorSliceEscapesWildcards(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	columns := []string{"id", "first_name", "middle_name", "last_name", "display_name", "sort_name", "alternate_names", "biography", "image", "reference"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM authors WHERE display_name ILIKE '%\%\_''%' ESCAPE '\' OR EXISTS (SELECT 1 FROM UNNEST(alternate_names) a WHERE a ILIKE '%\%\_''%' ESCAPE '\')`)).
+		WillReturnRows(pgxmock.NewRows(columns))
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	serve(handler.HandleGetAuthorSlice, http.MethodGet, "/api/book/author?query=%25_'")
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleGetSeriesReturnsReadingOrder(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM series WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name"}).AddRow(1, "The Witcher"))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM books_series WHERE series=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"book", "series", "position"}).AddRow(1, 1, float64(1)))
+
+	expectBook(mock, "id=1")
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serveRoute(handler.HandleGetSeries, http.MethodGet, "/api/series/:id", "/api/series/1")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	if !strings.Contains(recorder.Body.String(), `"position": 1`) || !strings.Contains(recorder.Body.String(), `"title": "The Last Wish"`) {
+		t.Fatalf("Actual response '%s' does not contain expected series book.", recorder.Body.String())
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostBookSeriesHandlesInvalidSeries(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	gin.SetMode(gin.TestMode)
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(http.MethodPost, "/api/book/series?id=1", strings.NewReader(`{ "name": " ", "position": 1 }`))
+
+	handler.HandlePostBookSeries(context)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func expectBook(mock pgxmock.PgxPoolIface, constraint string) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM books WHERE " + constraint)).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "title", "subtitle", "publish_date", "pages", "format", "duration", "languages", "original_language", "translation_of", "isbn10", "isbn13", "image", "edition_reference", "work_reference"}).
+			AddRow(1, "The Last Wish", "", int64(0), 384, "paperback", 0, []string{"eng"}, "pol", "Ostatnie życzenie", "0316452467", "9780316452465", "", "OL37765857M", "OL2577482W"))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM books_authors WHERE book=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"book", "author"}))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM books_narrators WHERE book=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"book", "narrator"}))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM books_publishers WHERE book=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"book", "publisher"}))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM books_topics WHERE book=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"book", "topic"}))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM books_series WHERE book=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"book", "series", "position"}).AddRow(1, 1, float64(1)))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM series WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name"}).AddRow(1, "The Witcher"))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM works WHERE reference='OL2577482W'")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "title", "description", "reference"}).AddRow(1, "The Last Wish", "Geralt of Rivia is a witcher.", "OL2577482W"))
+}
+
+func serve(handle gin.HandlerFunc, method string, target string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(method, target, nil)
+
+	handle(context)
+
+	context.Writer.WriteHeaderNow()
+
+	return recorder
+}
+
+func serveRoute(handle gin.HandlerFunc, method string, route string, target string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Handle(method, route, handle)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(method, target, nil))
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
@@ -1,9 +1,13 @@
 package helper_test
 
 import (
+	"slices"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/muzzarellimj/grace-material-api/internal/api/book/helper"
+	OLModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/openlibrary.org"
 )
 
 func TestFormatISBNReturnsISBN10(t *testing.T) {
@@ -130,6 +134,22 @@ func TestExtractNameReturnsEmpty(t *testing.T) {
 	}
 }
 
+func TestExtractNameKeepsCompoundLastName(t *testing.T) {
+	actualFirst, actualMiddle, actualLast := helper.ExtractName("Ursula K. Le Guin")
+
+	if actualFirst != "Ursula" || actualMiddle != "K." || actualLast != "Le Guin" {
+		t.Fatalf("Actual first name '%s', middle name '%s', last name '%s' do not match expected name 'Ursula K. Le Guin'.", actualFirst, actualMiddle, actualLast)
+	}
+}
+
+func TestExtractAlternateNamesRemovesDuplicates(t *testing.T) {
+	actual := helper.ExtractAlternateNames("Andrzej Sapkowski", []string{"andrzej sapkowski", "Анджей Сапковский", " Анджей  Сапковский ", ""})
+
+	if len(actual) != 1 || actual[0] != "Анджей Сапковский" {
+		t.Fatalf("Actual alternate names '%v' do not match expected alternate names.", actual)
+	}
+}
+
 func TestExtractResourceIdReturnsResourceId(t *testing.T) {
 	key := "/books/OL0M"
 
@@ -148,3 +168,152 @@ func TestExtractResourceIdReturnsEmptyString(t *testing.T) {
 		t.Fatalf("Actual resource identifier '%s' does not match expected empty resource identifier.", actual)
 	}
 }
+
+func TestExtractSeriesReturnsNameAndPosition(t *testing.T) {
+	for statement, expected := range map[string]float64{
+		"The Expanse ; 1":       1,
+		"The Expanse #2.5":      2.5,
+		"(The Expanse, book 3)": 3,
+		"The Expanse, v. 4":     4,
+		"The Expanse":           0,
+		"The Expanse (5)":       5,
+	} {
+		name, position := helper.ExtractSeries(statement)
+
+		if position != expected || name != "The Expanse" {
+			t.Fatalf("Actual series '%s' and position '%v' of '%s' do not match expected series 'The Expanse' and position '%v'.", name, position, statement, expected)
+		}
+	}
+}
+
+func TestExtractSeriesIgnoresTrailingNumberWithoutSeparator(t *testing.T) {
+	name, position := helper.ExtractSeries("Catch-22")
+
+	if name != "Catch-22" || position != 0 {
+		t.Fatalf("Actual series '%s' and position '%v' do not match expected series 'Catch-22' and position '0'.", name, position)
+	}
+}
+
+func TestExtractFormatReturnsAudiobook(t *testing.T) {
+	for _, edition := range []OLModel.OLEditionResponse{{Format: "Audio CD"}, {Format: "MP3 CD"}, {Pagination: "10 sound discs (12 hr., 30 min.)"}} {
+		actual := helper.ExtractFormat(edition)
+
+		if actual != helper.FormatAudiobook {
+			t.Fatalf("Actual format '%s' of edition '%+v' does not match expected format '%s'.", actual, edition, helper.FormatAudiobook)
+		}
+	}
+}
+
+func TestExtractFormatNormalizesFormat(t *testing.T) {
+	expected := map[string]string{
+		" Mass Market  Paperback": helper.FormatPaperback,
+		"Softcover":               helper.FormatPaperback,
+		"Hardback":                helper.FormatHardcover,
+		"Library Binding":         helper.FormatHardcover,
+		"E-book":                  helper.FormatEbook,
+		"Kindle Edition":          helper.FormatEbook,
+		"Board Book":              "board book",
+		"":                        "",
+	}
+
+	for format, expectedFormat := range expected {
+		actual := helper.ExtractFormat(OLModel.OLEditionResponse{Format: format, Pagination: "xii, 384 p."})
+
+		if actual != expectedFormat {
+			t.Fatalf("Actual format '%s' of '%s' does not match expected format '%s'.", actual, format, expectedFormat)
+		}
+	}
+}
+
+func TestExtractFormatTruncatesUnexpectedFormat(t *testing.T) {
+	format := strings.Repeat("folded broadside ", 8)
+	actual := helper.ExtractFormat(OLModel.OLEditionResponse{Format: format})
+
+	if utf8.RuneCountInString(actual) > 64 || !strings.HasPrefix(actual, "folded broadside") {
+		t.Fatalf("Actual format '%s' is not truncated to the maximum format length.", actual)
+	}
+}
+
+func TestExtractLanguagesIgnoresUnexpectedReference(t *testing.T) {
+	expected := []string{"eng"}
+	actual := helper.ExtractLanguages([]OLModel.OLResourceReference{{ID: "/languages/eng"}, {ID: "/languages/english"}, {ID: "/languages/EN"}})
+
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("Actual languages '%v' do not match expected languages '%v'.", actual, expected)
+	}
+
+	if actual := helper.ExtractOriginalLanguage(OLModel.OLEditionResponse{TranslatedFrom: []OLModel.OLResourceReference{{ID: "/languages/polish"}}}); actual != "" {
+		t.Fatalf("Actual original language '%s' does not match expected empty original language.", actual)
+	}
+}
+
+func TestExtractLanguagesRemovesDuplicates(t *testing.T) {
+	expected := []string{"eng", "pol"}
+	actual := helper.ExtractLanguages([]OLModel.OLResourceReference{{ID: "/languages/eng"}, {ID: "/languages/pol"}, {ID: "/languages/eng"}, {}})
+
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("Actual languages '%v' do not match expected languages '%v'.", actual, expected)
+	}
+}
+
+func TestExtractOriginalLanguageReturnsTranslatedFrom(t *testing.T) {
+	expected := "pol"
+	actual := helper.ExtractOriginalLanguage(OLModel.OLEditionResponse{TranslatedFrom: []OLModel.OLResourceReference{{ID: "/languages/pol"}}})
+
+	if actual != expected {
+		t.Fatalf("Actual original language '%s' does not match expected original language '%s'.", actual, expected)
+	}
+
+	if actual := helper.ExtractOriginalLanguage(OLModel.OLEditionResponse{}); actual != "" {
+		t.Fatalf("Actual original language '%s' does not match expected empty original language.", actual)
+	}
+}
+
+func TestIsLanguageValidatesLanguage(t *testing.T) {
+	if !helper.IsLanguage("eng") || helper.IsLanguage("english") || helper.IsLanguage("EN") || helper.IsLanguage("") {
+		t.Fatal("Actual language validations do not match expected language validations.")
+	}
+}
+
+func TestExtractDurationReturnsMinutes(t *testing.T) {
+	expected := 750
+
+	for _, statement := range []string{"10 CDs (12 hr., 30 min.)", "12 hours 30 minutes", "12:30:00", "12.5 hours"} {
+		actual := helper.ExtractDuration(statement)
+
+		if actual != expected {
+			t.Fatalf("Actual duration '%d' of '%s' does not match expected duration '%d'.", actual, statement, expected)
+		}
+	}
+}
+
+func TestExtractDurationReturnsZero(t *testing.T) {
+	actual := helper.ExtractDuration("10 sound discs")
+
+	if actual != 0 {
+		t.Fatalf("Actual duration '%d' does not match expected zero duration.", actual)
+	}
+}
+
+func TestExtractNarratorsReturnsContributors(t *testing.T) {
+	edition := OLModel.OLEditionResponse{
+		Contributors:  []OLModel.OLContributor{{Role: "Narrator", Name: "Peter Kenny"}, {Role: "Translator", Name: "Danusia Stok"}},
+		Contributions: []string{"Jane Doe (Reader)", "peter kenny (Narrator)"},
+	}
+
+	expected := []string{"Peter Kenny", "Jane Doe"}
+	actual := helper.ExtractNarrators(edition)
+
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("Actual narrators '%v' do not match expected narrators '%v'.", actual, expected)
+	}
+}
+
+func TestExtractNarratorsReturnsByStatement(t *testing.T) {
+	expected := []string{"Peter Kenny", "Jane Doe"}
+	actual := helper.ExtractNarrators(OLModel.OLEditionResponse{ByStatement: "Andrzej Sapkowski ; read by Peter Kenny and Jane Doe."})
+
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("Actual narrators '%v' do not match expected narrators '%v'.", actual, expected)
+	}
+}
@@ -0,0 +1,452 @@
+package api_test
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgconn"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/collection"
+	"github.com/muzzarellimj/grace-material-api/internal/api/collection/helper"
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/auth"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+func TestHandleGetCollectionReturnsStatusOk(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectCollection(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT m.id, m.title, m.image, r.date_added FROM collections_books r JOIN books m ON m.id = r.book WHERE r.collection=1")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "title", "image", "date_added"}).
+			AddRow(1, "The Last Wish", "", int64(1700000000)))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, "/api/collection", "/api/collection", handler.HandleGetCollection)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleGetCollectionResolvesConcurrentlyStoredUserAndCollection(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM users WHERE reference='default'")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "reference", "date_created"}))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO users (reference,date_created)")).
+		WithArgs("default", pgxmock.AnyArg()).
+		WillReturnError(&pgconn.PgError{Code: "23505"})
+	mock.ExpectRollback()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM users WHERE reference='default'")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "reference", "date_created"}).
+			AddRow(1, "default", int64(0)))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM collections WHERE owner=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "owner", "name", "date_created"}))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO collections (owner,name,date_created)")).
+		WithArgs(1, "Collection", pgxmock.AnyArg()).
+		WillReturnError(&pgconn.PgError{Code: "23505"})
+	mock.ExpectRollback()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM collections WHERE owner=1")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "owner", "name", "date_created"}).
+			AddRow(1, 1, "Collection", int64(0)))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT m.id, m.title, m.image, r.date_added FROM collections_books r JOIN books m ON m.id = r.book WHERE r.collection=1")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "title", "image", "date_added"}).
+			AddRow(1, "The Last Wish", "", int64(1700000000)))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, "/api/collection", "/api/collection", handler.HandleGetCollection)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleGetCollectionReturnsGamePlatform(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	platform := 2
+
+	expectCollection(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT m.id, m.title, m.image, r.date_added, r.platform FROM collections_games r JOIN games m ON m.id = r.game WHERE r.collection=1")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "title", "image", "date_added", "platform"}).
+			AddRow(1, "Super Smash Bros.", "", int64(1700000000), &platform))
+
+	handler := createHandler(mock, "game")
+	recorder := serve(http.MethodGet, "/api/collection", "/api/collection", handler.HandleGetCollection)
+
+	if recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), `"platform": 2`) {
+		t.Fatalf("Actual response '%s' does not contain expected game platform.", recorder.Body.String())
+	}
+}
+
+func TestHandleGetCollectionReturnsOnlyShows(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectCollection(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT m.id, m.title, m.image, r.date_added FROM collections_books r JOIN books m ON m.id = r.book WHERE r.collection=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "title", "image", "date_added"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT m.id, m.title, m.image, r.date_added FROM collections_shows r JOIN shows m ON m.id = r.show WHERE r.collection=1")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "title", "image", "date_added"}).
+			AddRow(1, "Severance", "", int64(1700000000)))
+
+	handler := createHandler(mock, "book", "show")
+	recorder := serve(http.MethodGet, "/api/collection", "/api/collection", handler.HandleGetCollection)
+
+	body := recorder.Body.String()
+
+	if recorder.Code != http.StatusOK || !strings.Contains(body, "Severance") || !strings.Contains(body, `"podcasts": []`) {
+		t.Fatalf("Actual response '%s' does not contain expected shows and empty material types.", body)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleGetCollectionHandlesUnsupportedType(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, "/api/collection", "/api/collection?type=game", handler.HandleGetCollection)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlePostCollectionItemReturnsStatusCreated(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM books WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}).AddRow(1))
+
+	expectCollection(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM collections_books WHERE collection=1 AND book=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO collections_books (collection,book,date_added)")).
+		WithArgs(1, 1, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/collection/:type", "/api/collection/book?id=1", handler.HandlePostCollectionItem)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostCollectionItemStoresGameWithoutPlatform(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM games WHERE id=3")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}).AddRow(1))
+
+	expectCollection(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM collections_games WHERE collection=1 AND game=3")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO collections_games (collection,game,date_added,platform)")).
+		WithArgs(1, 3, pgxmock.AnyArg(), nil).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock, "game")
+	recorder := serve(http.MethodPost, "/api/collection/:type", "/api/collection/game?id=3", handler.HandlePostCollectionItem)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostCollectionItemHandlesMissingMaterial(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM books WHERE id=2")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/collection/:type", "/api/collection/book?id=2", handler.HandlePostCollectionItem)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandleDeleteCollectionItemReturnsStatusNoContent(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectCollection(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM collections_books WHERE collection=1 AND book=1")).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodDelete, "/api/collection/:type", "/api/collection/book?id=1", handler.HandleDeleteCollectionItem)
+
+	if recorder.Code != http.StatusNoContent {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusNoContent)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleDeleteCollectionItemHandlesInvalidIdArg(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodDelete, "/api/collection/:type", "/api/collection/book?id=last-wish", handler.HandleDeleteCollectionItem)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlePostCollectionIssueReturnsStatusCreated(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM issues WHERE id=2")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}).AddRow(1))
+
+	expectCollection(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM collections_issues WHERE collection=1 AND issue=2")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO collections_issues (collection,issue,date_added)")).
+		WithArgs(1, 2, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock, "comic")
+	recorder := serve(http.MethodPost, "/api/collection/:type/issue", "/api/collection/comic/issue?id=2", handler.HandlePostCollectionIssue)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostCollectionIssueHandlesTypeWithoutIssues(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock, "book", "comic")
+	recorder := serve(http.MethodPost, "/api/collection/:type/issue", "/api/collection/book/issue?id=2", handler.HandlePostCollectionIssue)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlePutCollectionPlatformReturnsStatusOk(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM games_platforms WHERE game=1 AND platform=2")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}).AddRow(1))
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE collections_games SET platform=@platform WHERE collection IN (SELECT c.id FROM collections c JOIN users u ON u.id = c.owner WHERE u.reference='default') AND game=1")).
+		WithArgs(2).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	handler := createHandler(mock, "game")
+	recorder := serve(http.MethodPut, "/api/collection/:type/platform", "/api/collection/game/platform?id=1&platform=2", handler.HandlePutCollectionPlatform)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePutCollectionPlatformHandlesPlatformOfOtherGame(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM games_platforms WHERE game=1 AND platform=9")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}))
+
+	handler := createHandler(mock, "game")
+	recorder := serve(http.MethodPut, "/api/collection/:type/platform", "/api/collection/game/platform?id=1&platform=9", handler.HandlePutCollectionPlatform)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlePutCollectionPlatformHandlesMissingItem(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE collections_games SET platform=@platform WHERE collection IN (SELECT c.id FROM collections c JOIN users u ON u.id = c.owner WHERE u.reference='default') AND game=1")).
+		WithArgs(nil).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	handler := createHandler(mock, "game")
+	recorder := serve(http.MethodPut, "/api/collection/:type/platform", "/api/collection/game/platform?id=1&platform=0", handler.HandlePutCollectionPlatform)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusNotFound)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePutCollectionPlatformHandlesTypeWithoutPlatforms(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock, "book", "game")
+	recorder := serve(http.MethodPut, "/api/collection/:type/platform", "/api/collection/book/platform?id=1&platform=2", handler.HandlePutCollectionPlatform)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func expectCollection(mock pgxmock.PgxPoolIface) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM users WHERE reference='default'")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "reference", "date_created"}).
+			AddRow(1, "default", int64(0)))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM collections WHERE owner=1")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "owner", "name", "date_created"}).
+			AddRow(1, 1, "Collection", int64(0)))
+}
+
+func createHandler(mock pgxmock.PgxPoolIface, materialTypes ...string) *api.Handler {
+	logger := log.New(io.Discard, "", 0)
+
+	if len(materialTypes) == 0 {
+		materialTypes = []string{"book"}
+	}
+
+	return api.NewHandler(helper.NewRepository(mock, userHelper.NewRepository(mock, logger), materialTypes, logger))
+}
+
+func serve(method string, route string, target string, handle gin.HandlerFunc) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Handle(method, route, middleware.Authorize(auth.NewAuthenticator(config.AuthConfig{}, time.Second), auth.ScopeRead), handle)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(method, target, nil))
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
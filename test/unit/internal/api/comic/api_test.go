@@ -0,0 +1,285 @@
+package api_test
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/comic"
+	"github.com/muzzarellimj/grace-material-api/internal/api/comic/helper"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/comic"
+	CVModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/comicvine.gamespot.com"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+const description = "An epic space opera & fantasy."
+
+type fakeProvider struct{}
+
+func (provider fakeProvider) CVGetVolume(id string) (CVModel.CVVolume, error) {
+	return CVModel.CVVolume{
+		ID:            48488,
+		Name:          "Saga",
+		Description:   "<p>An epic <em>space opera</em> &amp; fantasy.</p>",
+		StartYear:     "2012",
+		CountOfIssues: 2,
+		Publisher:     CVModel.CVResource{ID: 513, Name: "Image"},
+		FirstIssue:    CVModel.CVIssueSummary{ID: 323340, IssueNumber: "1"},
+	}, nil
+}
+
+func (provider fakeProvider) CVGetIssue(id string) (CVModel.CVIssue, error) {
+	return CVModel.CVIssue{
+		ID:          323340,
+		IssueNumber: "1",
+		PersonCredits: []CVModel.CVPersonCredits{
+			{ID: 40439, Name: "Brian K. Vaughan", Role: "writer"},
+			{ID: 56652, Name: "Fiona Staples", Role: "artist, cover"},
+		},
+	}, nil
+}
+
+func (provider fakeProvider) CVGetVolumeIssues(id string) ([]CVModel.CVIssue, error) {
+	return []CVModel.CVIssue{
+		{ID: 328423, Name: "Chapter Two", IssueNumber: "2", CoverDate: "2012-04-08"},
+		{ID: 323340, Name: "Chapter One", IssueNumber: "1", CoverDate: "2012-03-07"},
+	}, nil
+}
+
+func (provider fakeProvider) CVSearchVolume(query string) ([]CVModel.CVSearchResult, error) {
+	return []CVModel.CVSearchResult{}, nil
+}
+
+func TestHandlePostComicStoresVolumeIssuesAndCreatorRoles(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM volumes WHERE reference=48488")).
+		WillReturnRows(pgxmock.NewRows(volumeColumns))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO comics (title,description,start_date,volumes,issues,image)")).
+		WithArgs("Saga", description, int64(1325376000), 1, 2, "").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	expectVolumeAndIssues(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM comics_creators WHERE comic=1")).
+		WillReturnRows(pgxmock.NewRows(creatorRelationshipColumns))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM creators WHERE reference=40439")).
+		WillReturnRows(pgxmock.NewRows(referenceColumns))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO creators (name,reference)")).
+		WithArgs("Brian K. Vaughan", 40439).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	expectCreatorRelationship(mock, 1, "writer")
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM creators WHERE reference=56652")).
+		WillReturnRows(pgxmock.NewRows(referenceColumns))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO creators (name,reference)")).
+		WithArgs("Fiona Staples", 56652).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectCommit()
+	expectCreatorRelationship(mock, 2, "artist")
+	expectCreatorRelationship(mock, 2, "cover")
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM cpublishers WHERE reference=513")).
+		WillReturnRows(pgxmock.NewRows(referenceColumns))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO cpublishers (name,reference)")).
+		WithArgs("Image", 513).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO comics_publishers (comic,publisher)")).
+		WithArgs(1, 1).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostComic, "/api/comic?id=48488")
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostComicAddsVolumeToComic(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM volumes WHERE reference=48488")).
+		WillReturnRows(pgxmock.NewRows(volumeColumns))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM comics WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows(comicColumns).AddRow(1, "Saga", description, int64(1356998400), 1, 3, ""))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE comics SET title=@title,description=@description,start_date=@start_date,volumes=@volumes,issues=@issues,image=@image WHERE id=1")).
+		WithArgs("Saga", description, int64(1325376000), 2, 5, "").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	expectVolumeAndIssues(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM comics_creators WHERE comic=1")).
+		WillReturnRows(pgxmock.NewRows(creatorRelationshipColumns).AddRow(1, 1, "writer"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM creators WHERE reference=40439")).
+		WillReturnRows(pgxmock.NewRows(referenceColumns).AddRow(1, "Brian K. Vaughan", 40439))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM creators WHERE reference=56652")).
+		WillReturnRows(pgxmock.NewRows(referenceColumns).AddRow(2, "Fiona Staples", 56652))
+	expectCreatorRelationship(mock, 2, "artist")
+	expectCreatorRelationship(mock, 2, "cover")
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM cpublishers WHERE reference=513")).
+		WillReturnRows(pgxmock.NewRows(referenceColumns).AddRow(1, "Image", 513))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM comics_publishers WHERE comic=1 AND publisher=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"comic", "publisher"}).AddRow(1, 1))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostComic, "/api/comic?id=48488&comic=1")
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostComicHandlesInvalidComic(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostComic, "/api/comic?id=48488&comic=saga")
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleGetComicReturnsVolumesAndIssuesInOrder(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM comics WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows(comicColumns).AddRow(1, "Saga", description, int64(1325376000), 2, 4, ""))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT f.id, f.name, r.role, f.reference FROM creators f JOIN comics_creators r ON r.creator = f.id WHERE r.comic=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "role", "reference"}).
+			AddRow(2, "Fiona Staples", "cover", 56652).
+			AddRow(1, "Brian K. Vaughan", "writer", 40439).
+			AddRow(2, "Fiona Staples", "artist", 56652))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM comics_publishers WHERE comic=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"comic", "publisher"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM volumes WHERE comic=1")).
+		WillReturnRows(pgxmock.NewRows(volumeColumns).
+			AddRow(2, 1, "Saga", "", int64(1514764800), 2, "", 110001).
+			AddRow(1, 1, "Saga", "", int64(1325376000), 2, "", 48488))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM issues WHERE comic=1")).
+		WillReturnRows(pgxmock.NewRows(issueColumns).
+			AddRow(4, 1, 2, "1", "Chapter Fifty-Five", int64(0), "", 4).
+			AddRow(2, 1, 1, "10", "Chapter Ten", int64(0), "", 2).
+			AddRow(3, 1, 2, "½", "Chapter Zero", int64(0), "", 3).
+			AddRow(1, 1, 1, "2", "Chapter Two", int64(0), "", 1))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, handler.HandleGetComic, "/api/comic?id=1")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data []model.Comic `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	comic := response.Data[0]
+
+	if len(comic.Creators) != 3 || comic.Creators[0].Name != "Brian K. Vaughan" || comic.Creators[1].Role != "artist" {
+		t.Fatalf("Actual creators '%+v' do not match expected creators in order.", comic.Creators)
+	}
+
+	volumes := comic.Volumes
+
+	if len(volumes) != 2 || volumes[0].ID != 1 || volumes[0].Issues[0].Number != "2" || volumes[0].Issues[1].Number != "10" || volumes[1].Issues[0].Number != "½" {
+		t.Fatalf("Actual volumes '%+v' do not match expected volumes in order.", volumes)
+	}
+}
+
+var comicColumns = []string{"id", "title", "description", "start_date", "volumes", "issues", "image"}
+var volumeColumns = []string{"id", "comic", "title", "description", "start_date", "issues", "image", "reference"}
+var issueColumns = []string{"id", "comic", "volume", "number", "title", "cover_date", "image", "reference"}
+var referenceColumns = []string{"id", "name", "reference"}
+var creatorRelationshipColumns = []string{"comic", "creator", "role"}
+
+func expectVolumeAndIssues(mock pgxmock.PgxPoolIface) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO volumes (comic,title,description,start_date,issues,image,reference)")).
+		WithArgs(1, "Saga", description, int64(1325376000), 2, "", 48488).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO issues (comic,volume,number,title,cover_date,image,reference)")).
+		WithArgs(1, 1, "2", "Chapter Two", int64(1333843200), "", 328423).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO issues (comic,volume,number,title,cover_date,image,reference)")).
+		WithArgs(1, 1, "1", "Chapter One", int64(1331078400), "", 323340).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectCommit()
+}
+
+func expectCreatorRelationship(mock pgxmock.PgxPoolIface, creator int, role string) {
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO comics_creators (comic,creator,role)")).
+		WithArgs(1, creator, role).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+}
+
+func createHandler(mock pgxmock.PgxPoolIface) *api.Handler {
+	return api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+}
+
+func serve(method string, handle gin.HandlerFunc, target string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(method, target, nil)
+
+	handle(context)
+
+	context.Writer.WriteHeaderNow()
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
@@ -0,0 +1,63 @@
+package helper_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/muzzarellimj/grace-material-api/internal/api/comic/helper"
+)
+
+func TestFormatDescriptionPrefersDeck(t *testing.T) {
+	expected := "A space opera."
+	actual := helper.FormatDescription(" A space opera. ", "<p>An epic space opera.</p>")
+
+	if actual != expected {
+		t.Fatalf("Actual description '%s' does not match expected description '%s'.", actual, expected)
+	}
+}
+
+func TestFormatDescriptionRemovesMarkup(t *testing.T) {
+	expected := "An epic space opera & fantasy."
+	actual := helper.FormatDescription("", "<p>An epic <em>space opera</em> &amp;\n fantasy.</p>")
+
+	if actual != expected {
+		t.Fatalf("Actual description '%s' does not match expected description '%s'.", actual, expected)
+	}
+}
+
+func TestParseStartYearParses(t *testing.T) {
+	var expected int64 = 1325376000
+	actual := helper.ParseStartYear("2012")
+
+	if actual != expected {
+		t.Fatalf("Actual start date '%d' does not match expected start date '%d'.", actual, expected)
+	}
+}
+
+func TestParseStartYearHandlesMalformedYear(t *testing.T) {
+	actual := helper.ParseStartYear("20l2")
+
+	if actual != 0 {
+		t.Fatalf("Actual start date '%d' does not match expected zero start date.", actual)
+	}
+}
+
+func TestSplitRoleSliceSplitsDistinctRoles(t *testing.T) {
+	expected := []string{"artist", "cover"}
+	actual := helper.SplitRoleSlice("Artist, cover, artist,")
+
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("Actual roles '%v' do not match expected roles '%v'.", actual, expected)
+	}
+}
+
+func TestCompareIssueNumberSortsAsPublished(t *testing.T) {
+	expected := []string{"½", "1", "1.MU", "2", "10", "Annual"}
+	actual := []string{"10", "Annual", "2", "1.MU", "½", "1"}
+
+	slices.SortStableFunc(actual, helper.CompareIssueNumber)
+
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("Actual issue order '%v' does not match expected issue order '%v'.", actual, expected)
+	}
+}
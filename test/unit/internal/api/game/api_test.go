@@ -0,0 +1,121 @@
+package api_test
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/game"
+	"github.com/muzzarellimj/grace-material-api/internal/api/game/helper"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/game"
+	IGDBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/igdb.com"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+type fakeProvider struct {
+	similar []IGDBModel.IGDBGameSearchResponse
+}
+
+func (provider fakeProvider) IGDBGetGame(id string) (IGDBModel.IGDBGameResponse, error) {
+	return IGDBModel.IGDBGameResponse{}, nil
+}
+
+func (provider fakeProvider) IGDBSearchGame(query string) ([]IGDBModel.IGDBGameSearchResponse, error) {
+	return []IGDBModel.IGDBGameSearchResponse{}, nil
+}
+
+func (provider fakeProvider) IGDBGetCompany(id int) (IGDBModel.IGDBCompanyResponse, error) {
+	return IGDBModel.IGDBCompanyResponse{}, nil
+}
+
+func (provider fakeProvider) IGDBGetSimilarGames(id int) ([]IGDBModel.IGDBGameSearchResponse, error) {
+	return provider.similar, nil
+}
+
+func (provider fakeProvider) IGDBGetSteamGameSlice(appid string) ([]IGDBModel.IGDBExternalGameResponse, error) {
+	return []IGDBModel.IGDBExternalGameResponse{}, nil
+}
+
+func TestHandleGetGameSimilarExcludesStoredGames(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM games WHERE id=3")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "title", "summary", "storyline", "release_date", "image", "reference"}).
+			AddRow(3, "Super Smash Bros.", "", "", 916876800, "", 1626))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT reference FROM games WHERE reference IN (1627,1628)")).
+		WillReturnRows(pgxmock.NewRows([]string{"reference"}).AddRow(1627))
+
+	handler := createHandler(mock, fakeProvider{similar: []IGDBModel.IGDBGameSearchResponse{
+		{ID: 1627, Title: "Super Smash Bros. Melee"},
+		{ID: 1628, Title: "Super Smash Bros. Brawl"},
+	}})
+	recorder := serve("/api/game/similar?id=3", handler.HandleGetGameSimilar)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data []model.GameSearchResult `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	if len(response.Data) != 1 || response.Data[0].ID != 1628 {
+		t.Fatalf("Actual candidates '%v' do not match expected candidates without stored games.", response.Data)
+	}
+}
+
+func TestHandleGetGameSimilarHandlesMissingGame(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM games WHERE id=9")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "title", "summary", "storyline", "release_date", "image", "reference"}))
+
+	handler := createHandler(mock, fakeProvider{})
+	recorder := serve("/api/game/similar?id=9", handler.HandleGetGameSimilar)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusNotFound)
+	}
+}
+
+func createHandler(mock pgxmock.PgxPoolIface, provider helper.Provider) *api.Handler {
+	return api.NewHandler(helper.NewRepository(mock, provider, log.New(io.Discard, "", 0)))
+}
+
+func serve(target string, handle gin.HandlerFunc) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/api/game/similar", handle)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
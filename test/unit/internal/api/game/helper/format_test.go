@@ -0,0 +1,50 @@
+package helper_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/muzzarellimj/grace-material-api/internal/api/game/helper"
+	IGDBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/igdb.com"
+)
+
+func TestFormatRegionReturnsName(t *testing.T) {
+	expected := "north_america"
+	actual := helper.FormatRegion(2)
+
+	if actual != expected {
+		t.Fatalf("Actual region '%s' does not match expected region '%s'.", actual, expected)
+	}
+}
+
+func TestFormatRegionReturnsUnknown(t *testing.T) {
+	expected := "unknown"
+	actual := helper.FormatRegion(0)
+
+	if actual != expected {
+		t.Fatalf("Actual region '%s' does not match expected region '%s'.", actual, expected)
+	}
+}
+
+func TestExtractReleaseSliceCollapsesReleases(t *testing.T) {
+	releaseDates := []IGDBModel.IGDBNestedReleaseDate{
+		{Date: 1645142400, Platform: 130, Region: 8},
+		{Date: 930873600, Platform: 4, Region: 2},
+		{Date: 925430400, Platform: 4, Region: 5},
+		{Date: 940000000, Platform: 4, Region: 2},
+		{Platform: 130, Region: 1},
+		{Date: 930873600},
+	}
+
+	expected := []IGDBModel.IGDBNestedReleaseDate{
+		{Date: 925430400, Platform: 4, Region: 5},
+		{Date: 930873600, Platform: 4, Region: 2},
+		{Date: 1645142400, Platform: 130, Region: 8},
+	}
+
+	actual := helper.ExtractReleaseSlice(releaseDates)
+
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("Actual releases '%v' do not match expected releases '%v'.", actual, expected)
+	}
+}
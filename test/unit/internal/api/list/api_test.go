@@ -0,0 +1,229 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/list"
+	"github.com/muzzarellimj/grace-material-api/internal/api/list/helper"
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/auth"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/list"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+var (
+	listColumns     = []string{"id", "owner", "name", "description", "date_created"}
+	listItemColumns = []string{"id", "list", "position", "type", "material"}
+)
+
+func TestHandleGetListReturnsItemsInOrder(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectList(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT i.id, i.position, i.type, i.material, m.title, m.image FROM lists_items i JOIN books m ON m.id = i.material WHERE i.list=1 AND i.type='book'")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "position", "type", "material", "title", "image"}).
+			AddRow(2, 3, "book", 1, "The Last Wish", ""))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT i.id, i.position, i.type, i.material, m.title, m.image FROM lists_items i JOIN movies m ON m.id = i.material WHERE i.list=1 AND i.type='movie'")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "position", "type", "material", "title", "image"}).
+			AddRow(1, 1, "movie", 4, "Alien", "").
+			AddRow(3, 2, "movie", 5, "Aliens", ""))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, "/api/list/:id", "/api/list/1", "", handler.HandleGetList)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data model.List `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	if len(response.Data.Items) != 3 || response.Data.Items[0].ID != 1 || response.Data.Items[2].ID != 2 {
+		t.Fatalf("Actual items '%v' do not match expected items ordered by position.", response.Data.Items)
+	}
+}
+
+func TestHandleGetListHandlesUnownedList(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectUser(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM lists WHERE id=2 AND owner=1")).
+		WillReturnRows(pgxmock.NewRows(listColumns))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, "/api/list/:id", "/api/list/2", "", handler.HandleGetList)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandlePostListItemAppendsToEnd(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectList(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM movies WHERE id=5")).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM lists_items WHERE list=1")).
+		WillReturnRows(pgxmock.NewRows(listItemColumns).
+			AddRow(1, 1, 1, "movie", 4).
+			AddRow(2, 1, 2, "book", 1))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO lists_items (list,position,type,material)")).
+		WithArgs(1, 3, "movie", 5).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/list/:id/item", "/api/list/1/item", `{"type":"movie","material":5}`, handler.HandlePostListItem)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePutListOrderHandlesIncompleteOrder(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectList(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM lists_items WHERE list=1")).
+		WillReturnRows(pgxmock.NewRows(listItemColumns).
+			AddRow(1, 1, 1, "movie", 4).
+			AddRow(2, 1, 2, "book", 1))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPut, "/api/list/:id/order", "/api/list/1/order", `{"items":[2]}`, handler.HandlePutListOrder)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlePutListOrderRollsBackPartialOrder(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectList(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM lists_items WHERE list=1")).
+		WillReturnRows(pgxmock.NewRows(listItemColumns).
+			AddRow(1, 1, 1, "movie", 4).
+			AddRow(2, 1, 2, "book", 1))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE lists_items SET position=@position WHERE id=@id AND list=@list")).
+		WithArgs(pgx.NamedArgs{"position": 1, "id": 2, "list": 1}).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE lists_items SET position=@position WHERE id=@id AND list=@list")).
+		WithArgs(pgx.NamedArgs{"position": 2, "id": 1, "list": 1}).
+		WillReturnError(errors.New("connection reset"))
+	mock.ExpectRollback()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPut, "/api/list/:id/order", "/api/list/1/order", `{"items":[2,1]}`, handler.HandlePutListOrder)
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusInternalServerError)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostListHandlesEmptyName(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/list", "/api/list", `{"name":"","description":"Favourites"}`, handler.HandlePostList)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func expectList(mock pgxmock.PgxPoolIface) {
+	expectUser(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM lists WHERE id=1 AND owner=1")).
+		WillReturnRows(pgxmock.NewRows(listColumns).AddRow(1, 1, "Favourites", "", int64(0)))
+}
+
+func expectUser(mock pgxmock.PgxPoolIface) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM users WHERE reference='default'")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "reference", "date_created"}).
+			AddRow(1, "default", int64(0)))
+}
+
+func createHandler(mock pgxmock.PgxPoolIface) *api.Handler {
+	logger := log.New(io.Discard, "", 0)
+
+	return api.NewHandler(helper.NewRepository(mock, userHelper.NewRepository(mock, logger), []string{"book", "movie"}, logger))
+}
+
+func serve(method string, route string, target string, body string, handle gin.HandlerFunc) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Handle(method, route, middleware.Authorize(auth.NewAuthenticator(config.AuthConfig{}, time.Second), auth.ScopeRead), handle)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(method, target, strings.NewReader(body)))
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
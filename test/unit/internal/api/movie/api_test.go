@@ -0,0 +1,125 @@
+package api_test
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/movie"
+	"github.com/muzzarellimj/grace-material-api/internal/api/movie/helper"
+	TMDBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/themoviedb.org"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+type fakeProvider struct{}
+
+func (provider fakeProvider) TMDBGetMovie(id string) (TMDBModel.TMDBMovieDetailResponse, error) {
+	return TMDBModel.TMDBMovieDetailResponse{}, nil
+}
+
+func (provider fakeProvider) TMDBSearchMovie(title string) (TMDBModel.TMDBMovieSearchResponse, error) {
+	return TMDBModel.TMDBMovieSearchResponse{}, nil
+}
+
+func (provider fakeProvider) TMDBGetMovieRecommendations(id string) (TMDBModel.TMDBMovieSearchResponse, error) {
+	return TMDBModel.TMDBMovieSearchResponse{}, nil
+}
+
+func TestHandleGetPersonReturnsCredits(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM people WHERE id=2")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "image", "department", "reference"}).AddRow(2, "Jared Bush", "", "Directing", 1247497))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM movies_people WHERE person=2")).
+		WillReturnRows(pgxmock.NewRows([]string{"movie", "person", "role", "job", "character", "billing"}).AddRow(1, 2, "crew", "Director", "", 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM movies WHERE id=1")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "title", "tagline", "description", "release_date", "runtime", "image", "reference"}).
+			AddRow(1, "Encanto", "", "", int64(1637280000), 102, "", 568124))
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetPerson, "/api/movie/person?id=2")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	if !strings.Contains(recorder.Body.String(), `"job": "Director"`) || !strings.Contains(recorder.Body.String(), `"title": "Encanto"`) {
+		t.Fatalf("Actual response '%s' does not contain expected credit.", recorder.Body.String())
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleGetPersonHandlesUnknownPerson(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM people WHERE id=9")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name", "image", "department", "reference"}))
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetPerson, "/api/movie/person?id=9")
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandleGetMovieExistenceSliceFiltersByPerson(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM movies WHERE id IN (SELECT movie FROM movies_people WHERE person=2)")).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+
+	handler := api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+	recorder := serve(handler.HandleGetMovieExistenceSlice, "/api/movie/exist?person=2")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func serve(handle gin.HandlerFunc, target string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(http.MethodGet, target, nil)
+
+	handle(context)
+
+	context.Writer.WriteHeaderNow()
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
@@ -0,0 +1,318 @@
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/podcast"
+	"github.com/muzzarellimj/grace-material-api/internal/api/podcast/helper"
+	FeedAPI "github.com/muzzarellimj/grace-material-api/internal/api/third_party/feed"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+const description = "Stories about the things we collect."
+
+const feed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+	<channel>
+		<title>Grace Radio</title>
+		<link>https://example.com/radio</link>
+		<language>en-us</language>
+		<description><![CDATA[<p>Stories about the <em>things</em> we collect.</p>]]></description>
+		<itunes:author>Grace</itunes:author>
+		<itunes:explicit>false</itunes:explicit>
+		<itunes:image href="https://example.com/radio.jpg"/>
+		<itunes:category text="Society &amp; Culture">
+			<itunes:category text="Documentary"/>
+		</itunes:category>
+		<item>
+			<title>Shelves</title>
+			<guid isPermaLink="false">grace-radio-2</guid>
+			<pubDate>Mon, 08 Jan 2024 12:00:00 +0000</pubDate>
+			<enclosure url="https://example.com/radio/2.mp3" type="audio/mpeg" length="2048"/>
+			<itunes:duration>45:00</itunes:duration>
+			<itunes:episode>2</itunes:episode>
+		</item>
+		<item>
+			<title>Pilot</title>
+			<guid isPermaLink="false">grace-radio-1</guid>
+			<pubDate>Mon, 01 Jan 2024 12:00:00 +0000</pubDate>
+			<enclosure url="https://example.com/radio/1.mp3" type="audio/mpeg" length="1024"/>
+			<itunes:duration>1800</itunes:duration>
+			<itunes:episode>1</itunes:episode>
+		</item>
+	</channel>
+</rss>`
+
+func TestHandlePostPodcastStoresFeedEpisodesAndCategories(t *testing.T) {
+	server := createFeedServer()
+
+	defer server.Close()
+
+	feedURL := server.URL + "/feed.xml"
+
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT * FROM podcasts WHERE reference='%s'", feedURL))).
+		WillReturnRows(pgxmock.NewRows(podcastColumns))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO podcasts (title,author,description,language,explicit,first_release_date,last_release_date,episodes,image,link,reference,date_refreshed)")).
+		WithArgs("Grace Radio", "Grace", description, "en-us", false, int64(1704110400), int64(1704715200), 2, "https://example.com/radio.jpg", "https://example.com/radio", feedURL, pgxmock.AnyArg()).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	expectEpisode(mock, "Shelves", int64(1704715200), 2700, 2, "grace-radio-2", 2)
+	expectEpisode(mock, "Pilot", int64(1704110400), 1800, 1, "grace-radio-1", 1)
+	expectCategory(mock, "Society & Culture", 1)
+	expectCategory(mock, "Documentary", 2)
+	expectCategoryRelationship(mock, 1)
+	expectCategoryRelationship(mock, 2)
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostPodcast, "/api/podcast?feed="+feedURL)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostPodcastReturnsExistingPodcast(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM podcasts WHERE reference='https://example.com/radio/feed.xml'")).
+		WillReturnRows(pgxmock.NewRows(podcastColumns).AddRow(podcastRow("https://example.com/radio/feed.xml", 1)...))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostPodcast, "/api/podcast?feed=https://example.com/radio/feed.xml")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+}
+
+func TestHandlePostPodcastHandlesMissingFeed(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostPodcast, "/api/podcast")
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlePostPodcastRefreshStoresNewEpisodes(t *testing.T) {
+	server := createFeedServer()
+
+	defer server.Close()
+
+	feedURL := server.URL + "/feed.xml"
+
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM podcasts WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows(podcastColumns).AddRow(podcastRow(feedURL, 1)...))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM pepisodes WHERE podcast=1")).
+		WillReturnRows(pgxmock.NewRows(episodeColumns).AddRow(1, 1, "Pilot", "", int64(1704110400), 1800, 0, 1, "https://example.com/radio/1.mp3", "", "grace-radio-1"))
+	expectEpisode(mock, "Shelves", int64(1704715200), 2700, 2, "grace-radio-2", 2)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE podcasts SET title=@title,author=@author,description=@description,language=@language,explicit=@explicit,first_release_date=@first_release_date,last_release_date=@last_release_date,episodes=@episodes,image=@image,link=@link,reference=@reference,date_refreshed=@date_refreshed WHERE id=1")).
+		WithArgs("Grace Radio", "Grace", description, "en-us", false, int64(1704110400), int64(1704715200), 2, "", "https://example.com/radio", feedURL, pgxmock.AnyArg()).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostPodcastRefresh, "/api/podcast/refresh?id=1")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data struct {
+			Episodes int `json:"episodes"`
+		} `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	if response.Data.Episodes != 1 {
+		t.Fatalf("Actual new episode count '%d' does not match expected new episode count '%d'.", response.Data.Episodes, 1)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostPodcastRefreshHandlesMissingPodcast(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM podcasts WHERE id=2")).
+		WillReturnRows(pgxmock.NewRows(podcastColumns))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostPodcastRefresh, "/api/podcast/refresh?id=2")
+
+	if recorder.Code != http.StatusNoContent {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusNoContent)
+	}
+}
+
+func TestHandleGetPodcastReturnsEpisodesNewestFirst(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM podcasts WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows(podcastColumns).AddRow(podcastRow("https://example.com/radio/feed.xml", 2)...))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM podcasts_categories WHERE podcast=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"podcast", "category"}).AddRow(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM pcategories WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name"}).AddRow(1, "Society & Culture"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM pepisodes WHERE podcast=1")).
+		WillReturnRows(pgxmock.NewRows(episodeColumns).
+			AddRow(1, 1, "Pilot", "", int64(1704110400), 1800, 0, 1, "", "", "grace-radio-1").
+			AddRow(2, 1, "Shelves", "", int64(1704715200), 2700, 0, 2, "", "", "grace-radio-2"))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, handler.HandleGetPodcast, "/api/podcast?id=1")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data []model.Podcast `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	podcast := response.Data[0]
+
+	if len(podcast.Categories) != 1 || podcast.Categories[0].Name != "Society & Culture" {
+		t.Fatalf("Actual categories '%+v' do not match expected categories.", podcast.Categories)
+	}
+
+	if len(podcast.Episodes) != 2 || podcast.Episodes[0].Title != "Shelves" || podcast.Episodes[1].Title != "Pilot" {
+		t.Fatalf("Actual episodes '%+v' do not match expected episodes in order.", podcast.Episodes)
+	}
+}
+
+var podcastColumns = []string{"id", "title", "author", "description", "language", "explicit", "first_release_date", "last_release_date", "episodes", "image", "link", "reference", "date_refreshed"}
+var episodeColumns = []string{"id", "podcast", "title", "description", "release_date", "duration", "season", "number", "audio", "image", "reference"}
+
+func podcastRow(feedURL string, episodes int) []any {
+	lastReleaseDate := int64(1704110400)
+
+	if episodes > 1 {
+		lastReleaseDate = 1704715200
+	}
+
+	return []any{1, "Grace Radio", "Grace", description, "en-us", false, int64(1704110400), lastReleaseDate, episodes, "", "https://example.com/radio", feedURL, int64(1704110400)}
+}
+
+func expectEpisode(mock pgxmock.PgxPoolIface, title string, releaseDate int64, duration int, number int, reference string, id int) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO pepisodes (podcast,title,description,release_date,duration,season,number,audio,image,reference)")).
+		WithArgs(1, title, "", releaseDate, duration, 0, number, fmt.Sprintf("https://example.com/radio/%d.mp3", number), "", reference).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(id))
+	mock.ExpectCommit()
+}
+
+func expectCategory(mock pgxmock.PgxPoolIface, name string, id int) {
+	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT * FROM pcategories WHERE name='%s'", name))).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "name"}))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO pcategories (name)")).
+		WithArgs(name).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(id))
+	mock.ExpectCommit()
+}
+
+func expectCategoryRelationship(mock pgxmock.PgxPoolIface, category int) {
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO podcasts_categories (podcast,category)")).
+		WithArgs(1, category).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+}
+
+func createFeedServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != "/feed.xml" {
+			writer.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		writer.Header().Set("Content-Type", "application/rss+xml")
+
+		fmt.Fprint(writer, feed)
+	}))
+}
+
+func createHandler(mock pgxmock.PgxPoolIface) *api.Handler {
+	client := FeedAPI.NewClient(config.PodcastConfig{}, 5*time.Second)
+
+	return api.NewHandler(helper.NewRepository(mock, client, log.New(io.Discard, "", 0)))
+}
+
+func serve(method string, handle gin.HandlerFunc, target string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(method, target, nil)
+
+	handle(context)
+
+	context.Writer.WriteHeaderNow()
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
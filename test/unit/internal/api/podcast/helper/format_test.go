@@ -0,0 +1,131 @@
+package helper_test
+
+import (
+	"encoding/xml"
+	"slices"
+	"testing"
+
+	"github.com/muzzarellimj/grace-material-api/internal/api/podcast/helper"
+	FeedModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/feed"
+)
+
+func TestMapFeedMapsRSS(t *testing.T) {
+	document := FeedModel.FeedDocument{
+		XMLName: xml.Name{Local: "rss"},
+		Channel: FeedModel.RSSChannel{
+			Title:            " Grace Radio ",
+			ITunesAuthor:     "Grace",
+			ITunesSummary:    "Stories.",
+			ITunesExplicit:   "yes",
+			ITunesImage:      FeedModel.ITunesImage{Href: "https://example.com/radio.jpg"},
+			ITunesCategories: []FeedModel.ITunesCategory{{Text: "Society &amp; Culture", Subcategories: []FeedModel.ITunesCategory{{Text: "Documentary"}}}},
+			Categories:       []string{"documentary"},
+			Items: []FeedModel.RSSItem{
+				{Title: "Pilot", GUID: "grace-radio-1", PubDate: "Mon, 01 Jan 2024 12:00:00 +0000", ITunesDuration: "30:00"},
+				{Title: "Shelves", ITunesTitle: "Shelves, Revisited", GUID: "grace-radio-2", PubDate: "Mon, 08 Jan 2024 12:00:00 GMT", ITunesEpisode: "2"},
+				{Title: "Shelves", GUID: "grace-radio-2"},
+				{},
+			},
+		},
+	}
+
+	actual := helper.MapFeed(document, "https://example.com/radio/feed.xml")
+
+	podcast := actual.Podcast
+
+	if podcast.Title != "Grace Radio" || podcast.Description != "Stories." || !podcast.Explicit || podcast.Reference != "https://example.com/radio/feed.xml" {
+		t.Fatalf("Actual podcast '%+v' does not match expected podcast.", podcast)
+	}
+
+	if podcast.Episodes != 2 || podcast.FirstReleaseDate != 1704110400 || podcast.LastReleaseDate != 1704715200 {
+		t.Fatalf("Actual podcast episodes '%d' and release dates '%d' to '%d' do not match expected episodes and release dates.", podcast.Episodes, podcast.FirstReleaseDate, podcast.LastReleaseDate)
+	}
+
+	if len(actual.Episodes) != 2 || actual.Episodes[0].Duration != 1800 || actual.Episodes[1].Title != "Shelves, Revisited" || actual.Episodes[1].Number != 2 {
+		t.Fatalf("Actual episodes '%+v' do not match expected episodes.", actual.Episodes)
+	}
+
+	if !slices.Equal(actual.Categories, []string{"Society & Culture", "Documentary"}) {
+		t.Fatalf("Actual categories '%v' do not match expected categories.", actual.Categories)
+	}
+}
+
+func TestMapFeedMapsAtom(t *testing.T) {
+	document := FeedModel.FeedDocument{
+		XMLName: xml.Name{Local: "feed"},
+		Title:   "Grace Radio",
+		Authors: []FeedModel.AtomPerson{{Name: "Grace"}},
+		Links:   []FeedModel.AtomLink{{Href: "https://example.com/radio/feed.xml", Rel: "self"}, {Href: "https://example.com/radio"}},
+		Entries: []FeedModel.AtomEntry{
+			{ID: "urn:grace-radio:1", Title: "Pilot", Updated: "2024-01-01T12:00:00Z", Links: []FeedModel.AtomLink{{Href: "https://example.com/radio/1.mp3", Rel: "enclosure"}}},
+		},
+		Categories: []FeedModel.AtomCategory{{Term: "documentary", Label: "Documentary"}},
+	}
+
+	actual := helper.MapFeed(document, "https://example.com/radio/feed.xml")
+
+	if actual.Podcast.Author != "Grace" || actual.Podcast.Link != "https://example.com/radio" || actual.Podcast.LastReleaseDate != 1704110400 {
+		t.Fatalf("Actual podcast '%+v' does not match expected podcast.", actual.Podcast)
+	}
+
+	if len(actual.Episodes) != 1 || actual.Episodes[0].Audio != "https://example.com/radio/1.mp3" || actual.Episodes[0].Reference != "urn:grace-radio:1" {
+		t.Fatalf("Actual episodes '%+v' do not match expected episodes.", actual.Episodes)
+	}
+
+	if !slices.Equal(actual.Categories, []string{"Documentary"}) {
+		t.Fatalf("Actual categories '%v' do not match expected categories.", actual.Categories)
+	}
+}
+
+func TestFormatDescriptionRemovesMarkup(t *testing.T) {
+	expected := "Stories about the things we collect & keep."
+	actual := helper.FormatDescription("<p>Stories about the <em>things</em> we collect &amp;\n keep.</p>")
+
+	if actual != expected {
+		t.Fatalf("Actual description '%s' does not match expected description '%s'.", actual, expected)
+	}
+}
+
+func TestParseDurationParsesSeconds(t *testing.T) {
+	expected := 3723
+	actual := helper.ParseDuration("3723")
+
+	if actual != expected {
+		t.Fatalf("Actual duration '%d' does not match expected duration '%d'.", actual, expected)
+	}
+}
+
+func TestParseDurationParsesClock(t *testing.T) {
+	expected := 3723
+	actual := helper.ParseDuration("1:02:03")
+
+	if actual != expected {
+		t.Fatalf("Actual duration '%d' does not match expected duration '%d'.", actual, expected)
+	}
+}
+
+func TestParseDurationHandlesMalformedDuration(t *testing.T) {
+	actual := helper.ParseDuration("1 hour")
+
+	if actual != 0 {
+		t.Fatalf("Actual duration '%d' does not match expected zero duration.", actual)
+	}
+}
+
+func TestParseReleaseDateParsesLayouts(t *testing.T) {
+	var expected int64 = 1704110400
+
+	for _, value := range []string{"Mon, 01 Jan 2024 12:00:00 +0000", "Mon, 1 Jan 2024 12:00:00 GMT", "2024-01-01T12:00:00Z"} {
+		actual := helper.ParseReleaseDate(value)
+
+		if actual != expected {
+			t.Fatalf("Actual release date '%d' of '%s' does not match expected release date '%d'.", actual, value, expected)
+		}
+	}
+}
+
+func TestParseExplicitParses(t *testing.T) {
+	if !helper.ParseExplicit(" Explicit ") || helper.ParseExplicit("clean") || helper.ParseExplicit("") {
+		t.Fatal("Actual explicit flags do not match expected explicit flags.")
+	}
+}
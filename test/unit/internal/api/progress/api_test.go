@@ -0,0 +1,345 @@
+package api_test
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/progress"
+	"github.com/muzzarellimj/grace-material-api/internal/api/progress/helper"
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/auth"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/progress"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+func TestHandlePostProgressReturnsStatusCreated(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectBook(mock, 384, "page")
+	expectUser(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO books_progress (owner,book,status,progress,date_recorded)")).
+		WithArgs(1, 1, "reading", 142, int64(1700000000)).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/progress/:type", "/api/progress/book?id=1", `{"status":"reading","progress":142,"date_recorded":1700000000}`, handler.HandlePostProgress)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostProgressRecordsAudiobookInMinutes(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectBook(mock, 500, "minute")
+	expectUser(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO books_progress (owner,book,status,progress,date_recorded)")).
+		WithArgs(1, 1, "read", 500, int64(1700000000)).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/progress/:type", "/api/progress/book?id=1", `{"status":"read","date_recorded":1700000000}`, handler.HandlePostProgress)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	var response struct {
+		Data model.ProgressEntry `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	if response.Data.Progress != 500 || response.Data.Unit != "minute" {
+		t.Fatalf("Actual entry '%+v' does not match expected finished audiobook entry.", response.Data)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostProgressHandlesInvalidStatus(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/progress/:type", "/api/progress/book?id=1", `{"status":"playing"}`, handler.HandlePostProgress)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlePostProgressHandlesProgressBeyondTotal(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectBook(mock, 384, "page")
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/progress/:type", "/api/progress/book?id=1", `{"status":"reading","progress":385}`, handler.HandlePostProgress)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleGetProgressFeedReturnsLatestActiveEntries(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectUser(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT p.id, p.book AS material, m.title, m.image, p.status, p.progress, CASE WHEN m.format = 'audiobook' AND m.duration > 0 THEN m.duration ELSE m.pages END AS total, p.date_recorded, CASE WHEN m.format = 'audiobook' AND m.duration > 0 THEN 'minute' ELSE 'page' END AS unit FROM books_progress p JOIN books m ON m.id = p.book WHERE p.owner=1")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "material", "title", "image", "status", "progress", "total", "date_recorded", "unit"}).
+			AddRow(1, 1, "The Last Wish", "", "planned", 0, 384, int64(1600000000), "page").
+			AddRow(2, 1, "The Last Wish", "", "reading", 142, 384, int64(1700000000), "page").
+			AddRow(3, 2, "Sword of Destiny", "", "reading", 12, 400, int64(1600000000), "page").
+			AddRow(4, 2, "Sword of Destiny", "", "read", 400, 400, int64(1650000000), "page"))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, "/api/progress", "/api/progress", "", handler.HandleGetProgressFeed)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data []model.ProgressEntry `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	if len(response.Data) != 1 || response.Data[0].ID != 2 || response.Data[0].Unit != "page" {
+		t.Fatalf("Actual feed '%v' does not match expected feed with only entry '2'.", response.Data)
+	}
+}
+
+func TestHandleDeleteProgressHandlesMissingEntry(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectUser(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM books_progress WHERE id=9 AND owner=1")).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodDelete, "/api/progress/:type", "/api/progress/book?entry=9", "", handler.HandleDeleteProgress)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandlePostEpisodeProgressFinishesShow(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT show FROM episodes WHERE id=2")).
+		WillReturnRows(pgxmock.NewRows([]string{"show"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT m.id AS material, m.title, m.image, m.episodes AS total FROM shows m WHERE m.id=1")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"material", "title", "image", "total"}).
+			AddRow(1, "Severance", "", 2))
+	expectUser(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO episodes_progress (owner,episode,date_watched)")).
+		WithArgs(1, 2, int64(1700000000)).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(DISTINCT w.episode) FROM episodes_progress w JOIN episodes e ON e.id = w.episode WHERE w.owner=1 AND e.show=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO shows_progress (owner,show,status,progress,date_recorded)")).
+		WithArgs(1, 1, "watched", 2, int64(1700000000)).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock, "show")
+	recorder := serve(http.MethodPost, "/api/progress/:type/episode", "/api/progress/show/episode?id=2", `{"date_watched":1700000000}`, handler.HandlePostEpisodeProgress)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	var response struct {
+		Data model.ProgressEntry `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	if response.Data.Status != "watched" || response.Data.Unit != "episode" {
+		t.Fatalf("Actual entry '%+v' does not match expected finished show entry.", response.Data)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostEpisodeProgressHandlesTypeWithoutEpisodes(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock, "book", "show")
+	recorder := serve(http.MethodPost, "/api/progress/:type/episode", "/api/progress/book/episode?id=2", "", handler.HandlePostEpisodeProgress)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlePostIssueProgressRecordsReadingComic(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT comic FROM issues WHERE id=2")).
+		WillReturnRows(pgxmock.NewRows([]string{"comic"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT m.id AS material, m.title, m.image, m.issues AS total FROM comics m WHERE m.id=1")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"material", "title", "image", "total"}).
+			AddRow(1, "Saga", "", 3))
+	expectUser(mock)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO issues_progress (owner,issue,date_read)")).
+		WithArgs(1, 2, int64(1700000000)).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(DISTINCT w.issue) FROM issues_progress w JOIN issues i ON i.id = w.issue WHERE w.owner=1 AND i.comic=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO comics_progress (owner,comic,status,progress,date_recorded)")).
+		WithArgs(1, 1, "reading", 2, int64(1700000000)).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock, "comic")
+	recorder := serve(http.MethodPost, "/api/progress/:type/issue", "/api/progress/comic/issue?id=2", `{"date_read":1700000000}`, handler.HandlePostIssueProgress)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	var response struct {
+		Data model.ProgressEntry `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	if response.Data.Status != "reading" || response.Data.Progress != 2 || response.Data.Unit != "issue" {
+		t.Fatalf("Actual entry '%+v' does not match expected reading comic entry.", response.Data)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func expectBook(mock pgxmock.PgxPoolIface, total int, unit string) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT m.id AS material, m.title, m.image, CASE WHEN m.format = 'audiobook' AND m.duration > 0 THEN m.duration ELSE m.pages END AS total, CASE WHEN m.format = 'audiobook' AND m.duration > 0 THEN 'minute' ELSE 'page' END AS unit FROM books m WHERE m.id=1")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"material", "title", "image", "total", "unit"}).
+			AddRow(1, "The Last Wish", "", total, unit))
+}
+
+func expectUser(mock pgxmock.PgxPoolIface) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM users WHERE reference='default'")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "reference", "date_created"}).
+			AddRow(1, "default", int64(0)))
+}
+
+func createHandler(mock pgxmock.PgxPoolIface, materialTypes ...string) *api.Handler {
+	logger := log.New(io.Discard, "", 0)
+
+	if len(materialTypes) == 0 {
+		materialTypes = []string{"book"}
+	}
+
+	return api.NewHandler(helper.NewRepository(mock, userHelper.NewRepository(mock, logger), materialTypes, logger))
+}
+
+func serve(method string, route string, target string, body string, handle gin.HandlerFunc) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Handle(method, route, middleware.Authorize(auth.NewAuthenticator(config.AuthConfig{}, time.Second), auth.ScopeRead), handle)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(method, target, strings.NewReader(body)))
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
@@ -0,0 +1,129 @@
+package api_test
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/recommendation"
+	"github.com/muzzarellimj/grace-material-api/internal/api/recommendation/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/auth"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/recommendation"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+var candidateColumns = []string{"id", "count"}
+
+func TestHandleGetRecommendationSliceReturnsRankedRecommendations(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM movies WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM movies_production_companies WHERE movie=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT b.movie AS id, COUNT(*) AS count FROM movies_production_companies b WHERE b.production_company IN (SELECT s.production_company FROM movies_production_companies s WHERE s.movie=1) AND b.movie <> 1 GROUP BY b.movie")).
+		WillReturnRows(pgxmock.NewRows(candidateColumns).AddRow(2, 1))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM movies_genres WHERE movie=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM movies_genres b WHERE b.genre IN (SELECT s.genre FROM movies_genres s WHERE s.movie=1) AND b.movie <> 1 GROUP BY b.movie")).
+		WillReturnRows(pgxmock.NewRows(candidateColumns).AddRow(2, 2).AddRow(3, 1))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT m.id AS material, m.title, m.image FROM movies m WHERE m.id IN (2,3) AND NOT (m.id IN (SELECT r.movie FROM collections_movies r JOIN collections c ON c.id = r.collection JOIN users u ON u.id = c.owner WHERE u.reference='default'))")).
+		WillReturnRows(pgxmock.NewRows([]string{"material", "title", "image"}).
+			AddRow(3, "The Thing", "").
+			AddRow(2, "Aliens", ""))
+
+	handler := createHandler(mock)
+	recorder := serve("/api/recommendation/movie?id=1", handler.HandleGetRecommendationSlice)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data []model.Recommendation `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	if len(response.Data) != 2 || response.Data[0].Material != 2 || response.Data[0].Score != 1 || response.Data[1].Score != 0.25 {
+		t.Fatalf("Actual recommendations '%v' do not match expected recommendations ranked by score.", response.Data)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleGetRecommendationSliceHandlesMissingMaterial(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM movies WHERE id=9")).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+	handler := createHandler(mock)
+	recorder := serve("/api/recommendation/movie?id=9", handler.HandleGetRecommendationSlice)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandleGetRecommendationSliceHandlesUnsupportedType(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock)
+	recorder := serve("/api/recommendation/book?id=1", handler.HandleGetRecommendationSlice)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func createHandler(mock pgxmock.PgxPoolIface) *api.Handler {
+	return api.NewHandler(helper.NewRepository(mock, []string{"movie"}, log.New(io.Discard, "", 0)))
+}
+
+func serve(target string, handle gin.HandlerFunc) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/api/recommendation/:type", middleware.AuthorizeRead(auth.NewAuthenticator(config.AuthConfig{}, time.Second)), handle)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
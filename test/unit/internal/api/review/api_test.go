@@ -0,0 +1,174 @@
+package api_test
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/review"
+	"github.com/muzzarellimj/grace-material-api/internal/api/review/helper"
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/auth"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+var reviewColumns = []string{"id", "material", "title", "image", "rating", "review", "date_created", "date_updated"}
+
+func TestHandlePostReviewReturnsStatusCreated(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectMovie(mock)
+	expectUser(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM movies_reviews r JOIN movies m ON m.id = r.movie WHERE r.owner=1 AND r.movie=1")).
+		WillReturnRows(pgxmock.NewRows(reviewColumns))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO movies_reviews (owner,movie,rating,review,date_created,date_updated)")).
+		WithArgs(1, 1, 8, "Still holds up.", pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/review/:type", "/api/review/movie?id=1", `{"rating":8,"review":"Still holds up."}`, handler.HandlePostReview)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostReviewReturnsUpdatedReview(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectMovie(mock)
+	expectUser(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM movies_reviews r JOIN movies m ON m.id = r.movie WHERE r.owner=1 AND r.movie=1")).
+		WillReturnRows(pgxmock.NewRows(reviewColumns).AddRow(4, 1, "Alien", "", 6, "", int64(1600000000), int64(1600000000)))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE movies_reviews SET rating=@rating,review=@review,date_updated=@date_updated WHERE id=4")).
+		WithArgs(8, "", pgxmock.AnyArg()).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/review/:type", "/api/review/movie?id=1", `{"rating":8}`, handler.HandlePostReview)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+}
+
+func TestHandlePostReviewHandlesInvalidRating(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/review/:type", "/api/review/movie?id=1", `{"rating":11}`, handler.HandlePostReview)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleGetReviewSliceReturnsPage(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectUser(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT r.id, r.movie AS material, m.title, m.image, r.rating, r.review, r.date_created, r.date_updated FROM movies_reviews r JOIN movies m ON m.id = r.movie WHERE r.owner=1")).
+		WillReturnRows(pgxmock.NewRows(reviewColumns).
+			AddRow(1, 1, "Alien", "", 8, "", int64(0), int64(1600000000)).
+			AddRow(2, 2, "Aliens", "", 9, "", int64(0), int64(1700000000)).
+			AddRow(3, 3, "Alien 3", "", 5, "", int64(0), int64(1500000000)))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, "/api/review", "/api/review?page=1&limit=2", "", handler.HandleGetReviewSlice)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data []struct {
+			ID int `json:"id"`
+		} `json:"data"`
+		Pagination util.Pagination `json:"pagination"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	if len(response.Data) != 2 || response.Data[0].ID != 2 || response.Pagination.Total != 3 {
+		t.Fatalf("Actual page '%v' does not match expected first page of most recently updated reviews.", response)
+	}
+}
+
+func expectMovie(mock pgxmock.PgxPoolIface) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT m.id AS material, m.title, m.image FROM movies m WHERE m.id=1")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"material", "title", "image"}).
+			AddRow(1, "Alien", ""))
+}
+
+func expectUser(mock pgxmock.PgxPoolIface) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM users WHERE reference='default'")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "reference", "date_created"}).
+			AddRow(1, "default", int64(0)))
+}
+
+func createHandler(mock pgxmock.PgxPoolIface) *api.Handler {
+	logger := log.New(io.Discard, "", 0)
+
+	return api.NewHandler(helper.NewRepository(mock, userHelper.NewRepository(mock, logger), []string{"movie"}, logger))
+}
+
+func serve(method string, route string, target string, body string, handle gin.HandlerFunc) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Handle(method, route, middleware.Authorize(auth.NewAuthenticator(config.AuthConfig{}, time.Second), auth.ScopeRead), handle)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(method, target, strings.NewReader(body)))
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
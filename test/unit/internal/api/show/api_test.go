@@ -0,0 +1,165 @@
+package api_test
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/show"
+	"github.com/muzzarellimj/grace-material-api/internal/api/show/helper"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/show"
+	TMDBModel "github.com/muzzarellimj/grace-material-api/internal/model/third_party/themoviedb.org"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+type fakeProvider struct{}
+
+func (provider fakeProvider) TMDBGetShow(id string) (TMDBModel.TMDBShowDetailResponse, error) {
+	return TMDBModel.TMDBShowDetailResponse{
+		ID:               95396,
+		Name:             "Severance",
+		FirstAirDate:     "2022-02-18",
+		Status:           "Returning Series",
+		NumberOfSeasons:  1,
+		NumberOfEpisodes: 9,
+		EpisodeRunTime:   []int{50},
+		Seasons: []TMDBModel.TMDBSeason{
+			{ID: 134566, Name: "Specials", SeasonNumber: 0},
+			{ID: 134567, Name: "Season 1", SeasonNumber: 1},
+		},
+	}, nil
+}
+
+func (provider fakeProvider) TMDBGetSeason(id string, number int) (TMDBModel.TMDBSeasonDetailResponse, error) {
+	return TMDBModel.TMDBSeasonDetailResponse{
+		ID:           134567,
+		Name:         "Season 1",
+		SeasonNumber: number,
+		AirDate:      "2022-02-18",
+		Episodes: []TMDBModel.TMDBEpisode{
+			{ID: 1983587, Name: "Good News About Hell", SeasonNumber: number, EpisodeNumber: 1, AirDate: "2022-02-18", Runtime: 57},
+		},
+	}, nil
+}
+
+func (provider fakeProvider) TMDBSearchShow(name string) (TMDBModel.TMDBShowSearchResponse, error) {
+	return TMDBModel.TMDBShowSearchResponse{}, nil
+}
+
+func TestHandlePostShowStoresSeasonsAndEpisodes(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM shows WHERE reference=95396")).
+		WillReturnRows(pgxmock.NewRows(showColumns))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO shows (title,tagline,description,first_air_date,last_air_date,status,seasons,episodes,runtime,image,reference)")).
+		WithArgs("Severance", "", "", int64(1645142400), int64(0), "Returning Series", 1, 9, 50, "", 95396).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO seasons (show,number,title,description,air_date,image,reference)")).
+		WithArgs(1, 1, "Season 1", "", int64(1645142400), "", 134567).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO episodes (show,season,number,title,description,air_date,runtime,image,reference)")).
+		WithArgs(1, 1, 1, "Good News About Hell", "", int64(1645142400), 57, "", 1983587).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, handler.HandlePostShow, "/api/show?id=95396")
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleGetShowReturnsSeasonsInOrder(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM shows WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows(showColumns).
+			AddRow(1, "Severance", "", "", int64(1645142400), int64(0), "Returning Series", 2, 19, 50, "", 95396))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM shows_genres WHERE show=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"show", "genre"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM shows_networks WHERE show=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"show", "network"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM seasons WHERE show=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "show", "number", "title", "description", "air_date", "image", "reference"}).
+			AddRow(2, 1, 2, "Season 2", "", int64(0), "", 2).
+			AddRow(1, 1, 1, "Season 1", "", int64(0), "", 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM episodes WHERE show=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "show", "season", "number", "title", "description", "air_date", "runtime", "image", "reference"}).
+			AddRow(3, 1, 2, 1, "Hello, Ms. Cobel", "", int64(0), 50, "", 3).
+			AddRow(2, 1, 1, 2, "Half Loop", "", int64(0), 53, "", 2).
+			AddRow(1, 1, 1, 1, "Good News About Hell", "", int64(0), 57, "", 1))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, handler.HandleGetShow, "/api/show?id=1")
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data []model.Show `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	seasons := response.Data[0].Seasons
+
+	if len(seasons) != 2 || seasons[0].Number != 1 || len(seasons[0].Episodes) != 2 || seasons[0].Episodes[0].Title != "Good News About Hell" || len(seasons[1].Episodes) != 1 {
+		t.Fatalf("Actual seasons '%+v' do not match expected seasons in order.", seasons)
+	}
+}
+
+var showColumns = []string{"id", "title", "tagline", "description", "first_air_date", "last_air_date", "status", "seasons", "episodes", "runtime", "image", "reference"}
+
+func createHandler(mock pgxmock.PgxPoolIface) *api.Handler {
+	return api.NewHandler(helper.NewRepository(mock, fakeProvider{}, log.New(io.Discard, "", 0)))
+}
+
+func serve(method string, handle gin.HandlerFunc, target string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	recorder := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(recorder)
+	context.Request = httptest.NewRequest(method, target, nil)
+
+	handle(context)
+
+	context.Writer.WriteHeaderNow()
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
@@ -0,0 +1,185 @@
+package api_test
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/statistics"
+	"github.com/muzzarellimj/grace-material-api/internal/api/statistics/helper"
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/auth"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/statistics"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+var (
+	materialColumns = []string{"id", "release_date", "total"}
+	unitColumns     = []string{"id", "release_date", "total", "unit"}
+	entryColumns    = []string{"id", "name", "count"}
+)
+
+func TestHandleGetStatisticsReturnsYearInReview(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectUser(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT m.id, m.release_date AS release_date, m.runtime AS total FROM collections_movies r JOIN movies m ON m.id = r.movie WHERE r.collection IN (SELECT c.id FROM collections c WHERE c.owner=1) AND r.date_added >= 1704067200 AND r.date_added < 1735689600")).
+		WillReturnRows(pgxmock.NewRows(materialColumns).
+			AddRow(1, int64(296697600), 117).
+			AddRow(2, int64(522201600), 137).
+			AddRow(3, int64(517363200), 120))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM movies m WHERE m.id IN (SELECT p.movie FROM movies_progress p WHERE p.owner=1 AND p.status='watched' AND p.date_recorded >= 1704067200 AND p.date_recorded < 1735689600)")).
+		WillReturnRows(pgxmock.NewRows(materialColumns).
+			AddRow(1, int64(296697600), 117).
+			AddRow(2, int64(522201600), 137))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT f.id, f.name AS name, COUNT(DISTINCT r.movie) AS count FROM collections_movies r JOIN movies_genres b ON b.movie = r.movie JOIN mgenres f ON f.id = b.genre")).
+		WillReturnRows(pgxmock.NewRows(entryColumns).
+			AddRow(1, "Horror", 2).
+			AddRow(2, "Science Fiction", 3))
+	mock.ExpectQuery(regexp.QuoteMeta("JOIN production_companies f ON f.id = b.production_company")).
+		WillReturnRows(pgxmock.NewRows(entryColumns))
+
+	handler := createHandler(mock, "movie")
+	recorder := serve("/api/statistics?year=2024", handler.HandleGetStatistics)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data model.Statistics `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	movies := response.Data.Materials["movie"]
+
+	if movies.Collected != 3 || movies.Finished != 2 || response.Data.RuntimeWatched != 254 {
+		t.Fatalf("Actual statistics '%v' do not match expected counts and runtime.", response.Data)
+	}
+
+	if len(movies.Top["genres"]) != 2 || movies.Top["genres"][0].Name != "Science Fiction" {
+		t.Fatalf("Actual top genres '%v' do not match expected genres, most common first.", movies.Top["genres"])
+	}
+
+	if len(movies.ReleaseYears) != 2 || movies.ReleaseYears[0].Year != 1979 || movies.ReleaseYears[1].Count != 2 {
+		t.Fatalf("Actual release years '%v' do not match expected distribution.", movies.ReleaseYears)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleGetStatisticsSeparatesPagesReadFromMinutesListened(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectUser(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("CASE WHEN m.format = 'audiobook' AND m.duration > 0 THEN 'minute' ELSE 'page' END AS unit FROM collections_books r")).
+		WillReturnRows(pgxmock.NewRows(unitColumns))
+	mock.ExpectQuery(regexp.QuoteMeta("CASE WHEN m.format = 'audiobook' AND m.duration > 0 THEN m.duration ELSE m.pages END AS total, CASE WHEN m.format = 'audiobook' AND m.duration > 0 THEN 'minute' ELSE 'page' END AS unit FROM books m")).
+		WillReturnRows(pgxmock.NewRows(unitColumns).
+			AddRow(1, int64(0), 320, "page").
+			AddRow(2, int64(0), 690, "minute"))
+	mock.ExpectQuery(regexp.QuoteMeta("JOIN topics f ON f.id = b.topic")).
+		WillReturnRows(pgxmock.NewRows(entryColumns))
+	mock.ExpectQuery(regexp.QuoteMeta("JOIN authors f ON f.id = b.author")).
+		WillReturnRows(pgxmock.NewRows(entryColumns))
+	mock.ExpectQuery(regexp.QuoteMeta("JOIN publishers f ON f.id = b.publisher")).
+		WillReturnRows(pgxmock.NewRows(entryColumns))
+
+	handler := createHandler(mock, "book")
+	recorder := serve("/api/statistics", handler.HandleGetStatistics)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+
+	var response struct {
+		Data model.Statistics `json:"data"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if err != nil {
+		t.Fatalf("Unable to decode response body: %v\n", err)
+	}
+
+	if response.Data.Materials["book"].Finished != 2 || response.Data.PagesRead != 320 || response.Data.MinutesListened != 690 {
+		t.Fatalf("Actual statistics '%v' do not match expected pages read and minutes listened.", response.Data)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandleGetStatisticsHandlesInvalidYear(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock, "movie")
+	recorder := serve("/api/statistics?year=last", handler.HandleGetStatistics)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func expectUser(mock pgxmock.PgxPoolIface) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM users WHERE reference='default'")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "reference", "date_created"}).
+			AddRow(1, "default", int64(0)))
+}
+
+func createHandler(mock pgxmock.PgxPoolIface, materialTypes ...string) *api.Handler {
+	logger := log.New(io.Discard, "", 0)
+
+	return api.NewHandler(helper.NewRepository(mock, userHelper.NewRepository(mock, logger), materialTypes, logger))
+}
+
+func serve(target string, handle gin.HandlerFunc) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/api/statistics", middleware.Authorize(auth.NewAuthenticator(config.AuthConfig{}, time.Second), auth.ScopeRead), handle)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
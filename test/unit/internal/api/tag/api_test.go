@@ -0,0 +1,136 @@
+package api_test
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/muzzarellimj/grace-material-api/internal/api/tag"
+	"github.com/muzzarellimj/grace-material-api/internal/api/tag/helper"
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/auth"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+	"github.com/muzzarellimj/grace-material-api/internal/material"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+func TestHandlePostMaterialTagReturnsStatusCreated(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM games WHERE id=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+	expectUser(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM tags WHERE owner=1 AND name='couch co-op'")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "owner", "name"}))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO tags (owner,name)")).
+		WithArgs(1, "couch co-op").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectCommit()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM tags WHERE id=2 AND id IN (SELECT tag FROM games_tags WHERE game=1)")).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO games_tags (game,tag)")).
+		WithArgs(1, 2).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/tag/:type", "/api/tag/game?id=1", `{"name":" couch co-op "}`, handler.HandlePostMaterialTag)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusCreated)
+	}
+
+	err := mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestHandlePostMaterialTagHandlesEmptyName(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodPost, "/api/tag/:type", "/api/tag/game?id=1", `{"name":"  "}`, handler.HandlePostMaterialTag)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleGetTagSliceReturnsStatusNoContent(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	expectUser(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM tags WHERE owner=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "owner", "name"}))
+
+	handler := createHandler(mock)
+	recorder := serve(http.MethodGet, "/api/tag", "/api/tag", "", handler.HandleGetTagSlice)
+
+	if recorder.Code != http.StatusNoContent {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusNoContent)
+	}
+}
+
+func TestConstraintFiltersByOwnerAndName(t *testing.T) {
+	expected := "m.id IN (SELECT r.movie FROM movies_tags r JOIN tags t ON t.id = r.tag JOIN users u ON u.id = t.owner WHERE u.reference='default' AND t.name='director''s cut')"
+	actual := helper.Constraint("m.id", material.TypeMovie, "default", "director's cut")
+
+	if actual != expected {
+		t.Fatalf("Actual constraint '%s' does not match expected constraint '%s'.", actual, expected)
+	}
+}
+
+func expectUser(mock pgxmock.PgxPoolIface) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM users WHERE reference='default'")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "reference", "date_created"}).
+			AddRow(1, "default", int64(0)))
+}
+
+func createHandler(mock pgxmock.PgxPoolIface) *api.Handler {
+	logger := log.New(io.Discard, "", 0)
+
+	return api.NewHandler(helper.NewRepository(mock, userHelper.NewRepository(mock, logger), []string{"game", "movie"}, logger))
+}
+
+func serve(method string, route string, target string, body string, handle gin.HandlerFunc) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Handle(method, route, middleware.Authorize(auth.NewAuthenticator(config.AuthConfig{}, time.Second), auth.ScopeRead), handle)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(method, target, strings.NewReader(body)))
+
+	return recorder
+}
+
+func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	return mock
+}
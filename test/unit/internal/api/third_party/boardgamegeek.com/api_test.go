@@ -0,0 +1,97 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	api "github.com/muzzarellimj/grace-material-api/internal/api/third_party/boardgamegeek.com"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+const thingResponse = `<?xml version="1.0" encoding="utf-8"?>
+<items termsofuse="https://boardgamegeek.com/xmlapi/termsofuse">
+	<item type="boardgame" id="13">
+		<thumbnail>https://cf.geekdo-images.com/thumb.jpg</thumbnail>
+		<image>https://cf.geekdo-images.com/original.jpg</image>
+		<name type="primary" sortindex="1" value="CATAN" />
+		<name type="alternate" sortindex="1" value="Die Siedler von Catan" />
+		<description>Trade &amp;amp; build.&amp;#10;</description>
+		<yearpublished value="1995" />
+		<minplayers value="3" />
+		<maxplayers value="4" />
+		<playingtime value="120" />
+		<minplaytime value="60" />
+		<maxplaytime value="120" />
+		<minage value="10" />
+		<link type="boardgamecategory" id="1026" value="Negotiation" />
+		<link type="boardgamemechanic" id="2072" value="Dice Rolling" />
+		<link type="boardgamedesigner" id="11" value="Klaus Teuber" />
+	</item>
+</items>`
+
+func TestBGGGetBoardGameDecodesThing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Query().Get("id") != "13" || request.URL.Query().Get("type") != "boardgame" {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		fmt.Fprint(writer, thingResponse)
+	}))
+
+	defer server.Close()
+
+	actual, err := createClient(server.URL).BGGGetBoardGame("13")
+
+	if err != nil {
+		t.Fatalf("Unable to execute request to get BoardGameGeek board game: %v\n", err)
+	}
+
+	if actual.ID != 13 || len(actual.Names) != 2 || actual.Names[0].Value != "CATAN" || actual.MaxPlayers.Value != 4 || len(actual.Links) != 3 {
+		t.Fatalf("Actual board game '%+v' does not match expected board game.", actual)
+	}
+
+	if actual.Description != "Trade &amp; build.&#10;" {
+		t.Fatalf("Actual description '%s' does not match expected description.", actual.Description)
+	}
+}
+
+func TestBGGGetBoardGameReturnsEmpty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `<?xml version="1.0" encoding="utf-8"?><items termsofuse="https://boardgamegeek.com/xmlapi/termsofuse"></items>`)
+	}))
+
+	defer server.Close()
+
+	actual, err := createClient(server.URL).BGGGetBoardGame("0")
+
+	if err != nil {
+		t.Fatalf("Unable to execute request to get BoardGameGeek board game: %v\n", err)
+	}
+
+	if actual.ID != 0 {
+		t.Fatalf("Actual numeric identifier '%d' does not match expected zero numeric identifier.", actual.ID)
+	}
+}
+
+func TestBGGGetBoardGameReturnsErrorWhenQueued(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusAccepted)
+	}))
+
+	defer server.Close()
+
+	_, err := createClient(server.URL).BGGGetBoardGame("13")
+
+	if err == nil {
+		t.Fatal("Actual nil error does not match expected error for a queued request.")
+	}
+}
+
+func createClient(base string) *api.Client {
+	return api.NewClient(config.BGGConfig{Base: base}, 5*time.Second)
+}
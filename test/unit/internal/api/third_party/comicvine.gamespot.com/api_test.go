@@ -0,0 +1,130 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	api "github.com/muzzarellimj/grace-material-api/internal/api/third_party/comicvine.gamespot.com"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+const volumeResponse = `{
+	"error": "OK",
+	"limit": 1,
+	"offset": 0,
+	"number_of_page_results": 1,
+	"number_of_total_results": 1,
+	"status_code": 1,
+	"results": {
+		"id": 48488,
+		"name": "Saga",
+		"deck": "",
+		"description": "<p>An epic space opera.</p>",
+		"start_year": "2012",
+		"count_of_issues": 66,
+		"image": {"original_url": "https://comicvine.gamespot.com/a/uploads/original/saga.jpg"},
+		"publisher": {"id": 513, "name": "Image"},
+		"first_issue": {"id": 323340, "name": "Chapter One", "issue_number": "1"}
+	}
+}`
+
+func TestCVGetVolumeDecodesVolume(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != "/volume/4050-48488/" || request.URL.Query().Get("api_key") != "GraceTestSecret" || request.Header.Get("User-Agent") != api.CVUserAgent {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		fmt.Fprint(writer, volumeResponse)
+	}))
+
+	defer server.Close()
+
+	actual, err := createClient(server.URL).CVGetVolume("48488")
+
+	if err != nil {
+		t.Fatalf("Unable to execute request to get Comic Vine volume: %v\n", err)
+	}
+
+	if actual.ID != 48488 || actual.Name != "Saga" || actual.Publisher.ID != 513 || actual.FirstIssue.ID != 323340 {
+		t.Fatalf("Actual volume '%+v' does not match expected volume.", actual)
+	}
+}
+
+func TestCVGetVolumeReturnsEmpty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"error": "Object Not Found", "status_code": 101, "results": []}`)
+	}))
+
+	defer server.Close()
+
+	actual, err := createClient(server.URL).CVGetVolume("0")
+
+	if err != nil {
+		t.Fatalf("Unable to execute request to get Comic Vine volume: %v\n", err)
+	}
+
+	if actual.ID != 0 {
+		t.Fatalf("Actual numeric identifier '%d' does not match expected zero numeric identifier.", actual.ID)
+	}
+}
+
+func TestCVGetVolumeReturnsErrorWithInvalidKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"error": "Invalid API Key", "status_code": 100, "results": []}`)
+	}))
+
+	defer server.Close()
+
+	_, err := createClient(server.URL).CVGetVolume("48488")
+
+	if err == nil {
+		t.Fatal("Actual nil error does not match expected error for an invalid API key.")
+	}
+}
+
+func TestCVGetVolumeIssuesFetchesEveryPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Query().Get("filter") != "volume:48488" {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		offset, _ := strconv.Atoi(request.URL.Query().Get("offset"))
+		count := min(api.CVPageLimit, 150-offset)
+
+		fmt.Fprintf(writer, `{"error": "OK", "status_code": 1, "offset": %d, "number_of_total_results": 150, "results": [`, offset)
+
+		for index := 0; index < count; index++ {
+			if index > 0 {
+				fmt.Fprint(writer, ",")
+			}
+
+			fmt.Fprintf(writer, `{"id": %d, "issue_number": "%d"}`, offset+index+1, offset+index+1)
+		}
+
+		fmt.Fprint(writer, `]}`)
+	}))
+
+	defer server.Close()
+
+	actual, err := createClient(server.URL).CVGetVolumeIssues("48488")
+
+	if err != nil {
+		t.Fatalf("Unable to execute request to get Comic Vine volume issues: %v\n", err)
+	}
+
+	if len(actual) != 150 || actual[149].IssueNumber != "150" {
+		t.Fatalf("Actual issue count '%d' does not match expected issue count '150'.", len(actual))
+	}
+}
+
+func createClient(base string) *api.Client {
+	return api.NewClient(config.ComicVineConfig{Base: base, APIKey: "GraceTestSecret"}, 5*time.Second)
+}
@@ -0,0 +1,167 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	api "github.com/muzzarellimj/grace-material-api/internal/api/third_party/feed"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+const rssFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+	<channel>
+		<title>Grace Radio</title>
+		<link>https://example.com/radio</link>
+		<language>en-us</language>
+		<description>Stories about the things we collect.</description>
+		<itunes:author>Grace</itunes:author>
+		<itunes:explicit>false</itunes:explicit>
+		<itunes:image href="https://example.com/radio.jpg"/>
+		<itunes:category text="Society &amp; Culture">
+			<itunes:category text="Documentary"/>
+		</itunes:category>
+		<item>
+			<title>Pilot</title>
+			<itunes:title>Pilot&nbsp;Episode</itunes:title>
+			<guid>grace-radio-1</guid>
+			<pubDate>Mon, 01 Jan 2024 12:00:00 +0000</pubDate>
+			<enclosure url="https://example.com/radio/1.mp3" type="audio/mpeg" length="1024"/>
+			<itunes:duration>30:00</itunes:duration>
+		</item>
+	</channel>
+</rss>`
+
+const atomFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+	<title>Grace Radio</title>
+	<author><name>Grace</name></author>
+	<link href="https://example.com/radio"/>
+	<entry>
+		<id>urn:grace-radio:1</id>
+		<title>Pilot</title>
+		<published>2024-01-01T12:00:00Z</published>
+		<link rel="enclosure" type="audio/mpeg" href="https://example.com/radio/1.mp3"/>
+	</entry>
+</feed>`
+
+const latinFeed = "<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n<rss version=\"2.0\"><channel><title>Caf\xe9 Radio</title></channel></rss>"
+
+func TestFeedGetDocumentDecodesRSS(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Header.Get("User-Agent") != api.FeedUserAgent {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		writer.Header().Set("Content-Type", "application/rss+xml")
+
+		fmt.Fprint(writer, rssFeed)
+	}))
+
+	defer server.Close()
+
+	actual, err := createClient().FeedGetDocument(server.URL)
+
+	if err != nil {
+		t.Fatalf("Unable to execute request to get feed document: %v\n", err)
+	}
+
+	channel := actual.Channel
+
+	if actual.XMLName.Local != "rss" || channel.Title != "Grace Radio" || channel.ITunesAuthor != "Grace" || channel.ITunesImage.Href != "https://example.com/radio.jpg" {
+		t.Fatalf("Actual channel '%+v' does not match expected channel.", channel)
+	}
+
+	if len(channel.ITunesCategories) != 1 || channel.ITunesCategories[0].Text != "Society & Culture" || channel.ITunesCategories[0].Subcategories[0].Text != "Documentary" {
+		t.Fatalf("Actual categories '%+v' do not match expected categories.", channel.ITunesCategories)
+	}
+
+	if len(channel.Items) != 1 || channel.Items[0].Title != "Pilot" || channel.Items[0].ITunesTitle != "Pilot Episode" || channel.Items[0].Enclosure.URL != "https://example.com/radio/1.mp3" {
+		t.Fatalf("Actual items '%+v' do not match expected items.", channel.Items)
+	}
+}
+
+func TestFeedGetDocumentDecodesAtom(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, atomFeed)
+	}))
+
+	defer server.Close()
+
+	actual, err := createClient().FeedGetDocument(server.URL)
+
+	if err != nil {
+		t.Fatalf("Unable to execute request to get feed document: %v\n", err)
+	}
+
+	if actual.XMLName.Local != "feed" || actual.Title != "Grace Radio" || len(actual.Entries) != 1 || actual.Entries[0].Links[0].Rel != "enclosure" {
+		t.Fatalf("Actual document '%+v' does not match expected document.", actual)
+	}
+}
+
+func TestFeedGetDocumentDecodesDeclaredEncoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, latinFeed)
+	}))
+
+	defer server.Close()
+
+	actual, err := createClient().FeedGetDocument(server.URL)
+
+	if err != nil {
+		t.Fatalf("Unable to execute request to get feed document: %v\n", err)
+	}
+
+	if actual.Channel.Title != "Café Radio" {
+		t.Fatalf("Actual title '%s' does not match expected title '%s'.", actual.Channel.Title, "Café Radio")
+	}
+}
+
+func TestFeedGetDocumentReturnsEmpty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+	}))
+
+	defer server.Close()
+
+	actual, err := createClient().FeedGetDocument(server.URL)
+
+	if err != nil {
+		t.Fatalf("Unable to execute request to get feed document: %v\n", err)
+	}
+
+	if actual.XMLName.Local != "" {
+		t.Fatalf("Actual root '%s' does not match expected empty root.", actual.XMLName.Local)
+	}
+}
+
+func TestFeedGetDocumentReturnsErrorWithoutFeed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, "<html><body>Not a feed.</body></html>")
+	}))
+
+	defer server.Close()
+
+	_, err := createClient().FeedGetDocument(server.URL)
+
+	if err == nil {
+		t.Fatal("Unable to catch error with a document that is not a feed.")
+	}
+}
+
+func TestFeedGetDocumentReturnsErrorWithInvalidURL(t *testing.T) {
+	_, err := createClient().FeedGetDocument("file:///etc/passwd")
+
+	if err == nil {
+		t.Fatal("Unable to catch error with a feed URL that is not HTTP or HTTPS.")
+	}
+}
+
+func createClient() *api.Client {
+	return api.NewClient(config.PodcastConfig{}, 5*time.Second)
+}
@@ -1,10 +1,13 @@
 package api_test
 
 import (
+	"os"
 	"testing"
+	"time"
 
 	"github.com/joho/godotenv"
 	api "github.com/muzzarellimj/grace-material-api/internal/api/third_party/igdb.com"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
 	model "github.com/muzzarellimj/grace-material-api/internal/model/third_party/igdb.com"
 )
 
@@ -13,7 +16,7 @@ func TestIGDBGetResourceReturnsGame(t *testing.T) {
 
 	expectedTitle := "The Last of Us"
 
-	actual, err := api.IGDBGetResource[model.IGDBGameResponse](api.IGDBEndpointGame, "fields id,cover.*,first_release_date,franchises.*,genres.*,involved_companies.*,name,platforms.*,storyline,summary; where id = 1009;")
+	actual, err := api.IGDBGetResource[model.IGDBGameResponse](createClient(), api.IGDBEndpointGame, "fields id,cover.*,first_release_date,franchises.*,genres.*,involved_companies.*,name,platforms.*,storyline,summary; where id = 1009;")
 
 	if err != nil {
 		t.Fatalf("Unable to execute request to get IGDB resource: %v\n", err)
@@ -27,7 +30,7 @@ func TestIGDBGetResourceReturnsGame(t *testing.T) {
 func TestIGDBGetResourceReturnsEmpty(t *testing.T) {
 	godotenv.Load("../../../../../../.env")
 
-	actual, err := api.IGDBGetResource[model.IGDBGameResponse](api.IGDBEndpointGame, "fields id,cover.*,first_release_date,franchises.*,genres.*,involved_companies.*,name,platforms.*,storyline,summary; where id = -1;")
+	actual, err := api.IGDBGetResource[model.IGDBGameResponse](createClient(), api.IGDBEndpointGame, "fields id,cover.*,first_release_date,franchises.*,genres.*,involved_companies.*,name,platforms.*,storyline,summary; where id = -1;")
 
 	if err != nil {
 		t.Fatalf("Unable to execute request to get IGDB resource: %v\n", err)
@@ -37,3 +40,10 @@ func TestIGDBGetResourceReturnsEmpty(t *testing.T) {
 		t.Fatalf("Actual numeric identifier '%d' does not match expected zero numeric identifier", actual.ID)
 	}
 }
+
+func createClient() *api.Client {
+	return api.NewClient(config.IGDBConfig{
+		Host:   os.Getenv("AWS_PROXY_HOST"),
+		APIKey: os.Getenv("AWS_PROXY_API_KEY"),
+	}, 10*time.Second)
+}
@@ -3,17 +3,21 @@ package api_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	api "github.com/muzzarellimj/grace-material-api/internal/api/third_party/openlibrary.org"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
 )
 
+var client = api.NewClient(config.OpenLibraryConfig{}, 10*time.Second)
+
 func TestOLGetAuthorReturnsOLAuthorResponse(t *testing.T) {
 	id := "OL368638A"
 
 	expectedId := fmt.Sprint("/authors/", id)
 	expectedName := "Andrzej Sapkowski"
 
-	author, err := api.OLGetAuthor(id)
+	author, err := client.OLGetAuthor(id)
 
 	if err != nil {
 		t.Fatalf("Unable to retrieve and decode author '%s': %v\n", id, err)
@@ -31,7 +35,7 @@ func TestOLGetAuthorReturnsOLAuthorResponse(t *testing.T) {
 func TestOLGetAuthorReturnsEmptyOLAuthorResponse(t *testing.T) {
 	id := "OL0A"
 
-	author, err := api.OLGetAuthor(id)
+	author, err := client.OLGetAuthor(id)
 
 	if err != nil {
 		t.Fatalf("Unable to retrieve and decode author '%s': %v\n", id, err)
@@ -45,7 +49,7 @@ func TestOLGetAuthorReturnsEmptyOLAuthorResponse(t *testing.T) {
 func TestOLGetAuthorReturnsError(t *testing.T) {
 	id := ""
 
-	author, err := api.OLGetAuthor(id)
+	author, err := client.OLGetAuthor(id)
 
 	if err == nil {
 		t.Fatal("Unable to catch error with missing 'id' argument.")
@@ -62,7 +66,7 @@ func TestOLGetEditionReturnsOLEditionResponse(t *testing.T) {
 	expectedId := "/books/OL37765857M"
 	expectedTitle := "The Last Wish"
 
-	edition, err := api.OLGetEdition(id)
+	edition, err := client.OLGetEdition(id)
 
 	if err != nil {
 		t.Fatalf("Unable to retrieve and decode edition '%s': %v\n", id, err)
@@ -80,7 +84,7 @@ func TestOLGetEditionReturnsOLEditionResponse(t *testing.T) {
 func TestOLGetEditionReturnsEmptyOLEditionResponse(t *testing.T) {
 	id := "OL0M"
 
-	edition, err := api.OLGetEdition(id)
+	edition, err := client.OLGetEdition(id)
 
 	if err != nil {
 		t.Fatalf("Unable to retrieve and decode edition '%s': %v\n", id, err)
@@ -94,7 +98,7 @@ func TestOLGetEditionReturnsEmptyOLEditionResponse(t *testing.T) {
 func TestOLGetEditionReturnsError(t *testing.T) {
 	id := ""
 
-	edition, err := api.OLGetEdition(id)
+	edition, err := client.OLGetEdition(id)
 
 	if err == nil {
 		t.Fatal("Unable to catch error with missing 'id' argument.")
@@ -111,7 +115,7 @@ func TestOLGetWorkReturnsOLWorkResponse(t *testing.T) {
 	expectedId := fmt.Sprint("/works/", id)
 	expectedTitle := "The Last Wish"
 
-	work, err := api.OLGetWork(id)
+	work, err := client.OLGetWork(id)
 
 	if err != nil {
 		t.Fatalf("Unable to retrieve and decode work '%s': %v\n", id, err)
@@ -129,7 +133,7 @@ func TestOLGetWorkReturnsOLWorkResponse(t *testing.T) {
 func TestOLGetWorkReturnsEmptyOLWorkResponse(t *testing.T) {
 	id := "OL0W"
 
-	work, err := api.OLGetWork(id)
+	work, err := client.OLGetWork(id)
 
 	if err != nil {
 		t.Fatalf("Unable to retrieve and decode work '%s': %v\n", id, err)
@@ -143,7 +147,7 @@ func TestOLGetWorkReturnsEmptyOLWorkResponse(t *testing.T) {
 func TestOLGetWorkReturnsError(t *testing.T) {
 	id := ""
 
-	work, err := api.OLGetWork(id)
+	work, err := client.OLGetWork(id)
 
 	if err == nil {
 		t.Fatal("Unable to catch error with missing 'id' argument.")
@@ -0,0 +1,634 @@
+package archive_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	collectionHelper "github.com/muzzarellimj/grace-material-api/internal/api/collection/helper"
+	userHelper "github.com/muzzarellimj/grace-material-api/internal/api/user/helper"
+	"github.com/muzzarellimj/grace-material-api/internal/archive"
+	albumModel "github.com/muzzarellimj/grace-material-api/internal/model/album"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/archive"
+	boardGameModel "github.com/muzzarellimj/grace-material-api/internal/model/boardgame"
+	bookModel "github.com/muzzarellimj/grace-material-api/internal/model/book"
+	comicModel "github.com/muzzarellimj/grace-material-api/internal/model/comic"
+	gameModel "github.com/muzzarellimj/grace-material-api/internal/model/game"
+	movieModel "github.com/muzzarellimj/grace-material-api/internal/model/movie"
+	podcastModel "github.com/muzzarellimj/grace-material-api/internal/model/podcast"
+	showModel "github.com/muzzarellimj/grace-material-api/internal/model/show"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+func createArchive() model.Archive {
+	return model.Archive{
+		Version: archive.Version,
+		Books: []bookModel.Book{{
+			ID:               1,
+			Title:            "Dune",
+			Authors:          []bookModel.BookAuthorFragment{{FirstName: "Frank", LastName: "Herbert"}},
+			Topics:           []bookModel.BookTopicFragment{{Name: "Science Fiction"}, {Name: "Deserts"}},
+			Series:           []bookModel.BookSeries{{Name: "Dune", Position: 1}},
+			PublishDate:      -157766400,
+			Pages:            412,
+			ISBN13:           "9780441172719",
+			EditionReference: "OL26242482M",
+		}},
+		Movies: []movieModel.Movie{{ID: 2, Title: "Alien", Reference: 348}},
+		Collection: []model.ArchiveCollectionItem{
+			{Type: "book", Material: 1, DateAdded: 1704067200},
+			{Type: "movie", Material: 2, DateAdded: 1704067200},
+		},
+		Progress: []model.ArchiveProgressEntry{
+			{Type: "book", Material: 1, Status: "in_progress", Progress: 100, DateRecorded: 1704067200},
+			{Type: "book", Material: 1, Status: "finished", Progress: 412, DateRecorded: 1704153600},
+		},
+		Reviews: []model.ArchiveReview{{Type: "book", Material: 1, Rating: 9, Review: "Spice, mostly."}},
+		Tags:    []model.ArchiveTag{{Type: "book", Material: 1, Name: "comfort reads"}},
+	}
+}
+
+func TestWriteCSVFlattensBooksWithUserData(t *testing.T) {
+	var buffer bytes.Buffer
+
+	err := archive.WriteCSV(&buffer, createArchive(), "book")
+
+	if err != nil {
+		t.Fatalf("Unable to write CSV: %v\n", err)
+	}
+
+	recordSlice, err := csv.NewReader(&buffer).ReadAll()
+
+	if err != nil {
+		t.Fatalf("Unable to read written CSV: %v\n", err)
+	}
+
+	if len(recordSlice) != 2 || recordSlice[0][0] != "id" || recordSlice[0][len(recordSlice[0])-1] != "tags" {
+		t.Fatalf("Actual records '%v' do not match expected header and row.", recordSlice)
+	}
+
+	expected := []string{"1", "Dune", "", "Frank Herbert", "", "Science Fiction; Deserts", "Dune #1", "1965-01-01", "412", "", "9780441172719", "OL26242482M", "", "2024-01-01", "finished", "412", "9", "Spice, mostly.", "comfort reads"}
+
+	if strings.Join(recordSlice[1], "|") != strings.Join(expected, "|") {
+		t.Fatalf("Actual record '%v' does not match expected record '%v'.", recordSlice[1], expected)
+	}
+}
+
+func TestWriteCSVFlattensBoardGamesWithUserData(t *testing.T) {
+	var buffer bytes.Buffer
+
+	csvArchive := model.Archive{
+		BoardGames: []boardGameModel.BoardGame{{
+			ID:          4,
+			Title:       "Wingspan",
+			Designers:   []boardGameModel.BoardGameDesignerFragment{{Name: "Elizabeth Hargrave"}},
+			Mechanics:   []boardGameModel.BoardGameMechanicFragment{{Name: "Card Drafting"}, {Name: "Engine Building"}},
+			ReleaseDate: 1546300800,
+			MinPlayers:  1,
+			MaxPlayers:  5,
+			PlayingTime: 70,
+			MinAge:      10,
+			Reference:   266192,
+		}},
+		Collection: []model.ArchiveCollectionItem{{Type: "boardgame", Material: 4, DateAdded: 1704067200}},
+		Progress:   []model.ArchiveProgressEntry{{Type: "boardgame", Material: 4, Status: "played", Progress: 3, DateRecorded: 1704067200}},
+	}
+
+	err := archive.WriteCSV(&buffer, csvArchive, "boardgame")
+
+	if err != nil {
+		t.Fatalf("Unable to write CSV: %v\n", err)
+	}
+
+	recordSlice, err := csv.NewReader(&buffer).ReadAll()
+
+	if err != nil {
+		t.Fatalf("Unable to read written CSV: %v\n", err)
+	}
+
+	expected := []string{"4", "Wingspan", "Elizabeth Hargrave", "", "Card Drafting; Engine Building", "", "2019-01-01", "1", "5", "70", "10", "266192", "2024-01-01", "played", "3", "", "", ""}
+
+	if len(recordSlice) != 2 || strings.Join(recordSlice[1], "|") != strings.Join(expected, "|") {
+		t.Fatalf("Actual records '%v' do not match expected record '%v'.", recordSlice, expected)
+	}
+}
+
+func TestWriteCSVHandlesUnsupportedType(t *testing.T) {
+	err := archive.WriteCSV(io.Discard, createArchive(), "vinyl")
+
+	if err == nil {
+		t.Fatal("Expected error writing CSV of an unsupported material type.")
+	}
+}
+
+func TestWriteNDJSONWritesOneMaterialPerLine(t *testing.T) {
+	var buffer bytes.Buffer
+
+	err := archive.WriteNDJSON(&buffer, createArchive())
+
+	if err != nil {
+		t.Fatalf("Unable to write NDJSON: %v\n", err)
+	}
+
+	lineSlice := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+
+	if len(lineSlice) != 2 {
+		t.Fatalf("Actual line count '%d' does not match expected line count '2'.", len(lineSlice))
+	}
+
+	var line struct {
+		Type     string           `json:"type"`
+		Material movieModel.Movie `json:"material"`
+	}
+
+	err = json.Unmarshal([]byte(lineSlice[1]), &line)
+
+	if err != nil {
+		t.Fatalf("Unable to decode NDJSON line: %v\n", err)
+	}
+
+	if line.Type != "movie" || line.Material.Reference != 348 {
+		t.Fatalf("Actual line '%v' does not match expected movie.", line)
+	}
+}
+
+func TestReadArchiveRoundTripsWrittenArchive(t *testing.T) {
+	var buffer bytes.Buffer
+
+	err := archive.WriteArchive(&buffer, createArchive())
+
+	if err != nil {
+		t.Fatalf("Unable to write archive: %v\n", err)
+	}
+
+	restoreArchive, err := archive.ReadArchive(&buffer)
+
+	if err != nil {
+		t.Fatalf("Unable to read written archive: %v\n", err)
+	}
+
+	if len(restoreArchive.Books) != 1 || restoreArchive.Books[0].EditionReference != "OL26242482M" || len(restoreArchive.Progress) != 2 {
+		t.Fatalf("Actual archive '%v' does not match written archive.", restoreArchive)
+	}
+}
+
+func TestReadArchiveRejectsUnsupportedVersion(t *testing.T) {
+	_, err := archive.ReadArchive(strings.NewReader(`{ "version": 99 }`))
+
+	if !errors.Is(err, archive.ErrUnsupportedVersion) {
+		t.Fatalf("Actual error '%v' does not match expected error '%v'.", err, archive.ErrUnsupportedVersion)
+	}
+}
+
+func TestRestoreMapsMaterialsByProviderReference(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	defer mock.Close()
+
+	expectCollection(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM movies WHERE reference=348")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "title", "tagline", "description", "release_date", "runtime", "image", "reference"}).
+			AddRow(9, "Alien", "", "", int64(296697600), 117, "", 348))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM collections_movies WHERE collection=1 AND movie=9")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO collections_movies (collection,movie,date_added)")).
+		WithArgs(1, 9, int64(1704067200)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	archiver := createArchiver(mock, "movie")
+
+	restoreArchive := createArchive()
+	restoreArchive.Books = nil
+	restoreArchive.Collection = restoreArchive.Collection[1:]
+	restoreArchive.Progress = nil
+	restoreArchive.Reviews = nil
+	restoreArchive.Tags = nil
+
+	report, err := archiver.Restore("default", restoreArchive)
+
+	if err != nil {
+		t.Fatalf("Unable to restore archive: %v\n", err)
+	}
+
+	if report.Movies != 0 || report.Collection != 1 || report.Skipped != 0 {
+		t.Fatalf("Actual report '%v' does not match expected report.", report)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestRestoreMapsOwnedPlatformByProviderReference(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	defer mock.Close()
+
+	expectCollection(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM games WHERE reference=1942")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "title", "summary", "storyline", "release_date", "image", "reference"}).
+			AddRow(4, "The Witcher 3: Wild Hunt", "", "", 1431993600, "", 1942))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM platforms WHERE reference=130")).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(11))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM collections_games WHERE collection=1 AND game=4")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO collections_games (collection,game,date_added,platform)")).
+		WithArgs(1, 4, int64(1704067200), 11).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	platform := 6
+
+	restoreArchive := model.Archive{
+		Version: archive.Version,
+		Games: []gameModel.Game{{
+			ID:        2,
+			Title:     "The Witcher 3: Wild Hunt",
+			Platforms: []gameModel.GamePlatformFragment{{ID: 5, Name: "PC (Microsoft Windows)", Reference: 6}, {ID: 6, Name: "Nintendo Switch", Reference: 130}},
+			Reference: 1942,
+		}},
+		Collection: []model.ArchiveCollectionItem{{Type: "game", Material: 2, DateAdded: 1704067200, Platform: &platform}},
+	}
+
+	report, err := createArchiver(mock, "game").Restore("default", restoreArchive)
+
+	if err != nil {
+		t.Fatalf("Unable to restore archive: %v\n", err)
+	}
+
+	if report.Games != 0 || report.Collection != 1 || report.Skipped != 0 {
+		t.Fatalf("Actual report '%v' does not match expected report.", report)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestRestoreMapsEpisodeProgressByProviderReference(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	defer mock.Close()
+
+	expectCollection(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM shows WHERE reference=95396")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "title", "tagline", "description", "first_air_date", "last_air_date", "status", "seasons", "episodes", "runtime", "image", "reference"}).
+			AddRow(7, "Severance", "", "", int64(1644537600), int64(0), "Returning Series", 1, 9, 50, "", 95396))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM episodes WHERE show=7")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "show", "season", "number", "title", "description", "air_date", "runtime", "image", "reference"}).
+			AddRow(31, 7, 3, 1, "Good News About Hell", "", int64(1644537600), 57, "", 1979532))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM collections_shows WHERE collection=1 AND show=7")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO collections_shows (collection,show,date_added)")).
+		WithArgs(1, 7, int64(1704067200)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM episodes_progress WHERE owner=1 AND episode=31 AND date_watched=1704153600")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO episodes_progress (owner,episode,date_watched)")).
+		WithArgs(1, 31, int64(1704153600)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	restoreArchive := model.Archive{
+		Version: archive.Version,
+		Shows: []showModel.Show{{
+			ID:        2,
+			Title:     "Severance",
+			Seasons:   []showModel.ShowSeason{{ID: 5, Number: 1, Episodes: []showModel.ShowEpisodeFragment{{ID: 11, Number: 1, Reference: 1979532}}}},
+			Reference: 95396,
+		}},
+		Collection: []model.ArchiveCollectionItem{{Type: "show", Material: 2, DateAdded: 1704067200}},
+		EpisodeProgress: []model.ArchiveEpisodeProgress{
+			{Show: 2, Episode: 11, DateWatched: 1704153600},
+			{Show: 2, Episode: 12, DateWatched: 1704240000},
+		},
+	}
+
+	report, err := createArchiver(mock, "show").Restore("default", restoreArchive)
+
+	if err != nil {
+		t.Fatalf("Unable to restore archive: %v\n", err)
+	}
+
+	if report.Shows != 0 || report.Collection != 1 || report.Progress != 1 || report.Skipped != 1 {
+		t.Fatalf("Actual report '%v' does not match expected report.", report)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestRestoreStoresAlbumWithArtistsAndTracks(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	defer mock.Close()
+
+	expectCollection(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM albums WHERE reference='b84ee12a-09ef-421b-82de-0441a926375b'")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "title", "artist_credit", "release_date", "country", "format", "discs", "tracks", "runtime", "barcode", "image", "reference", "group_reference"}))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO albums")).
+		WithArgs("OK Computer", "Radiohead", int64(0), "", "", 0, 1, 0, "", "", "b84ee12a-09ef-421b-82de-0441a926375b", "").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectCommit()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM artists WHERE reference='a74b1b7f-71a5-4011-9441-d0b5e4122711'")).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO artists (name,sort_name,reference)")).
+		WithArgs("Radiohead", "Radiohead", "a74b1b7f-71a5-4011-9441-d0b5e4122711").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(6))
+	mock.ExpectCommit()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO albums_artists (album,artist,position)")).
+		WithArgs(4, 6, 0).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO tracks (album,disc,position,number,title,length,reference)")).
+		WithArgs(4, 1, 1, "1", "Airbag", 284, "e7a5f6b0-2b1e-3a5b-b4b1-1e1f0b2c3d4e").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(8))
+	mock.ExpectCommit()
+
+	restoreArchive := model.Archive{
+		Version: archive.Version,
+		Albums: []albumModel.Album{{
+			ID:           3,
+			Title:        "OK Computer",
+			ArtistCredit: "Radiohead",
+			Artists:      []albumModel.AlbumArtistFragment{{Name: "Radiohead", SortName: "Radiohead", Reference: "a74b1b7f-71a5-4011-9441-d0b5e4122711"}},
+			Tracks:       []albumModel.AlbumTrackFragment{{Disc: 1, Position: 1, Number: "1", Title: "Airbag", Length: 284, Reference: "e7a5f6b0-2b1e-3a5b-b4b1-1e1f0b2c3d4e"}},
+			Reference:    "b84ee12a-09ef-421b-82de-0441a926375b",
+		}},
+	}
+
+	report, err := createArchiver(mock, "album").Restore("default", restoreArchive)
+
+	if err != nil {
+		t.Fatalf("Unable to restore archive: %v\n", err)
+	}
+
+	if report.Albums != 1 || report.Skipped != 0 {
+		t.Fatalf("Actual report '%v' does not match expected report.", report)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestRestoreMapsOwnedAndReadIssuesByVolume(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	defer mock.Close()
+
+	expectCollection(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM volumes WHERE reference=18166")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "comic", "title", "description", "start_date", "issues", "image", "reference"}).
+			AddRow(6, 5, "Saga", "", int64(1325376000), 66, "", 18166))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM issues WHERE comic=5")).
+		WillReturnRows(pgxmock.
+			NewRows([]string{"id", "comic", "volume", "number", "title", "cover_date", "image", "reference"}).
+			AddRow(40, 5, 6, "1", "Chapter One", int64(1331856000), "", 320932))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM collections_issues WHERE collection=1 AND issue=40")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO collections_issues (collection,issue,date_added)")).
+		WithArgs(1, 40, int64(1704067200)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM issues_progress WHERE owner=1 AND issue=40 AND date_read=1704153600")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}).AddRow(1))
+
+	restoreArchive := model.Archive{
+		Version: archive.Version,
+		Comics: []comicModel.Comic{{
+			ID:      2,
+			Title:   "Saga",
+			Volumes: []comicModel.ComicVolume{{ID: 3, Title: "Saga", Reference: 18166, Issues: []comicModel.ComicIssueFragment{{ID: 9, Number: "1", Reference: 320932}}}},
+		}},
+		CollectionIssues: []model.ArchiveCollectionIssue{{Comic: 2, Issue: 9, DateAdded: 1704067200}},
+		IssueProgress:    []model.ArchiveIssueProgress{{Comic: 2, Issue: 9, DateRead: 1704153600}},
+	}
+
+	report, err := createArchiver(mock, "comic").Restore("default", restoreArchive)
+
+	if err != nil {
+		t.Fatalf("Unable to restore archive: %v\n", err)
+	}
+
+	if report.Comics != 0 || report.Collection != 1 || report.Progress != 0 || report.Skipped != 1 {
+		t.Fatalf("Actual report '%v' does not match expected report.", report)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestRestoreStoresPodcastWithCategoriesAndEpisodes(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	defer mock.Close()
+
+	expectCollection(mock)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM podcasts WHERE reference='https://example.com/feed.xml'")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "title", "author", "description", "language", "explicit", "first_release_date", "last_release_date", "episodes", "image", "link", "reference", "date_refreshed"}))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO podcasts")).
+		WithArgs("Grace Radio", "Grace", "", "en", false, int64(1700000000), int64(1700000000), 1, "", "", "https://example.com/feed.xml", 0).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectCommit()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM pcategories WHERE name='Technology'")).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(2))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO podcasts_categories (podcast,category)")).
+		WithArgs(7, 2).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO pepisodes (podcast,title,description,release_date,duration,season,number,audio,image,reference)")).
+		WithArgs(7, "Pilot", "", int64(1700000000), 1800, 0, 1, "https://example.com/1.mp3", "", "grace-1").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(9))
+	mock.ExpectCommit()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM collections_podcasts WHERE collection=1 AND podcast=7")).
+		WillReturnRows(pgxmock.NewRows([]string{"?column?"}))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO collections_podcasts (collection,podcast,date_added)")).
+		WithArgs(1, 7, int64(1704067200)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	restoreArchive := model.Archive{
+		Version: archive.Version,
+		Podcasts: []podcastModel.Podcast{{
+			ID:               3,
+			Title:            "Grace Radio",
+			Author:           "Grace",
+			Categories:       []podcastModel.PodcastCategoryFragment{{ID: 5, Name: "Technology"}},
+			Language:         "en",
+			FirstReleaseDate: 1700000000,
+			LastReleaseDate:  1700000000,
+			Episodes:         []podcastModel.PodcastEpisodeFragment{{ID: 4, Podcast: 3, Title: "Pilot", ReleaseDate: 1700000000, Duration: 1800, Number: 1, Audio: "https://example.com/1.mp3", Reference: "grace-1"}},
+			Reference:        "https://example.com/feed.xml",
+			DateRefreshed:    1704067200,
+		}},
+		Collection: []model.ArchiveCollectionItem{{Type: "podcast", Material: 3, DateAdded: 1704067200}},
+	}
+
+	report, err := createArchiver(mock, "podcast").Restore("default", restoreArchive)
+
+	if err != nil {
+		t.Fatalf("Unable to restore archive: %v\n", err)
+	}
+
+	if report.Podcasts != 1 || report.Collection != 1 || report.Skipped != 0 {
+		t.Fatalf("Actual report '%v' does not match expected report.", report)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestExportIncludesShowsOfEpisodeProgress(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+
+	if err != nil {
+		t.Fatalf("Unable to create mock database pool connection: %v\n", err)
+	}
+
+	defer mock.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM users WHERE reference='default'")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "reference", "date_created"}).AddRow(1, "default", int64(0)))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM collections_shows r")).
+		WillReturnRows(pgxmock.NewRows([]string{"type", "material", "date_added"}))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM shows_progress")).
+		WillReturnRows(pgxmock.NewRows([]string{"type", "material", "status", "progress", "date_recorded"}))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM shows_reviews")).
+		WillReturnRows(pgxmock.NewRows([]string{"type", "material", "rating", "review", "date_created", "date_updated"}))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM shows_tags r")).
+		WillReturnRows(pgxmock.NewRows([]string{"type", "material", "name"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT e.show, w.episode, w.date_watched FROM episodes_progress w JOIN episodes e ON e.id = w.episode WHERE w.owner=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"show", "episode", "date_watched"}).AddRow(2, 11, int64(1704153600)))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM lists WHERE owner=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "owner", "name", "description", "date_created"}))
+
+	logger := log.New(io.Discard, "", 0)
+	users := userHelper.NewRepository(mock, logger)
+	archiver := archive.NewArchiver(mock, users, collectionHelper.NewRepository(mock, users, []string{"show"}, logger), archive.Sources{Shows: fakeShowSource{}}, logger)
+
+	exportArchive, err := archiver.Export("default")
+
+	if err != nil {
+		t.Fatalf("Unable to export archive: %v\n", err)
+	}
+
+	if len(exportArchive.Shows) != 1 || exportArchive.Shows[0].Reference != 95396 || len(exportArchive.EpisodeProgress) != 1 {
+		t.Fatalf("Actual archive '%v' does not include the show of its episode progress.", exportArchive)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func expectCollection(mock pgxmock.PgxPoolIface) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM users WHERE reference='default'")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "reference", "date_created"}).AddRow(1, "default", int64(0)))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM collections WHERE owner=1")).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "owner", "name", "date_created"}).AddRow(1, 1, "Collection", int64(0)))
+}
+
+func createArchiver(mock pgxmock.PgxPoolIface, materialTypes ...string) *archive.Archiver {
+	logger := log.New(io.Discard, "", 0)
+	users := userHelper.NewRepository(mock, logger)
+
+	return archive.NewArchiver(mock, users, collectionHelper.NewRepository(mock, users, materialTypes, logger), archive.Sources{}, logger)
+}
+
+type fakeShowSource struct{}
+
+func (fakeShowSource) FetchShow(constraint string) (showModel.Show, error) {
+	return showModel.Show{ID: 2, Title: "Severance", Reference: 95396}, nil
+}
@@ -0,0 +1,180 @@
+package auth_test
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/auth"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+)
+
+func TestAuthenticateReturnsDefaultPrincipalWhenDisabled(t *testing.T) {
+	authenticator := auth.NewAuthenticator(config.AuthConfig{}, time.Second)
+
+	actual, err := authenticator.Authenticate(httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if err != nil {
+		t.Fatalf("Unable to authenticate request with authentication disabled: %v\n", err)
+	}
+
+	if actual.Subject != auth.DefaultSubject || !actual.Has(auth.ScopeWrite) {
+		t.Fatalf("Actual principal '%v' does not match expected default principal.", actual)
+	}
+}
+
+func TestAuthenticateReturnsAPIKeyPrincipal(t *testing.T) {
+	authenticator := auth.NewAuthenticator(config.AuthConfig{Enabled: true, APIKeys: []string{"GraceTestKey:grace-web:read|write"}}, time.Second)
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("X-API-Key", "GraceTestKey")
+
+	actual, err := authenticator.Authenticate(request)
+
+	if err != nil {
+		t.Fatalf("Unable to authenticate request with API key: %v\n", err)
+	}
+
+	if actual.Subject != "grace-web" || !actual.Has(auth.ScopeWrite) || actual.Has(auth.ScopeAdmin) {
+		t.Fatalf("Actual principal '%v' does not match expected API key principal.", actual)
+	}
+}
+
+func TestAuthenticateHandlesInvalidAPIKey(t *testing.T) {
+	authenticator := auth.NewAuthenticator(config.AuthConfig{Enabled: true, APIKeys: []string{"GraceTestKey:grace-web:read"}}, time.Second)
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("X-API-Key", "GraceWrongKey")
+
+	_, err := authenticator.Authenticate(request)
+
+	if err == nil {
+		t.Fatal("Unable to catch error with invalid API key.")
+	}
+}
+
+func TestAuthenticateHandlesMissingCredentials(t *testing.T) {
+	authenticator := auth.NewAuthenticator(config.AuthConfig{Enabled: true, JWTSecret: "GraceTestSecret"}, time.Second)
+
+	_, err := authenticator.Authenticate(httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if err != auth.ErrMissingCredentials {
+		t.Fatalf("Actual error '%v' does not match expected error '%v'.", err, auth.ErrMissingCredentials)
+	}
+}
+
+func TestAuthenticateReturnsHS256Principal(t *testing.T) {
+	authenticator := auth.NewAuthenticator(config.AuthConfig{Enabled: true, JWTSecret: "GraceTestSecret", JWTIssuer: "grace"}, time.Second)
+
+	token := signHS256(t, "GraceTestSecret", map[string]any{"sub": "mjm", "iss": "grace", "exp": time.Now().Add(time.Hour).Unix(), "scope": "read write"})
+
+	actual, err := authenticator.Authenticate(bearerRequest(token))
+
+	if err != nil {
+		t.Fatalf("Unable to authenticate request with HS256 token: %v\n", err)
+	}
+
+	if actual.Subject != "mjm" || !actual.Has(auth.ScopeWrite) {
+		t.Fatalf("Actual principal '%v' does not match expected token principal.", actual)
+	}
+}
+
+func TestAuthenticateHandlesExpiredToken(t *testing.T) {
+	authenticator := auth.NewAuthenticator(config.AuthConfig{Enabled: true, JWTSecret: "GraceTestSecret"}, time.Second)
+
+	token := signHS256(t, "GraceTestSecret", map[string]any{"sub": "mjm", "exp": time.Now().Add(-time.Hour).Unix()})
+
+	_, err := authenticator.Authenticate(bearerRequest(token))
+
+	if err == nil {
+		t.Fatal("Unable to catch error with expired token.")
+	}
+}
+
+func TestAuthenticateHandlesWrongSecret(t *testing.T) {
+	authenticator := auth.NewAuthenticator(config.AuthConfig{Enabled: true, JWTSecret: "GraceTestSecret"}, time.Second)
+
+	token := signHS256(t, "GraceWrongSecret", map[string]any{"sub": "mjm", "exp": time.Now().Add(time.Hour).Unix()})
+
+	_, err := authenticator.Authenticate(bearerRequest(token))
+
+	if err == nil {
+		t.Fatal("Unable to catch error with token signed by wrong secret.")
+	}
+}
+
+func TestAuthenticateReturnsRS256Principal(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+
+	if err != nil {
+		t.Fatalf("Unable to generate RSA key: %v\n", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		json.NewEncoder(writer).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "grace-1",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	}))
+
+	defer server.Close()
+
+	authenticator := auth.NewAuthenticator(config.AuthConfig{Enabled: true, JWKSURL: server.URL, JWKSRefresh: time.Hour, JWTAudience: "grace-api", DefaultScope: []string{"read"}}, time.Second)
+
+	signed := encodeSegment(t, map[string]string{"alg": "RS256", "kid": "grace-1"}) + "." + encodeSegment(t, map[string]any{"sub": "mjm", "aud": []string{"grace-api"}, "exp": time.Now().Add(time.Hour).Unix()})
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+
+	if err != nil {
+		t.Fatalf("Unable to sign RS256 token: %v\n", err)
+	}
+
+	actual, err := authenticator.Authenticate(bearerRequest(signed + "." + base64.RawURLEncoding.EncodeToString(signature)))
+
+	if err != nil {
+		t.Fatalf("Unable to authenticate request with RS256 token: %v\n", err)
+	}
+
+	if !actual.Has(auth.ScopeRead) || actual.Has(auth.ScopeWrite) {
+		t.Fatalf("Actual principal '%v' does not match expected default scope.", actual)
+	}
+}
+
+func bearerRequest(token string) *http.Request {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("Authorization", "Bearer "+token)
+
+	return request
+}
+
+func signHS256(t *testing.T, secret string, claims map[string]any) string {
+	signed := encodeSegment(t, map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func encodeSegment(t *testing.T, value any) string {
+	content, err := json.Marshal(value)
+
+	if err != nil {
+		t.Fatalf("Unable to encode token segment: %v\n", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(content)
+}
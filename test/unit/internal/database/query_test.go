@@ -1,8 +1,11 @@
 package database_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/muzzarellimj/grace-material-api/internal/database"
 	model "github.com/muzzarellimj/grace-material-api/internal/model/movie"
 	"github.com/pashagolub/pgxmock/v3"
@@ -239,3 +242,15 @@ func createMockConnection(t *testing.T) pgxmock.PgxPoolIface {
 
 	return mock
 }
+
+func TestIsUniqueViolationDetectsWrappedViolation(t *testing.T) {
+	err := fmt.Errorf("unable to store user: %w", &pgconn.PgError{Code: "23505"})
+
+	if !database.IsUniqueViolation(err) {
+		t.Fatalf("Unable to detect unique constraint violation in error '%v'.", err)
+	}
+
+	if database.IsUniqueViolation(errors.New("connection refused")) || database.IsUniqueViolation(&pgconn.PgError{Code: "23503"}) {
+		t.Fatal("Unable to distinguish other errors from unique constraint violations.")
+	}
+}
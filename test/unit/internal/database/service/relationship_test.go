@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/muzzarellimj/grace-material-api/internal/database"
+	"github.com/muzzarellimj/grace-material-api/internal/database/service"
+	"github.com/pashagolub/pgxmock/v3"
+)
+
+func TestDeleteRelationshipReturnsCount(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM collections_movies WHERE collection=1 AND movie=2").
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectCommit()
+
+	count, err := service.DeleteRelationship(mock, database.TableCollectionMovieRelationships, "collection=1 AND movie=2")
+
+	if err != nil {
+		t.Fatalf("Unable to delete relationship: %v\n", err)
+	}
+
+	if count != 1 {
+		t.Fatalf("Actual deletion count '%d' does not match expected deletion count '1'.", count)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestDeleteRelationshipHandlesEmptyConstraint(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	_, err := service.DeleteRelationship(mock, database.TableCollectionMovieRelationships, "")
+
+	if err == nil {
+		t.Fatal("Unable to catch error with empty deletion constraint.")
+	}
+}
+
+func TestUpdateRelationshipReturnsCount(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE collections_games SET platform=@platform WHERE collection=1 AND game=2")).
+		WithArgs(3).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	count, err := service.UpdateRelationship(mock, database.TableCollectionGameRelationships, database.PropertiesCollectionGamePlatform, "collection=1 AND game=2", pgx.NamedArgs{
+		"platform": 3,
+	})
+
+	if err != nil {
+		t.Fatalf("Unable to update relationship: %v\n", err)
+	}
+
+	if count != 1 {
+		t.Fatalf("Actual update count '%d' does not match expected update count '1'.", count)
+	}
+
+	err = mock.ExpectationsWereMet()
+
+	if err != nil {
+		t.Fatalf("Mock connection expectations were not met: %v\n", err)
+	}
+}
+
+func TestUpdateRelationshipHandlesEmptyConstraint(t *testing.T) {
+	mock := createMockConnection(t)
+
+	defer mock.Close()
+
+	_, err := service.UpdateRelationship(mock, database.TableCollectionGameRelationships, database.PropertiesCollectionGamePlatform, "", pgx.NamedArgs{})
+
+	if err == nil {
+		t.Fatal("Unable to catch error with empty update constraint.")
+	}
+}
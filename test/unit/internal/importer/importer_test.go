@@ -0,0 +1,139 @@
+package importer_test
+
+import (
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/muzzarellimj/grace-material-api/internal/importer"
+	model "github.com/muzzarellimj/grace-material-api/internal/model/importer"
+	movieModel "github.com/muzzarellimj/grace-material-api/internal/model/movie"
+)
+
+type fakeBooks struct{}
+
+func (books fakeBooks) StoreBook(id string) (int, bool, error) {
+	if id == "9780441172719" {
+		return 1, true, nil
+	}
+
+	return 0, false, errors.New("unable to store edition without related work")
+}
+
+type fakeGames struct{}
+
+func (games fakeGames) ResolveSteamGame(appid string) ([]int, error) {
+	switch appid {
+	case "620":
+		return []int{72}, nil
+	case "1":
+		return []int{10, 11}, nil
+	default:
+		return []int{}, nil
+	}
+}
+
+func (games fakeGames) StoreGame(id string) (int, bool, error) {
+	return 5, true, nil
+}
+
+type fakeMovies struct{}
+
+func (movies fakeMovies) SearchMovies(query string) ([]movieModel.MovieSearchResult, error) {
+	return []movieModel.MovieSearchResult{
+		{ID: 348, Title: "Alien", ReleaseDate: time.Date(1979, time.May, 25, 0, 0, 0, 0, time.UTC).Unix()},
+		{ID: 679, Title: "Aliens", ReleaseDate: time.Date(1986, time.July, 18, 0, 0, 0, 0, time.UTC).Unix()},
+	}, nil
+}
+
+func (movies fakeMovies) StoreMovie(id string) (int, bool, error) {
+	return 7, true, nil
+}
+
+type fakeCollector struct {
+	collected map[string][]int
+}
+
+func (collector fakeCollector) AddCollectionItem(reference string, materialType string, id int) (bool, bool, error) {
+	collector.collected[materialType] = append(collector.collected[materialType], id)
+
+	return true, true, nil
+}
+
+func TestRunReportsEachRow(t *testing.T) {
+	collector := fakeCollector{collected: make(map[string][]int)}
+	instance := importer.NewImporter(fakeBooks{}, fakeGames{}, fakeMovies{}, collector, log.New(io.Discard, "", 0))
+
+	job := instance.Run("default", importer.SourceSteam, []model.ImportRow{
+		{Line: 1, Type: "game", Identifier: "620", Title: "Portal 2"},
+		{Line: 2, Type: "game", Identifier: "1", Title: "Ambiguous"},
+		{Line: 3, Type: "game", Identifier: "2", Title: "Unknown"},
+	})
+
+	if job.Status != importer.JobCompleted || job.Summary.Matched != 1 || job.Summary.Ambiguous != 1 || job.Summary.Failed != 1 {
+		t.Fatalf("Actual job '%v' does not match expected summary.", job)
+	}
+
+	if job.Rows[0].Material != 5 || len(job.Rows[1].Candidates) != 2 || job.Rows[2].Status != importer.RowFailed {
+		t.Fatalf("Actual rows '%v' do not match expected per-row report.", job.Rows)
+	}
+
+	if len(collector.collected["game"]) != 1 {
+		t.Fatalf("Actual collected games '%v' do not match expected matched game.", collector.collected)
+	}
+}
+
+func TestSubmitTracksJobPerOwner(t *testing.T) {
+	instance := importer.NewImporter(fakeBooks{}, nil, nil, nil, log.New(io.Discard, "", 0))
+
+	job := instance.Submit("default", importer.SourceGoodreads, []model.ImportRow{
+		{Line: 2, Type: "book", Identifier: "9780441172719"},
+		{Line: 3, Type: "book", Identifier: ""},
+	})
+
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		job, _ = instance.Job("default", job.ID)
+
+		if job.Status == importer.JobCompleted {
+			break
+		}
+	}
+
+	if job.Status != importer.JobCompleted || job.Summary.Matched != 1 || job.Summary.Failed != 1 {
+		t.Fatalf("Actual job '%v' does not match expected completed job.", job)
+	}
+
+	_, exists := instance.Job("someone-else", job.ID)
+
+	if exists {
+		t.Fatalf("Job '%d' is visible to a user other than its owner.", job.ID)
+	}
+
+	if instance.Supports(importer.SourceSteam) {
+		t.Fatalf("Importer supports source '%s' without a game repository.", importer.SourceSteam)
+	}
+}
+
+func TestMatchMoviesFiltersByYear(t *testing.T) {
+	resultSlice, _ := fakeMovies{}.SearchMovies("Alien")
+
+	candidateSlice := importer.MatchMovies(resultSlice, "Alien", 1979)
+
+	if len(candidateSlice) != 1 || candidateSlice[0] != 348 {
+		t.Fatalf("Actual candidates '%v' do not match expected candidate.", candidateSlice)
+	}
+
+	candidateSlice = importer.MatchMovies(resultSlice, "Alien", 0)
+
+	if len(candidateSlice) != 1 || candidateSlice[0] != 348 {
+		t.Fatalf("Actual candidates '%v' do not match expected exact title candidate.", candidateSlice)
+	}
+
+	candidateSlice = importer.MatchMovies(resultSlice, "Predator", 0)
+
+	if len(candidateSlice) != 2 {
+		t.Fatalf("Actual candidates '%v' do not match expected ambiguous candidates.", candidateSlice)
+	}
+}
@@ -0,0 +1,66 @@
+package importer_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/muzzarellimj/grace-material-api/internal/importer"
+)
+
+func TestParseGoodreadsReturnsISBNs(t *testing.T) {
+	file := "Book Id,Title,Author,ISBN,ISBN13\n" +
+		`1,Dune,Frank Herbert,"=""0441172717""","=""9780441172719"""` + "\n" +
+		`2,The Last Wish,Andrzej Sapkowski,"=""0316029181""","="""""` + "\n" +
+		`3,Untitled,Unknown,"=""""","="""""` + "\n"
+
+	rowSlice, err := importer.ParseGoodreads(strings.NewReader(file))
+
+	if err != nil {
+		t.Fatalf("Unable to parse Goodreads export: %v\n", err)
+	}
+
+	if len(rowSlice) != 3 || rowSlice[0].Identifier != "9780441172719" || rowSlice[1].Identifier != "0316029181" || rowSlice[2].Identifier != "" {
+		t.Fatalf("Actual rows '%v' do not match expected ISBNs.", rowSlice)
+	}
+
+	if rowSlice[0].Line != 2 || rowSlice[0].Type != "book" {
+		t.Fatalf("Actual row '%v' does not match expected line and type.", rowSlice[0])
+	}
+}
+
+func TestParseLetterboxdReturnsTitlesAndYears(t *testing.T) {
+	file := "Date,Name,Year,Letterboxd URI\n2024-01-01,Alien,1979,https://boxd.it/2bcA\n"
+
+	rowSlice, err := importer.ParseLetterboxd(strings.NewReader(file))
+
+	if err != nil {
+		t.Fatalf("Unable to parse Letterboxd export: %v\n", err)
+	}
+
+	if len(rowSlice) != 1 || rowSlice[0].Title != "Alien" || rowSlice[0].Year != 1979 || rowSlice[0].Type != "movie" {
+		t.Fatalf("Actual rows '%v' do not match expected title and year.", rowSlice)
+	}
+}
+
+func TestParseLetterboxdHandlesMissingColumn(t *testing.T) {
+	_, err := importer.ParseLetterboxd(strings.NewReader("Date,Title\n2024-01-01,Alien\n"))
+
+	if !errors.Is(err, importer.ErrInvalidFile) {
+		t.Fatalf("Actual error '%v' does not match expected error '%v'.", err, importer.ErrInvalidFile)
+	}
+}
+
+func TestParseSteamReturnsApplicationIdentifiers(t *testing.T) {
+	file := `{"response":{"game_count":2,"games":[{"appid":620,"name":"Portal 2"},{"appid":413150,"name":"Stardew Valley"}]}}`
+
+	rowSlice, err := importer.ParseSteam(strings.NewReader(file))
+
+	if err != nil {
+		t.Fatalf("Unable to parse Steam export: %v\n", err)
+	}
+
+	if len(rowSlice) != 2 || rowSlice[0].Identifier != "620" || rowSlice[1].Title != "Stardew Valley" || rowSlice[1].Type != "game" {
+		t.Fatalf("Actual rows '%v' do not match expected application identifiers.", rowSlice)
+	}
+}
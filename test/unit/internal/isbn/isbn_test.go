@@ -0,0 +1,78 @@
+package isbn_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/muzzarellimj/grace-material-api/internal/isbn"
+)
+
+func TestValid10AcceptsCheckCharacterX(t *testing.T) {
+	if !isbn.Valid10(isbn.Clean("0-8044-2957-x")) {
+		t.Fatal("Expected ISBN-10 '0-8044-2957-x' to be valid.")
+	}
+
+	if isbn.Valid10("0804429571") {
+		t.Fatal("Expected ISBN-10 '0804429571' with an invalid check character to be invalid.")
+	}
+}
+
+func TestValid13RejectsInvalidCheckDigit(t *testing.T) {
+	if !isbn.Valid13("9780316452465") {
+		t.Fatal("Expected ISBN-13 '9780316452465' to be valid.")
+	}
+
+	if isbn.Valid13("9780316452466") {
+		t.Fatal("Expected ISBN-13 '9780316452466' with an invalid check digit to be invalid.")
+	}
+
+	if isbn.Valid13("4006381333931") {
+		t.Fatal("Expected EAN-13 '4006381333931' without a Bookland prefix to be invalid.")
+	}
+}
+
+func TestConvertBetweenISBN10AndISBN13(t *testing.T) {
+	isbn13, err := isbn.To13("080442957X")
+
+	if err != nil || isbn13 != "9780804429573" {
+		t.Fatalf("Actual ISBN-13 '%s' does not match expected ISBN-13 '9780804429573': %v", isbn13, err)
+	}
+
+	isbn10, err := isbn.To10("9780316452465")
+
+	if err != nil || isbn10 != "0316452467" {
+		t.Fatalf("Actual ISBN-10 '%s' does not match expected ISBN-10 '0316452467': %v", isbn10, err)
+	}
+
+	_, err = isbn.To10("9791032305690")
+
+	if !errors.Is(err, isbn.ErrInvalidISBN) {
+		t.Fatalf("Actual error '%v' does not match expected error for a '979' ISBN-13.", err)
+	}
+}
+
+func TestNormalizeRemovesBarcodeAddOn(t *testing.T) {
+	for _, input := range []string{"0316452467", "978-0-316-45246-5", "978031645246551599", "97803164524655"} {
+		actual, err := isbn.Normalize(input)
+
+		if input == "97803164524655" {
+			if !errors.Is(err, isbn.ErrInvalidISBN) {
+				t.Fatalf("Actual error '%v' does not match expected error for '%s'.", err, input)
+			}
+
+			continue
+		}
+
+		if err != nil || actual != "9780316452465" {
+			t.Fatalf("Actual ISBN-13 '%s' for '%s' does not match expected ISBN-13 '9780316452465': %v", actual, input, err)
+		}
+	}
+}
+
+func TestFormsReturnsEmptyISBN10For979(t *testing.T) {
+	isbn10, isbn13, err := isbn.Forms("979-10-323-0569-0")
+
+	if err != nil || isbn10 != "" || isbn13 != "9791032305690" {
+		t.Fatalf("Actual forms '%s' and '%s' do not match expected forms: %v", isbn10, isbn13, err)
+	}
+}
@@ -0,0 +1,161 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/muzzarellimj/grace-material-api/internal/auth"
+	"github.com/muzzarellimj/grace-material-api/internal/config"
+	"github.com/muzzarellimj/grace-material-api/internal/middleware"
+)
+
+func TestCORSAllowsConfiguredOrigin(t *testing.T) {
+	router := createRouter(middleware.CORS(config.CORSConfig{
+		AllowedOrigins: []string{"https://grace.app"},
+		AllowedMethods: []string{http.MethodGet},
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("Origin", "https://grace.app")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Header().Get("Access-Control-Allow-Origin") != "https://grace.app" {
+		t.Fatalf("Actual allowed origin '%s' does not match expected allowed origin 'https://grace.app'.", recorder.Header().Get("Access-Control-Allow-Origin"))
+	}
+}
+
+func TestCORSHandlesUnknownOrigin(t *testing.T) {
+	router := createRouter(middleware.CORS(config.CORSConfig{
+		AllowedOrigins: []string{"https://grace.app"},
+		AllowedMethods: []string{http.MethodGet},
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("Origin", "https://evil.app")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusForbidden {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusForbidden)
+	}
+}
+
+func TestSecurityHeadersSetsHeaders(t *testing.T) {
+	router := createRouter(middleware.SecurityHeaders(config.SecurityConfig{HSTS: true}))
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	for _, header := range []string{"X-Content-Type-Options", "X-Frame-Options", "Referrer-Policy", "Content-Security-Policy", "Strict-Transport-Security"} {
+		if recorder.Header().Get(header) == "" {
+			t.Fatalf("Unable to find expected security header '%s'.", header)
+		}
+	}
+}
+
+func TestSecurityHeadersSkipsDisabledHSTS(t *testing.T) {
+	router := createRouter(middleware.SecurityHeaders(config.SecurityConfig{}))
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if recorder.Header().Get("Strict-Transport-Security") != "" {
+		t.Fatal("Unable to skip Strict-Transport-Security header with HSTS disabled.")
+	}
+}
+
+func TestBodyLimitHandlesOversizedBody(t *testing.T) {
+	router := createRouter(middleware.BodyLimit(config.SecurityConfig{MaxBodySize: 8}))
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("0123456789")))
+
+	if recorder.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusRequestEntityTooLarge)
+	}
+}
+
+func TestBodyLimitAllowsSmallBody(t *testing.T) {
+	router := createRouter(middleware.BodyLimit(config.SecurityConfig{MaxBodySize: 8}))
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("0123")))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+}
+
+func createRouter(handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(handler)
+
+	respond := func(context *gin.Context) {
+		context.Status(http.StatusOK)
+	}
+
+	router.GET("/", respond)
+	router.POST("/", respond)
+
+	return router
+}
+
+func TestAuthorizeHandlesMissingCredentials(t *testing.T) {
+	authenticator := auth.NewAuthenticator(config.AuthConfig{Enabled: true, APIKeys: []string{"GraceTestKey:grace-web:read"}}, time.Second)
+	router := createRouter(middleware.Authorize(authenticator, auth.ScopeWrite))
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+
+	if recorder.Code != http.StatusUnauthorized {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestAuthorizeHandlesMissingScope(t *testing.T) {
+	authenticator := auth.NewAuthenticator(config.AuthConfig{Enabled: true, APIKeys: []string{"GraceTestKey:grace-web:read"}}, time.Second)
+	router := createRouter(middleware.Authorize(authenticator, auth.ScopeWrite))
+
+	request := httptest.NewRequest(http.MethodPost, "/", nil)
+	request.Header.Set("X-API-Key", "GraceTestKey")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusForbidden {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusForbidden)
+	}
+}
+
+func TestAuthorizeReadAllowsPublicRead(t *testing.T) {
+	authenticator := auth.NewAuthenticator(config.AuthConfig{Enabled: true, PublicRead: true, APIKeys: []string{"GraceTestKey:grace-web:read"}}, time.Second)
+	router := createRouter(middleware.AuthorizeRead(authenticator))
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusOK)
+	}
+}
+
+func TestAuthorizeReadHandlesPrivateRead(t *testing.T) {
+	authenticator := auth.NewAuthenticator(config.AuthConfig{Enabled: true, APIKeys: []string{"GraceTestKey:grace-web:read"}}, time.Second)
+	router := createRouter(middleware.AuthorizeRead(authenticator))
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if recorder.Code != http.StatusUnauthorized {
+		t.Fatalf("Actual status '%d' does not match expected status '%d'.", recorder.Code, http.StatusUnauthorized)
+	}
+}
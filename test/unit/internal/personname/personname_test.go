@@ -0,0 +1,57 @@
+package personname_test
+
+import (
+	"testing"
+
+	"github.com/muzzarellimj/grace-material-api/internal/personname"
+)
+
+func TestParseHandlesParticlesAndInitials(t *testing.T) {
+	cases := []struct {
+		full     string
+		expected personname.Name
+		sort     string
+	}{
+		{"Ursula K. Le Guin", personname.Name{First: "Ursula", Middle: "K.", Last: "Le Guin"}, "Le Guin, Ursula K."},
+		{"J.R.R. Tolkien", personname.Name{First: "J.", Middle: "R. R.", Last: "Tolkien"}, "Tolkien, J. R. R."},
+		{"Ludwig van Beethoven", personname.Name{First: "Ludwig", Last: "van Beethoven"}, "Beethoven, Ludwig van"},
+		{"Gabriel García Márquez", personname.Name{First: "Gabriel", Last: "García Márquez"}, "García Márquez, Gabriel"},
+		{"José Ortega y Gasset", personname.Name{First: "José", Last: "Ortega y Gasset"}, "Ortega y Gasset, José"},
+		{"Martin Luther King, Jr.", personname.Name{First: "Martin", Middle: "Luther", Last: "King", Suffix: "Jr."}, "King, Martin Luther, Jr."},
+		{"Confucius", personname.Name{First: "Confucius"}, "Confucius"},
+	}
+
+	for _, c := range cases {
+		actual := personname.Parse(c.full)
+
+		if actual != c.expected {
+			t.Fatalf("Actual name '%+v' for '%s' does not match expected name '%+v'.", actual, c.full, c.expected)
+		}
+
+		if actual.Sort() != c.sort {
+			t.Fatalf("Actual sort name '%s' for '%s' does not match expected sort name '%s'.", actual.Sort(), c.full, c.sort)
+		}
+	}
+}
+
+func TestParseAcceptsSortOrder(t *testing.T) {
+	actual := personname.Parse("Le Guin, Ursula K.")
+
+	if actual.Display() != "Ursula K. Le Guin" || actual.Last != "Le Guin" {
+		t.Fatalf("Actual name '%+v' does not match expected name 'Ursula K. Le Guin'.", actual)
+	}
+}
+
+func TestParseUsesSortOrderAlternateName(t *testing.T) {
+	actual := personname.Parse("Mario Vargas Llosa", "Vargas Llosa, Mario", "Mario Vargas")
+
+	if actual.First != "Mario" || actual.Middle != "" || actual.Last != "Vargas Llosa" {
+		t.Fatalf("Actual name '%+v' does not match expected family name 'Vargas Llosa'.", actual)
+	}
+
+	fallback := personname.Parse("Mario Vargas Llosa")
+
+	if fallback.Middle != "Vargas" || fallback.Last != "Llosa" {
+		t.Fatalf("Actual name '%+v' does not match expected name without alternate names.", fallback)
+	}
+}
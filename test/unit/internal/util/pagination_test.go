@@ -0,0 +1,45 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/muzzarellimj/grace-material-api/internal/util"
+)
+
+func TestParsePaginationReturnsDefaults(t *testing.T) {
+	actual, err := util.ParsePagination("", "")
+
+	if err != nil {
+		t.Fatalf("Unable to parse empty pagination arguments: %v\n", err)
+	}
+
+	if actual.Page != 1 || actual.Limit != util.DefaultPageLimit {
+		t.Fatalf("Actual pagination '%v' does not match expected default pagination.", actual)
+	}
+}
+
+func TestParsePaginationHandlesInvalidArgs(t *testing.T) {
+	for _, args := range [][]string{{"0", ""}, {"one", ""}, {"", "0"}, {"", "1000"}} {
+		_, err := util.ParsePagination(args[0], args[1])
+
+		if err == nil {
+			t.Fatalf("Unable to catch error with invalid pagination arguments '%v'.", args)
+		}
+	}
+}
+
+func TestPaginateReturnsPage(t *testing.T) {
+	actual, pagination := util.Paginate([]int{1, 2, 3, 4, 5}, util.Pagination{Page: 2, Limit: 2})
+
+	if len(actual) != 2 || actual[0] != 3 || pagination.Total != 5 {
+		t.Fatalf("Actual page '%v' with pagination '%v' does not match expected page '[3 4]'.", actual, pagination)
+	}
+}
+
+func TestPaginateReturnsEmptyBeyondLastPage(t *testing.T) {
+	actual, _ := util.Paginate([]int{1, 2, 3}, util.Pagination{Page: 3, Limit: 2})
+
+	if len(actual) != 0 {
+		t.Fatalf("Actual page '%v' is not empty beyond the last page.", actual)
+	}
+}
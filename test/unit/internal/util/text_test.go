@@ -46,3 +46,14 @@ func TestFormatPSQLStringReturnsEmptyString(t *testing.T) {
 		t.Fatalf("Actual formatted string '%s' does not match expected empty formatted string.", actual)
 	}
 }
+
+func TestFormatPSQLPatternEscapesWildcards(t *testing.T) {
+	value := `100% O'Brien_\`
+
+	actual := util.FormatPSQLPattern(value)
+	expected := `100\% O''Brien\_\\`
+
+	if actual != expected {
+		t.Fatalf("Actual formatted pattern '%s' does not match expected formatted pattern '%s'.", actual, expected)
+	}
+}